@@ -21,6 +21,7 @@ import (
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"strconv"
 	"strings"
@@ -29,6 +30,7 @@ import (
 	"github.com/iost-official/go-iost/common"
 	"github.com/iost-official/go-iost/consensus"
 	"github.com/iost-official/go-iost/consensus/synchronizer"
+	"github.com/iost-official/go-iost/consensus/verifier"
 	"github.com/iost-official/go-iost/core/block"
 	"github.com/iost-official/go-iost/core/blockcache"
 	"github.com/iost-official/go-iost/core/global"
@@ -43,8 +45,9 @@ import (
 )
 
 var (
-	configfile = flag.StringP("config", "f", "", "Configuration `file`")
-	help       = flag.BoolP("help", "h", false, "Display available options")
+	configfile   = flag.StringP("config", "f", "", "Configuration `file`")
+	trustedBlock = flag.StringP("trusted-block", "", "", "Trusted block `number:hash[:root]`, the node refuses the chains without it and starts from its snapshot if the chain is empty, the snapshot must have the root if it is given")
+	help         = flag.BoolP("help", "h", false, "Display available options")
 )
 
 func initMetrics(metricsConfig *common.MetricsConfig) error {
@@ -108,11 +111,29 @@ func main() {
 	}
 
 	conf := common.NewConfig(*configfile)
+	if *trustedBlock != "" {
+		cp, err := common.ParseCheckpoint(*trustedBlock)
+		if err != nil {
+			ilog.Fatalf("parse trusted block failed. err=%v", err)
+		}
+		conf.Checkpoints = append(conf.Checkpoints, cp)
+		if conf.Snapshot == nil {
+			conf.Snapshot = &common.SnapshotConfig{}
+		}
+		conf.Snapshot.TrustedHash = cp.Hash
+		conf.Snapshot.TrustedRoot = cp.SnapshotRoot
+	}
+	if err := common.SetCheckpoints(conf.Checkpoints); err != nil {
+		ilog.Fatalf("set checkpoints failed. err=%v", err)
+	}
 
 	initLogger(conf.Log)
 	ilog.Infof("Config Information:\n%v", conf.YamlString())
 
 	vm.SetUp(conf.VM)
+	if conf.VM.VerifyTimeLimit > 0 {
+		verifier.TxExecTimeLimit = time.Duration(conf.VM.VerifyTimeLimit) * time.Millisecond
+	}
 
 	err := initMetrics(conf.Metrics)
 	if err != nil {
@@ -145,6 +166,9 @@ func main() {
 	if err != nil {
 		ilog.Fatalf("blockcache initialization failed, stop the program! err:%v", err)
 	}
+	if err := blkCache.Recover(verifier.VerifyBlockWithVM); err != nil {
+		ilog.Fatalf("blockcache recovery failed, stop the program! err:%v", err)
+	}
 
 	sync, err := synchronizer.NewSynchronizer(bv, blkCache, p2pService)
 	if err != nil {
@@ -159,15 +183,20 @@ func main() {
 	}
 	app = append(app, txp)
 
-	rpcServer := rpc.NewRPCServer(txp, blkCache, bv, p2pService)
+	var consensusType string
+	if conf.Consensus != nil {
+		consensusType = conf.Consensus.Type
+	}
+	consensus, err := consensus.Factory(consensusType, acc, bv, blkCache, txp, p2pService)
+	if err != nil {
+		ilog.Fatalf("consensus initialization failed, stop the program! err:%v", err)
+	}
+
+	rpcServer := rpc.NewRPCServer(txp, blkCache, bv, p2pService, consensus)
 	app = append(app, rpcServer)
 
 	jsonRPCServer := rpc.NewJSONServer(bv)
 	app = append(app, jsonRPCServer)
-	consensus, err := consensus.Factory("pob", acc, bv, blkCache, txp, p2pService)
-	if err != nil {
-		ilog.Fatalf("consensus initialization failed, stop the program! err:%v", err)
-	}
 	app = append(app, consensus)
 
 	err = app.Start()
@@ -183,9 +212,11 @@ func main() {
 
 	app.Stop()
 	ilog.Stop()
+	blkCache.Close()
 	bv.BlockChain().Close()
 	bv.StateDB().Close()
 	bv.TxDB().Close()
+	bv.EvidenceDB().Close()
 }
 
 func waitExit() {
@@ -197,11 +228,26 @@ func waitExit() {
 
 func startDebugServer(addr string, blkCache blockcache.BlockCache, p2pService p2p.Service, blkChain block.Chain) {
 	http.HandleFunc("/debug/blockcache/", func(rw http.ResponseWriter, r *http.Request) {
-		rw.Write([]byte(blkCache.Draw()))
+		switch r.URL.Query().Get("format") {
+		case "json":
+			bytes, err := json.MarshalIndent(blkCache.Export(), "", "    ")
+			if err != nil {
+				http.Error(rw, err.Error(), http.StatusInternalServerError)
+				return
+			}
+			rw.Write(bytes)
+		case "dot":
+			rw.Write([]byte(blkCache.Export().DOT()))
+		default:
+			rw.Write([]byte(blkCache.Draw()))
+		}
 	})
 	http.HandleFunc("/debug/blockchain/", func(rw http.ResponseWriter, r *http.Request) {
 		rg := r.URL.Query()
-		sp := strings.Split(rg["range"][0], "-")
+		sp := strings.Split(rg.Get("range"), "-")
+		if len(sp) != 2 {
+			return
+		}
 		start, err := strconv.Atoi(sp[0])
 		if err != nil {
 			return
@@ -210,7 +256,19 @@ func startDebugServer(addr string, blkCache blockcache.BlockCache, p2pService p2
 		if err != nil {
 			return
 		}
-		rw.Write([]byte(blkChain.Draw(int64(start), int64(end))))
+		switch rg.Get("format") {
+		case "json":
+			bytes, err := json.MarshalIndent(blockcache.ExportChain(blkChain, int64(start), int64(end)), "", "    ")
+			if err != nil {
+				http.Error(rw, err.Error(), http.StatusInternalServerError)
+				return
+			}
+			rw.Write(bytes)
+		case "dot":
+			rw.Write([]byte(blockcache.ExportChain(blkChain, int64(start), int64(end)).DOT()))
+		default:
+			rw.Write([]byte(blkChain.Draw(int64(start), int64(end))))
+		}
 	})
 	http.HandleFunc("/debug/p2p/neighbors/", func(rw http.ResponseWriter, r *http.Request) {
 		neighbors := p2pService.NeighborStat()
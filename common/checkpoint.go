@@ -0,0 +1,63 @@
+package common
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// checkpoints maps the numbers of the trusted blocks to their hashes, it is set at startup.
+var checkpoints = make(map[int64][]byte)
+
+// ParseCheckpoint parses the checkpoint in the format of number:hash or number:hash:root, the hash and the root of
+// the snapshot at the block are base58 encoded.
+func ParseCheckpoint(s string) (*CheckpointConfig, error) {
+	sp := strings.SplitN(s, ":", 3)
+	if len(sp) < 2 {
+		return nil, fmt.Errorf("invalid checkpoint %v, should be number:hash or number:hash:root", s)
+	}
+	number, err := strconv.ParseInt(sp[0], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid checkpoint number %v", sp[0])
+	}
+	cp := &CheckpointConfig{Number: number, Hash: sp[1]}
+	if len(sp) == 3 {
+		if len(Base58Decode(sp[2])) == 0 {
+			return nil, fmt.Errorf("invalid snapshot root %v", sp[2])
+		}
+		cp.SnapshotRoot = sp[2]
+	}
+	return cp, nil
+}
+
+// SetCheckpoints sets the checkpoints of the running chain, two checkpoints at the same number must be the same.
+func SetCheckpoints(cps []*CheckpointConfig) error {
+	m := make(map[int64][]byte)
+	for _, cp := range cps {
+		hash := Base58Decode(cp.Hash)
+		if cp.Number < 0 || len(hash) == 0 {
+			return fmt.Errorf("invalid checkpoint %v:%v", cp.Number, cp.Hash)
+		}
+		if h, ok := m[cp.Number]; ok && string(h) != string(hash) {
+			return fmt.Errorf("conflicting checkpoints at %v", cp.Number)
+		}
+		m[cp.Number] = hash
+	}
+	checkpoints = m
+	return nil
+}
+
+// CheckpointHash returns the hash of the checkpoint at the number.
+func CheckpointHash(number int64) ([]byte, bool) {
+	hash, ok := checkpoints[number]
+	return hash, ok
+}
+
+// Checkpoints returns the numbers of the checkpoints.
+func Checkpoints() []int64 {
+	numbers := make([]int64, 0, len(checkpoints))
+	for n := range checkpoints {
+		numbers = append(numbers, n)
+	}
+	return numbers
+}
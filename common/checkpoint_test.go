@@ -0,0 +1,42 @@
+package common
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestCheckpoints(t *testing.T) {
+	defer SetCheckpoints(nil)
+
+	hash := Base58Encode(Sha3([]byte("block")))
+	cp, err := ParseCheckpoint("100:" + hash)
+	if err != nil || cp.Number != 100 || cp.Hash != hash {
+		t.Fatalf("parse checkpoint: %v, err %v", cp, err)
+	}
+	root := Base58Encode(Sha3([]byte("snapshot")))
+	if cp, err := ParseCheckpoint("100:" + hash + ":" + root); err != nil || cp.Hash != hash || cp.SnapshotRoot != root {
+		t.Fatalf("parse checkpoint with root: %v, err %v", cp, err)
+	}
+	for _, s := range []string{"100", "a:" + hash, "100:" + hash + ":0OIl"} {
+		if _, err := ParseCheckpoint(s); err == nil {
+			t.Fatalf("%v should be invalid", s)
+		}
+	}
+
+	other := Base58Encode(Sha3([]byte("other block")))
+	if err := SetCheckpoints([]*CheckpointConfig{cp, {Number: 100, Hash: other}}); err == nil {
+		t.Fatal("conflicting checkpoints should be refused")
+	}
+	if err := SetCheckpoints([]*CheckpointConfig{cp, cp, {Number: 200, Hash: other}}); err != nil {
+		t.Fatal(err)
+	}
+	if h, ok := CheckpointHash(100); !ok || !bytes.Equal(h, Sha3([]byte("block"))) {
+		t.Fatalf("wrong checkpoint hash at 100: %v", h)
+	}
+	if _, ok := CheckpointHash(150); ok {
+		t.Fatal("no checkpoint at 150")
+	}
+	if len(Checkpoints()) != 2 {
+		t.Fatalf("wrong checkpoints: %v", Checkpoints())
+	}
+}
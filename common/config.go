@@ -22,17 +22,42 @@ type GenesisConfig struct {
 	WitnessInfo      []string
 	VoteContractPath string
 	AdminID			 string
+	Consensus        *GenesisConsensusConfig
+}
+
+// GenesisConsensusConfig is the consensus parameters written into the genesis block.
+// A block is confirmed by ConfirmNumerator/ConfirmDenominator of the witnesses plus one.
+// MaxBlockGas and MaxBlockSize limit the total gas usage and the serialized size of a block.
+// ProducerNumber is the number of the witnesses producing in a round, all the genesis witnesses produce if it is 0.
+// Forks are the heights from which the changes of the chain rules take effect.
+type GenesisConsensusConfig struct {
+	SlotLength         int64
+	ConfirmNumber      int64
+	ConfirmNumerator   int64
+	ConfirmDenominator int64
+	MaxBlockGas        int64
+	MaxBlockSize       int64
+	ProducerNumber     int64
+	Forks              ForkConfig
 }
 
 // DBConfig config of the database
+// HistoryLength is the number of the latest confirmed blocks whose states are kept for tracing their txs, no state
+// of the past blocks is kept if it is 0.
 type DBConfig struct {
-	LdbPath string
+	LdbPath       string
+	HistoryLength int64
 }
 
 // VMConfig config of the v8vm
 type VMConfig struct {
 	JsPath   string
 	LogLevel string
+	Parallel int // number of workers executing txs in parallel, txs are executed one by one if it is less than 2
+	// VerifyTimeLimit is the time in milliseconds a tx may run when a block is verified, the default is used if it is 0.
+	// The receipts don't depend on the time from MeteringHeight on, so a block is rejected if any of its txs runs
+	// longer, it must be well above the time a producer gives to a tx on a slow node.
+	VerifyTimeLimit int64
 }
 
 // P2PConfig is the config for p2p network.
@@ -50,6 +75,24 @@ type RPCConfig struct {
 	GRPCPort int
 }
 
+// TxPoolConfig is the config of txpool.
+type TxPoolConfig struct {
+	ChanSize         int
+	MaxSize          int
+	MaxPerPublisher  int
+	MinGasPrice      int64
+	MaxTxSize        int
+	MaxExpiration    int64
+	PriceBumpPercent int64
+}
+
+// ConsensusConfig is the config of consensus.
+// SealInterval is used by the dev consensus, in milliseconds. Blocks are sealed as soon as txs arrive if it is 0.
+type ConsensusConfig struct {
+	Type         string
+	SealInterval int64
+}
+
 // FileLogConfig is the config for filewriter of ilog.
 type FileLogConfig struct {
 	Path   string
@@ -84,17 +127,54 @@ type DebugConfig struct {
 	ListenAddr string
 }
 
+// SnapshotConfig is the config of state snapshots.
+// The block head has no state root, so the state of the snapshot is only trusted if its root is the trusted root,
+// or if at least two peers (the quorum) serve the same snapshot, then the peers serving it are trusted not to collude.
+type SnapshotConfig struct {
+	Serve       bool   // take snapshots of the irreversible state and serve them to other nodes
+	Interval    int64  // snapshots are taken at the blocks whose numbers are multiples of the interval
+	TrustedHash string // a new node starts from the snapshot at the block with the base58 hash instead of the genesis block
+	TrustedRoot string // the base58 root of the snapshot at the trusted block, logged by the nodes taking it
+	Quorum      int    // number of peers which must serve the same snapshot before downloading it
+}
+
+// CheckpointConfig is a block trusted to be on the chain, the branches conflicting with it are refused.
+type CheckpointConfig struct {
+	Number       int64
+	Hash         string
+	SnapshotRoot string // the root of the snapshot at the block, optional
+}
+
+// ForkConfig is the numbers of the blocks from which the changes of the chain rules take effect, a change
+// never takes effect if its number is negative. They are a part of the consensus parameters of the genesis block,
+// so all the nodes of a chain use the same numbers.
+// From ParallelHeight on, a tx doesn't pay the costs left by the previous txs of the block, so the txs can run in parallel.
+// From MeteringHeight on, a js contract stops at the same instruction on every node when it runs out of gas, and a tx
+// killed by the time limit of a node has no receipt, instead of an ErrorTimeout one.
+// From BeaconHeight on, a block must carry the vrf beacon of its witness, which is the random seed of the contracts.
+// From LimitHeight on, a block over the MaxBlockGas or the MaxBlockSize of the consensus parameters is invalid.
+type ForkConfig struct {
+	ParallelHeight int64
+	MeteringHeight int64
+	BeaconHeight   int64
+	LimitHeight    int64
+}
+
 // Config provide all configuration for the application
 type Config struct {
-	ACC     *ACCConfig
-	Genesis *GenesisConfig
-	VM      *VMConfig
-	DB      *DBConfig
-	P2P     *P2PConfig
-	RPC     *RPCConfig
-	Log     *LogConfig
-	Metrics *MetricsConfig
-	Debug   *DebugConfig
+	ACC         *ACCConfig
+	Genesis     *GenesisConfig
+	VM          *VMConfig
+	DB          *DBConfig
+	P2P         *P2PConfig
+	RPC         *RPCConfig
+	TxPool      *TxPoolConfig
+	Consensus   *ConsensusConfig
+	Log         *LogConfig
+	Metrics     *MetricsConfig
+	Debug       *DebugConfig
+	Snapshot    *SnapshotConfig
+	Checkpoints []*CheckpointConfig
 }
 
 // NewConfig returns a new instance of Config
@@ -112,6 +192,10 @@ func NewConfig(configfile string) *Config {
 	}
 
 	c := &Config{}
+	// the consensus parameters which aren't given are the default ones, the forks don't take effect
+	if v.IsSet("genesis.consensus") {
+		c.Genesis = &GenesisConfig{Consensus: DefaultGenesisConsensusConfig()}
+	}
 	if err := v.Unmarshal(c); err != nil {
 		ilog.Fatalf("Unable to decode into struct, %v", err)
 	}
@@ -0,0 +1,67 @@
+package common
+
+import "fmt"
+
+// ConsensusParams is the consensus parameters of the running chain, it is loaded from the genesis block at startup.
+var ConsensusParams = DefaultGenesisConsensusConfig()
+
+// DefaultGenesisConsensusConfig returns the consensus parameters of a genesis block which doesn't have any.
+func DefaultGenesisConsensusConfig() *GenesisConsensusConfig {
+	return &GenesisConsensusConfig{
+		SlotLength:         3,
+		ConfirmNumber:      8,
+		ConfirmNumerator:   2,
+		ConfirmDenominator: 3,
+		MaxBlockGas:        1000000000,
+		MaxBlockSize:       4 * 1024 * 1024,
+		Forks:              *DefaultForkConfig(),
+	}
+}
+
+// SetConsensusParams sets the consensus parameters of the running chain. It's called once at startup,
+// before any goroutine reads the parameters, they are constant after that.
+func SetConsensusParams(c *GenesisConsensusConfig) {
+	ConsensusParams = c
+	SlotLength = c.SlotLength
+	forks := c.Forks
+	SetForks(&forks)
+}
+
+// Validate checks that the consensus parameters are usable.
+func (c *GenesisConsensusConfig) Validate() error {
+	if c.SlotLength <= 0 {
+		return fmt.Errorf("invalid slot length %v", c.SlotLength)
+	}
+	if c.ConfirmNumber <= 0 {
+		return fmt.Errorf("invalid confirm number %v", c.ConfirmNumber)
+	}
+	if c.ConfirmNumerator < 0 || c.ConfirmDenominator <= 0 || c.ConfirmNumerator > c.ConfirmDenominator {
+		return fmt.Errorf("invalid confirm rate %v/%v", c.ConfirmNumerator, c.ConfirmDenominator)
+	}
+	if c.MaxBlockGas <= 0 {
+		return fmt.Errorf("invalid max block gas %v", c.MaxBlockGas)
+	}
+	if c.MaxBlockSize <= 0 {
+		return fmt.Errorf("invalid max block size %v", c.MaxBlockSize)
+	}
+	if c.ProducerNumber < 0 {
+		return fmt.Errorf("invalid producer number %v", c.ProducerNumber)
+	}
+	return nil
+}
+
+// ValidateWitnesses checks that the genesis witnesses can fill the producers of a round.
+func (c *GenesisConsensusConfig) ValidateWitnesses(numberOfWitnesses int64) error {
+	if numberOfWitnesses <= 0 {
+		return fmt.Errorf("no genesis witness")
+	}
+	if c.ProducerNumber > numberOfWitnesses {
+		return fmt.Errorf("producer number %v is more than the genesis witnesses %v", c.ProducerNumber, numberOfWitnesses)
+	}
+	return nil
+}
+
+// ConfirmLimit returns the number of witnesses needed to confirm a block.
+func (c *GenesisConsensusConfig) ConfirmLimit(numberOfWitnesses int64) int64 {
+	return numberOfWitnesses*c.ConfirmNumerator/c.ConfirmDenominator + 1
+}
@@ -0,0 +1,32 @@
+package common
+
+import "testing"
+
+func TestGenesisConsensusConfig_ProducerNumber(t *testing.T) {
+	c := DefaultGenesisConsensusConfig()
+	if err := c.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.ValidateWitnesses(1); err != nil {
+		t.Fatalf("all the genesis witnesses produce by default: %v", err)
+	}
+
+	c.ProducerNumber = 3
+	if err := c.ValidateWitnesses(7); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.ValidateWitnesses(3); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.ValidateWitnesses(2); err == nil {
+		t.Fatal("producer number more than the genesis witnesses should be refused")
+	}
+	if err := c.ValidateWitnesses(0); err == nil {
+		t.Fatal("genesis without witness should be refused")
+	}
+
+	c.ProducerNumber = -1
+	if err := c.Validate(); err == nil {
+		t.Fatal("negative producer number should be refused")
+	}
+}
@@ -0,0 +1,48 @@
+package common
+
+// Forks is the fork config of the running chain, it is set with the consensus parameters of the genesis block.
+var Forks = DefaultForkConfig()
+
+// DefaultForkConfig returns the fork config of a chain which doesn't configure any, none of the changes takes effect.
+func DefaultForkConfig() *ForkConfig {
+	return &ForkConfig{
+		ParallelHeight: -1,
+		MeteringHeight: -1,
+		BeaconHeight:   -1,
+		LimitHeight:    -1,
+	}
+}
+
+// SetForks sets the fork config of the running chain, the default is used if c is nil.
+func SetForks(c *ForkConfig) {
+	if c == nil {
+		c = DefaultForkConfig()
+	}
+	Forks = c
+}
+
+func forked(height int64, number int64) bool {
+	return height >= 0 && number >= height
+}
+
+// IsParallel returns whether the txs of the block at number are executed independently of the costs of each other.
+func (c *ForkConfig) IsParallel(number int64) bool {
+	return forked(c.ParallelHeight, number)
+}
+
+// IsMetering returns whether the execution of the block at number is bounded only by the gas, the time limit doesn't
+// change the receipts.
+func (c *ForkConfig) IsMetering(number int64) bool {
+	return forked(c.MeteringHeight, number)
+}
+
+// IsBeacon returns whether the block at number carries the vrf beacon of its witness, and the contracts get the
+// randomness from it.
+func (c *ForkConfig) IsBeacon(number int64) bool {
+	return forked(c.BeaconHeight, number)
+}
+
+// IsLimit returns whether the block at number is refused if it's over the gas or size limit of a block.
+func (c *ForkConfig) IsLimit(number int64) bool {
+	return forked(c.LimitHeight, number)
+}
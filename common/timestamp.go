@@ -2,9 +2,11 @@ package common
 
 import "time"
 
+// SlotLength interval of generate block, it is set once at startup by the consensus parameters of the genesis
+// block, before any goroutine reads it, and never changed after that
+var SlotLength int64 = 3
+
 const (
-	// SlotLength interval of generate block
-	SlotLength = 3
 	// SecondsInHour ...
 	SecondsInHour = 3600
 	// SecondsInDay ...
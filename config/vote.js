@@ -3,6 +3,8 @@ const producerRegisterFee = 1000 * 1000 * softFloatRate;
 const preProducerThreshold = 2100 * 10000;
 const voteLockTime = 200;
 const voteStatInterval = 200;
+const evidenceSlashAmount = producerRegisterFee / 10;
+const jailTime = 3 * voteStatInterval;
 
 class VoteContract {
     constructor() {
@@ -48,6 +50,20 @@ class VoteContract {
         });
     }
 
+    // keep the first producerNumber of the genesis producers, the others wait in the producer table
+    InitProducerNumber(producerNumber) {
+        const bn = this._getBlockNumber();
+        if(bn !== 0) {
+            throw new Error("init out of genesis block")
+        }
+        const pendingProducerList = this._get("pendingProducerList");
+        if (producerNumber <= 0 || producerNumber > pendingProducerList.length) {
+            throw new Error("invalid producer number " + producerNumber);
+        }
+        this._put("pendingProducerList", pendingProducerList.slice(0, producerNumber));
+        this._put("producerNumber", producerNumber);
+    }
+
     InitAdmin(adminID) {
         const bn = this._getBlockNumber();
         if(bn !== 0) {
@@ -109,6 +125,14 @@ class VoteContract {
 		if (storage.mapHas("producerTable", account)) {
 			throw new Error("producer exists");
 		}
+		// the jail record outlives the registration, so a jailed producer can't register again to escape
+		if (storage.mapHas("jailTable", account)) {
+			const releaseBlockNumber = this._mapGet("jailTable", account);
+			if (this._getBlockNumber() < releaseBlockNumber) {
+				throw new Error("producer in jail until block " + releaseBlockNumber);
+			}
+			this._mapDel("jailTable", account);
+		}
 		const ret = BlockChain.deposit(account, producerRegisterFee);
 		if (ret !== 0) {
 			throw new Error("register deposit failed. ret = " + ret);
@@ -142,6 +166,9 @@ class VoteContract {
         if (!storage.mapHas("producerTable", account)) {
 			throw new Error("producer not exists, " + account);
 		}
+		if (storage.mapHas("jailTable", account)) {
+			throw new Error("producer in jail, can't login");
+		}
         const pro = this._mapGet("producerTable", account);
 		pro.online = true;
         this._mapPut("producerTable", account, pro);
@@ -177,10 +204,84 @@ class VoteContract {
         this._mapDel("producerTable", account);
         this._mapDel("preProducerMap", account);
 
-		const ret = BlockChain.withdraw(account, producerRegisterFee);
-		if (ret != 0) {
-			throw new Error("withdraw failed. ret = " + ret);
+		// the slashed part of the deposit is not returned
+		let slashed = 0;
+		if (storage.mapHas("slashTable", account)) {
+			slashed = this._mapGet("slashTable", account);
+			this._mapDel("slashTable", account);
+		}
+
+		if (slashed < producerRegisterFee) {
+			const ret = BlockChain.withdraw(account, producerRegisterFee - slashed);
+			if (ret != 0) {
+				throw new Error("withdraw failed. ret = " + ret);
+			}
+		}
+	}
+
+	// submit the evidence that a producer signed two blocks in the same slot,
+	// the producer will be slashed, jailed and removed from pending producer list
+	SubmitEvidence(evidence) {
+		const ret = BlockChain.call("iost.system", "VerifyEvidence", JSON.stringify([evidence]));
+		if (ret === null) {
+			throw new Error("verify evidence failed");
+		}
+		const rtn = JSON.parse(ret);
+		const producer = rtn[0];
+		const slot = rtn[1];
+
+        if (!storage.mapHas("producerTable", producer)) {
+			throw new Error("producer not exists");
+		}
+		const evidenceKey = producer + "-" + slot;
+		if (storage.mapHas("evidenceTable", evidenceKey)) {
+			throw new Error("evidence exists");
+		}
+		const bn = this._getBlockNumber();
+		this._mapPut("evidenceTable", evidenceKey, bn);
+
+		// slash part of the deposit, the slashed token stays in the contract
+		let slashed = 0;
+		if (storage.mapHas("slashTable", producer)) {
+			slashed = this._mapGet("slashTable", producer);
+		}
+		slashed = Math.min(producerRegisterFee, slashed + evidenceSlashAmount);
+		this._mapPut("slashTable", producer, slashed);
+
+		// jail the producer, it can't log in or become pending producer until unjailed
+		this._mapPut("jailTable", producer, bn + jailTime);
+		const pro = this._mapGet("producerTable", producer);
+		pro.online = false;
+		this._mapPut("producerTable", producer, pro);
+
+		// keep at least one producer in the pending list
+		const pendingProducerList = this._get("pendingProducerList");
+		const index = pendingProducerList.indexOf(producer);
+		if (index >= 0 && pendingProducerList.length > 1) {
+			pendingProducerList.splice(index, 1);
+			this._put("pendingProducerList", pendingProducerList);
+		}
+	}
+
+	// release the producer from jail after the cooldown, need to refill the slashed deposit
+	Unjail(account) {
+		this._requireAuth(account);
+		if (!storage.mapHas("jailTable", account)) {
+			throw new Error("producer not in jail");
+		}
+		const releaseBlockNumber = this._mapGet("jailTable", account);
+		if (this._getBlockNumber() < releaseBlockNumber) {
+			throw new Error("producer still in jail until block " + releaseBlockNumber);
+		}
+		if (storage.mapHas("slashTable", account)) {
+			const slashed = this._mapGet("slashTable", account);
+			const ret = BlockChain.deposit(account, slashed);
+			if (ret !== 0) {
+				throw new Error("unjail deposit failed. ret = " + ret);
+			}
+			this._mapDel("slashTable", account);
 		}
+		this._mapDel("jailTable", account);
 	}
 
 	// vote, need to pledge token
@@ -322,9 +423,10 @@ class VoteContract {
 		};
 		preList.sort(scoreCmp);
 
-		// update pending list
+		// update pending list, fill the places of jailed producers
         const producerNumber = this._get("producerNumber");
-		const replaceNum = Math.min(preList.length, Math.floor(producerNumber / 6));
+		const replaceNum = Math.min(preList.length,
+			Math.floor(producerNumber / 6) + producerNumber - pendingProducerList.length);
 		const oldPreList = [];
         for (let key in pendingProducerList) {
 		    const x = pendingProducerList[key];
@@ -347,7 +449,7 @@ class VoteContract {
 		this._put("pendingProducerList", newList.map(x => x.key));
 		this._put("pendingBlockNumber", this._getBlockNumber());
 
-		for (let i = 0; i < producerNumber; i++) {
+		for (let i = 0; i < currentList.length; i++) {
 			if (!pendingProducerList.includes(currentList[i])) {
                 const proRes = this._mapGet("producerTable", currentList[i]);
                 proRes.score = 0;
@@ -1,7 +1,9 @@
 package consensus
 
 import (
+	"fmt"
 	"sync"
+	"time"
 
 	"github.com/iost-official/go-iost/account"
 	"github.com/iost-official/go-iost/consensus/pob"
@@ -15,6 +17,40 @@ import (
 type Consensus interface {
 	Start() error
 	Stop()
+	SlotCounts() map[string][]*SlotCount
+}
+
+// SlotCount is the number of produced and missed slots of a witness in a sliding window.
+type SlotCount struct {
+	Window   int64
+	Produced int64
+	Missed   int64
+}
+
+type pobEngine interface {
+	Start() error
+	Stop()
+	SlotCounts() map[string][]*pob.SlotCount
+}
+
+// pobConsensus is the Consensus of a pob engine.
+type pobConsensus struct {
+	pobEngine
+}
+
+// SlotCounts returns the produced and missed slots of every witness in each of the pob.SlotWindows.
+func (c *pobConsensus) SlotCounts() map[string][]*SlotCount {
+	ret := make(map[string][]*SlotCount)
+	for witness, counts := range c.pobEngine.SlotCounts() {
+		for _, sc := range counts {
+			ret[witness] = append(ret[witness], &SlotCount{
+				Window:   sc.Window,
+				Produced: sc.Produced,
+				Missed:   sc.Missed,
+			})
+		}
+	}
+	return ret
 }
 
 var cons Consensus
@@ -33,9 +69,21 @@ func Factory(consensusType string, account *account.Account, baseVariable global
 	case "pob":
 		if cons == nil {
 			once.Do(func() {
-				cons = pob.NewPoB(account, baseVariable, blkcache, txPool, service)
+				cons = &pobConsensus{pob.NewPoB(account, baseVariable, blkcache, txPool, service)}
+			})
+		}
+	case "dev":
+		if cons == nil {
+			once.Do(func() {
+				var interval time.Duration
+				if conf := baseVariable.Config(); conf != nil && conf.Consensus != nil {
+					interval = time.Duration(conf.Consensus.SealInterval) * time.Millisecond
+				}
+				cons = &pobConsensus{pob.NewDevPoB(account, baseVariable, blkcache, txPool, interval)}
 			})
 		}
+	default:
+		err = fmt.Errorf("unknown consensus type: %v", consensusType)
 	}
 	return cons, err
 }
@@ -27,14 +27,21 @@ var (
 	errTxDup       = errors.New("duplicate tx")
 	errTxSignature = errors.New("tx wrong signature")
 	errHeadHash    = errors.New("wrong head hash")
+	errBlockGas    = errors.New("block gas exceeds the limit")
+	errBlockSize   = errors.New("block size exceeds the limit")
 	txLimit        = 2000 //limit it to 2000
 	txExecTime     = verifier.TxExecTimeLimit / 2
+	// blockSizeReserve is the size reserved for the block head, the signature and the encoding overhead
+	blockSizeReserve int64 = 2048
+	// receiptSizeReserve is the size reserved for the receipt of a tx before its execution, it's the upper bound
+	// of the usual receipts, the txs of larger receipts are trimmed from the tail of the block after the execution
+	receiptSizeReserve int64 = 512
 )
 
 func generateBlock(account *account.Account, txPool txpool.TxPool, db db.MVCCDB) (*block.Block, error) {
 
 	ilog.Info("generate Block start")
-	limitTime := time.NewTimer(common.SlotLength / 3 * time.Second)
+	limitTime := time.NewTimer(time.Duration(common.SlotLength) * time.Second / 3)
 	txIter, head := txPool.TxIterator()
 	topBlock := head.Block
 	blk := block.Block{
@@ -48,7 +55,19 @@ func generateBlock(account *account.Account, txPool txpool.TxPool, db db.MVCCDB)
 		Txs:      []*tx.Tx{},
 		Receipts: []*tx.TxReceipt{},
 	}
+	var err error
+	if common.Forks.IsBeacon(blk.Head.Number) {
+		beacon, err := block.NewBeacon(topBlock, account.Algorithm, account.Seckey)
+		if err != nil {
+			return nil, err
+		}
+		err = blk.Head.SetBeacon(beacon)
+		if err != nil {
+			return nil, err
+		}
+	}
 	db.Checkout(string(topBlock.HeadHash()))
+	vm.RecordRandom(blk.Head, db)
 	engine := vm.NewEngine(blk.Head, db)
 
 	// call vote
@@ -71,48 +90,99 @@ func generateBlock(account *account.Account, txPool txpool.TxPool, db db.MVCCDB)
 		blk.Txs = append(blk.Txs, trx)
 		blk.Receipts = append(blk.Receipts, receipt)
 	}
+	// the vote tx is never trimmed
+	keep := len(blk.Txs)
+	blockGas, blockSize := blockUsage(&blk)
+	maxBlockGas, maxBlockSize := common.ConsensusParams.MaxBlockGas, common.ConsensusParams.MaxBlockSize-blockSizeReserve
+	executor := vm.NewExecutor(engine, blk.Head, db, vm.ParallelWorkers())
+	batchSize := vm.ParallelWorkers()
+	if batchSize < 1 {
+		batchSize = 1
+	}
 	t, ok := txIter.Next()
 	delList := []*tx.Tx{}
-	var vmExecTime, iterTime, i, j int64
+	full := false
+	trimmed := false
 L:
-	for ok {
+	for ok && !full {
 		select {
 		case <-limitTime.C:
 			ilog.Info("time up")
 			break L
 		default:
-			i++
-			step1 := time.Now()
-			if !txPool.TxTimeOut(t) {
-				j++
-				if receipt, err := engine.Exec(t, txExecTime); err == nil {
-					blk.Txs = append(blk.Txs, t)
-					blk.Receipts = append(blk.Receipts, receipt)
+			batch := make([]*tx.Tx, 0, batchSize)
+			limits := make([]time.Duration, 0, batchSize)
+			batchGas, batchTxSize := blockGas, blockSize
+			for ok && len(batch) < batchSize && len(blk.Txs)+len(batch) < txLimit {
+				// the gas usage of a tx never exceeds its gas limit, so the limit is used before the execution
+				txSize := int64(len(t.Encode())) + receiptSizeReserve
+				if t.GasLimit > maxBlockGas || txSize > maxBlockSize {
+					ilog.Warnf("tx %v can never be packed, gas limit: %v, size: %v", common.Base58Encode(t.Hash()), t.GasLimit, txSize)
+					delList = append(delList, t)
+				} else if batchGas+t.GasLimit > maxBlockGas || batchTxSize+txSize > maxBlockSize {
+					ilog.Info("block is full")
+					full = true
+					break
+				} else if !txPool.TxTimeOut(t) {
+					batch = append(batch, t)
+					limits = append(limits, txExecTime)
+					batchGas += t.GasLimit
+					batchTxSize += txSize
 				} else {
-					ilog.Errorf("exec tx failed. err=%v, receipt=%v", err, receipt)
 					delList = append(delList, t)
 				}
-			} else {
-				delList = append(delList, t)
+				t, ok = txIter.Next()
+			}
+			receipts, errs := executor.Exec(batch, limits)
+			for k, trx := range batch {
+				if errs[k] != nil {
+					ilog.Errorf("exec tx failed. err=%v, receipt=%v", errs[k], receipts[k])
+					delList = append(delList, trx)
+					continue
+				}
+				blk.Txs = append(blk.Txs, trx)
+				blk.Receipts = append(blk.Receipts, receipts[k])
+				blockGas += receipts[k].GasUsage
+				blockSize += int64(len(trx.Encode()) + len(receipts[k].Encode()))
+			}
+			if blockGas > maxBlockGas || blockSize > maxBlockSize {
+				ilog.Info("block is full, trim the txs of large receipts")
+				trimBlock(&blk, keep, maxBlockGas, maxBlockSize)
+				full, trimmed = true, true
 			}
 			if len(blk.Txs) >= txLimit {
 				break L
 			}
-			step2 := time.Now()
-			t, ok = txIter.Next()
-			step3 := time.Now()
-			vmExecTime += step2.Sub(step1).Nanoseconds()
-			iterTime += step3.Sub(step2).Nanoseconds()
 		}
 	}
 
-	blk.Head.TxsHash = blk.CalculateTxsHash()
-	blk.Head.MerkleHash = blk.CalculateMerkleHash()
-	err := blk.CalculateHeadHash()
-	if err != nil {
-		return nil, err
+	for {
+		if trimmed {
+			err = replayBlock(&blk, topBlock, db, &delList)
+			if err != nil {
+				go txPool.DelTxList(delList)
+				return nil, err
+			}
+		}
+		blk.Head.TxsHash = blk.CalculateTxsHash()
+		blk.Head.MerkleHash = blk.CalculateMerkleHash()
+		err = blk.CalculateHeadHash()
+		if err != nil {
+			return nil, err
+		}
+		blk.Sign = account.Sign(blk.HeadHash())
+		err = verifyBlockLimit(&blk)
+		if err == nil {
+			break
+		}
+		if len(blk.Txs) <= keep {
+			go txPool.DelTxList(delList)
+			return nil, err
+		}
+		// the reserve of the head isn't enough, trim the last tx and try again
+		blk.Txs, blk.Receipts = blk.Txs[:len(blk.Txs)-1], blk.Receipts[:len(blk.Receipts)-1]
+		trimmed = true
 	}
-	blk.Sign = account.Sign(blk.HeadHash())
 	db.Tag(string(blk.HeadHash()))
 
 	metricsGeneratedBlockCount.Add(1, nil)
@@ -121,6 +191,42 @@ L:
 	return &blk, nil
 }
 
+// trimBlock drops the txs from the tail of the block until its gas usage and the size of its txs and receipts
+// are within the limits, the first keep txs are never dropped. The dropped txs stay in the tx pool.
+func trimBlock(blk *block.Block, keep int, maxGas int64, maxSize int64) {
+	gas, size := blockUsage(blk)
+	for len(blk.Txs) > keep && (gas > maxGas || size > maxSize) {
+		last := len(blk.Txs) - 1
+		gas -= blk.Receipts[last].GasUsage
+		size -= int64(len(blk.Txs[last].Encode()) + len(blk.Receipts[last].Encode()))
+		blk.Txs, blk.Receipts = blk.Txs[:last], blk.Receipts[:last]
+	}
+}
+
+// replayBlock executes the txs of the block again on the state of the parent, after txs are trimmed from its tail
+// the state of the executed txs is dropped. The txs failing this time are dropped from the block and the pool.
+func replayBlock(blk *block.Block, parent *block.Block, db db.MVCCDB, delList *[]*tx.Tx) error {
+	if !db.Checkout(string(parent.HeadHash())) {
+		return fmt.Errorf("checkout parent %v failed", common.Base58Encode(parent.HeadHash()))
+	}
+	vm.RecordRandom(blk.Head, db)
+	engine := vm.NewEngine(blk.Head, db)
+	txs := make([]*tx.Tx, 0, len(blk.Txs))
+	receipts := make([]*tx.TxReceipt, 0, len(blk.Txs))
+	for _, t := range blk.Txs {
+		receipt, err := engine.Exec(t, txExecTime)
+		if err != nil {
+			ilog.Errorf("exec tx failed. err=%v, receipt=%v", err, receipt)
+			*delList = append(*delList, t)
+			continue
+		}
+		txs = append(txs, t)
+		receipts = append(receipts, receipt)
+	}
+	blk.Txs, blk.Receipts = txs, receipts
+	return nil
+}
+
 func verifyBasics(head *block.BlockHead, signature *crypto.Signature) error {
 
 	signature.SetPubkey(account.GetPubkeyByID(head.Witness))
@@ -134,18 +240,66 @@ func verifyBasics(head *block.BlockHead, signature *crypto.Signature) error {
 	return nil
 }
 
+// blockUsage returns the total gas usage and the size of the txs and receipts of the block.
+func blockUsage(blk *block.Block) (gas int64, size int64) {
+	for _, t := range blk.Txs {
+		size += int64(len(t.Encode()))
+	}
+	for _, r := range blk.Receipts {
+		gas += r.GasUsage
+		size += int64(len(r.Encode()))
+	}
+	return gas, size
+}
+
+// verifyBlockLimit checks the total gas usage and the serialized size of the block from the limit fork on, and records
+// how full the block is.
+func verifyBlockLimit(blk *block.Block) error {
+	if !common.Forks.IsLimit(blk.Head.Number) {
+		return nil
+	}
+	var gas int64
+	for _, r := range blk.Receipts {
+		gas += r.GasUsage
+	}
+	if gas > common.ConsensusParams.MaxBlockGas {
+		return errBlockGas
+	}
+	b, err := blk.Encode()
+	if err != nil {
+		return err
+	}
+	size := int64(len(b))
+	if size > common.ConsensusParams.MaxBlockSize {
+		return errBlockSize
+	}
+	metricsBlockGasFullness.Set(float64(gas)/float64(common.ConsensusParams.MaxBlockGas), nil)
+	metricsBlockSizeFullness.Set(float64(size)/float64(common.ConsensusParams.MaxBlockSize), nil)
+	return nil
+}
+
 func verifyBlock(blk *block.Block, parent *block.Block, lib *block.Block, txPool txpool.TxPool, db db.MVCCDB) error {
 	err := verifier.VerifyBlockHead(blk, parent, lib)
 	if err != nil {
 		return err
 	}
 
+	err = verifyBlockLimit(blk)
+	if err != nil {
+		return err
+	}
+
 	if witnessOfSlot(blk.Head.Time) != blk.Head.Witness {
 		ilog.Errorf("blk num: %v, time: %v, witness: %v, witness len: %v, witness list: %v",
 			blk.Head.Number, blk.Head.Time, blk.Head.Witness, staticProperty.NumberOfWitnesses, staticProperty.WitnessList)
 		return errWitness
 	}
 
+	err = verifyBeacon(blk, parent)
+	if err != nil {
+		return err
+	}
+
 	// check vote
 	if blk.Head.Number%common.VoteInterval == 0 {
 		if len(blk.Txs) == 0 || strings.Compare(blk.Txs[0].Actions[0].Contract, "iost.vote") != 0 ||
@@ -176,6 +330,19 @@ func verifyBlock(blk *block.Block, parent *block.Block, lib *block.Block, txPool
 	return verifier.VerifyBlockWithVM(blk, db)
 }
 
+// verifyBeacon checks the beacon of the block is the vrf output of its witness on the randomness of the parent,
+// the blocks before the BeaconHeight of the forks have none.
+func verifyBeacon(blk *block.Block, parent *block.Block) error {
+	if !common.Forks.IsBeacon(blk.Head.Number) {
+		return nil
+	}
+	beacon, err := blk.Head.Beacon()
+	if err != nil {
+		return err
+	}
+	return beacon.Verify(parent, blk.Sign.Algorithm, account.GetPubkeyByID(blk.Head.Witness))
+}
+
 func updateWaterMark(node *blockcache.BlockCacheNode) {
 	node.ConfirmUntil = staticProperty.Watermark[node.Witness]
 	if node.Number >= staticProperty.Watermark[node.Witness] {
@@ -183,16 +350,17 @@ func updateWaterMark(node *blockcache.BlockCacheNode) {
 	}
 }
 
-func updateLib(node *blockcache.BlockCacheNode, bc blockcache.BlockCache) {
+func updateLib(node *blockcache.BlockCacheNode, bc blockcache.BlockCache, stat *slotStat) {
 	confirmedNode := calculateConfirm(node, bc.LinkedRoot())
 	if confirmedNode != nil {
+		stat.recordConfirmed(confirmedNode, bc.LinkedRoot())
 		bc.Flush(confirmedNode)
 		metricsConfirmedLength.Set(float64(confirmedNode.Number+1), nil)
 	}
 }
 
 func calculateConfirm(node *blockcache.BlockCacheNode, root *blockcache.BlockCacheNode) *blockcache.BlockCacheNode {
-	confirmLimit := staticProperty.NumberOfWitnesses*2/3 + 1
+	confirmLimit := common.ConsensusParams.ConfirmLimit(staticProperty.NumberOfWitnesses)
 	startNumber := node.Number
 	var confirmNum int64
 	confirmUntilMap := make(map[int64]int64, startNumber-root.Number)
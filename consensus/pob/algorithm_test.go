@@ -1,6 +1,7 @@
 package pob
 
 import (
+	"strings"
 	"testing"
 	"time"
 
@@ -275,6 +276,91 @@ func TestVerifyBasics(t *testing.T) {
 	})
 }
 
+func TestVerifyBlockLimit(t *testing.T) {
+	convey.Convey("Test of verifyBlockLimit", t, func() {
+		secKey := common.Sha256([]byte("secKey of id0"))
+		account0, _ := account.NewAccount(secKey, crypto.Secp256k1)
+		act := tx.NewAction("iost.system", "Transfer", fmt.Sprintf(`["%v","%v",%v]`, testID[0], testID[2], "100"))
+		trx, _ := MakeTx(act)
+		receipt := tx.NewTxReceipt(trx.Hash())
+		receipt.GasUsage = 100
+		blk := &block.Block{
+			Head: &block.BlockHead{
+				Number:  1,
+				Witness: account0.ID,
+				Time:    1,
+			},
+			Txs:      []*tx.Tx{trx},
+			Receipts: []*tx.TxReceipt{&receipt},
+		}
+		blk.CalculateHeadHash()
+		blk.Sign = account0.Sign(blk.HeadHash())
+		b, _ := blk.Encode()
+		defer common.SetConsensusParams(common.ConsensusParams)
+		defer common.SetForks(common.Forks)
+		common.SetForks(&common.ForkConfig{ParallelHeight: -1, MeteringHeight: -1, BeaconHeight: -1, LimitHeight: 1})
+
+		params := *common.ConsensusParams
+		params.MaxBlockGas = 100
+		params.MaxBlockSize = int64(len(b))
+		common.SetConsensusParams(&params)
+		convey.So(verifyBlockLimit(blk), convey.ShouldBeNil)
+
+		params.MaxBlockGas = 99
+		convey.So(verifyBlockLimit(blk), convey.ShouldEqual, errBlockGas)
+
+		params.MaxBlockGas = 100
+		params.MaxBlockSize = int64(len(b)) - 1
+		convey.So(verifyBlockLimit(blk), convey.ShouldEqual, errBlockSize)
+
+		// the blocks before the fork aren't limited
+		common.SetForks(&common.ForkConfig{ParallelHeight: -1, MeteringHeight: -1, BeaconHeight: -1, LimitHeight: 2})
+		convey.So(verifyBlockLimit(blk), convey.ShouldBeNil)
+	})
+}
+
+func TestTrimBlock(t *testing.T) {
+	convey.Convey("Test of trimBlock", t, func() {
+		blk := &block.Block{
+			Head:     &block.BlockHead{Number: 1},
+			Txs:      []*tx.Tx{},
+			Receipts: []*tx.TxReceipt{},
+		}
+		// the receipts are much larger than the reserve
+		content := strings.Repeat("e", int(4*receiptSizeReserve))
+		for i := 0; i < 6; i++ {
+			act := tx.NewAction("iost.system", "Transfer", fmt.Sprintf(`["%v","%v",%v]`, testID[0], testID[2], i))
+			trx, _ := MakeTx(act)
+			receipt := tx.NewTxReceipt(trx.Hash())
+			receipt.GasUsage = 100
+			receipt.Receipts = append(receipt.Receipts, tx.Receipt{Type: tx.EventDefined, Content: content})
+			blk.Txs = append(blk.Txs, trx)
+			blk.Receipts = append(blk.Receipts, &receipt)
+		}
+		gas, size := blockUsage(blk)
+		each := size / 6
+		txs := blk.Txs
+
+		trimBlock(blk, 1, gas, size)
+		convey.So(len(blk.Txs), convey.ShouldEqual, 6)
+
+		trimBlock(blk, 1, gas, 4*each+each/2)
+		convey.So(len(blk.Txs), convey.ShouldEqual, 4)
+		convey.So(len(blk.Receipts), convey.ShouldEqual, 4)
+		convey.So(blk.Txs, convey.ShouldResemble, txs[:4])
+		_, size = blockUsage(blk)
+		convey.So(size, convey.ShouldBeLessThanOrEqualTo, 4*each+each/2)
+
+		trimBlock(blk, 1, 250, size)
+		convey.So(len(blk.Txs), convey.ShouldEqual, 2)
+
+		// the first txs are kept even if they exceed the limits
+		trimBlock(blk, 1, 0, 0)
+		convey.So(len(blk.Txs), convey.ShouldEqual, 1)
+		convey.So(blk.Txs[0], convey.ShouldEqual, txs[0])
+	})
+}
+
 func TestVerifyBlock(t *testing.T) {
 	convey.Convey("Test of verify block", t, func() {
 		secKey := common.Sha256([]byte("secKey of id0"))
@@ -366,3 +452,57 @@ func addBlock(parent *blockcache.BlockCacheNode, number int64, witness string, t
 	}
 	return blockcache.NewBCN(parent, blk)
 }
+
+func TestVerifyBeacon(t *testing.T) {
+	forks := common.Forks
+	defer common.SetForks(forks)
+	acc, err := account.NewAccount(crypto.Ed25519.GenSeckey(), crypto.Ed25519)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sign := func(blk *block.Block) {
+		if err := blk.CalculateHeadHash(); err != nil {
+			t.Fatal(err)
+		}
+		blk.Sign = acc.Sign(blk.HeadHash())
+	}
+	// the chain produced before the beacon, none of the blocks has one
+	chain := []*block.Block{{Head: &block.BlockHead{Witness: acc.ID}}}
+	for i := int64(1); i < 5; i++ {
+		blk := &block.Block{Head: &block.BlockHead{ParentHash: chain[i-1].HeadHash(), Number: i, Witness: acc.ID, Time: i}}
+		sign(blk)
+		chain = append(chain, blk)
+	}
+
+	common.SetForks(nil)
+	for _, blk := range chain[1:] {
+		if err := verifyBeacon(blk, chain[blk.Head.Number-1]); err != nil {
+			t.Fatalf("block %v without the beacon fork: %v", blk.Head.Number, err)
+		}
+	}
+
+	common.SetForks(&common.ForkConfig{ParallelHeight: -1, MeteringHeight: -1, BeaconHeight: 3})
+	for _, blk := range chain[1:3] {
+		if err := verifyBeacon(blk, chain[blk.Head.Number-1]); err != nil {
+			t.Fatalf("block %v before the beacon height: %v", blk.Head.Number, err)
+		}
+	}
+	if err := verifyBeacon(chain[3], chain[2]); err != block.ErrNoBeacon {
+		t.Fatalf("block at the beacon height without beacon: expect %v, got %v", block.ErrNoBeacon, err)
+	}
+	blk := &block.Block{Head: &block.BlockHead{ParentHash: chain[2].HeadHash(), Number: 3, Witness: acc.ID, Time: 3}}
+	beacon, err := block.NewBeacon(chain[2], acc.Algorithm, acc.Seckey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := blk.Head.SetBeacon(beacon); err != nil {
+		t.Fatal(err)
+	}
+	sign(blk)
+	if err := verifyBeacon(blk, chain[2]); err != nil {
+		t.Fatalf("block at the beacon height: %v", err)
+	}
+	if err := verifyBeacon(blk, chain[1]); err != block.ErrBeacon {
+		t.Fatalf("beacon on another parent: expect %v, got %v", block.ErrBeacon, err)
+	}
+}
@@ -0,0 +1,163 @@
+package pob
+
+import (
+	"time"
+
+	"github.com/iost-official/go-iost/account"
+	"github.com/iost-official/go-iost/core/block"
+	"github.com/iost-official/go-iost/core/blockcache"
+	"github.com/iost-official/go-iost/core/event"
+	"github.com/iost-official/go-iost/core/global"
+	"github.com/iost-official/go-iost/core/txpool"
+	"github.com/iost-official/go-iost/db"
+	"github.com/iost-official/go-iost/ilog"
+)
+
+// devPollInterval is how often the pool is checked for the txs whose events were dropped.
+var devPollInterval = time.Second
+
+// DevPoB is a single node consensus for local development.
+// It seals a block as soon as txs arrive, or on a fixed interval, and every block is final at once.
+type DevPoB struct {
+	account      *account.Account
+	baseVariable global.BaseVariable
+	blockCache   blockcache.BlockCache
+	txPool       txpool.TxPool
+	produceDB    db.MVCCDB
+	interval     time.Duration
+	sub          *event.Subscription
+	slotStat     *slotStat
+	pending      chan struct{}
+	exitSignal   chan struct{}
+}
+
+// NewDevPoB init a new DevPoB. Blocks are sealed every interval, or when txs arrive if interval is 0.
+func NewDevPoB(account *account.Account, baseVariable global.BaseVariable, blockCache blockcache.BlockCache, txPool txpool.TxPool, interval time.Duration) *DevPoB {
+	p := DevPoB{
+		account:      account,
+		baseVariable: baseVariable,
+		blockCache:   blockCache,
+		txPool:       txPool,
+		produceDB:    baseVariable.StateDB().Fork(),
+		interval:     interval,
+		slotStat:     newSlotStat(),
+		pending:      make(chan struct{}, 1),
+		exitSignal:   make(chan struct{}),
+	}
+	staticProperty = newStaticProperty(p.account, []string{p.account.ID})
+	return &p
+}
+
+// Start make the DevPoB run.
+func (p *DevPoB) Start() error {
+	p.baseVariable.SetMode(global.ModeNormal)
+	if p.interval == 0 {
+		p.sub = event.NewSubscription(1024, []event.Event_Topic{event.Event_TxPoolAccepted})
+		event.GetEventCollectorInstance().Subscribe(p.sub)
+	}
+	go p.sealLoop()
+	return nil
+}
+
+// SlotCounts returns the produced and missed slots of the witness in each of the SlotWindows.
+func (p *DevPoB) SlotCounts() map[string][]*SlotCount {
+	return p.slotStat.count()
+}
+
+// Stop make the DevPoB stop.
+func (p *DevPoB) Stop() {
+	if p.sub != nil {
+		event.GetEventCollectorInstance().Unsubscribe(p.sub)
+	}
+	close(p.exitSignal)
+}
+
+func (p *DevPoB) sealLoop() {
+	if p.interval > 0 {
+		ticker := time.NewTicker(p.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				p.seal()
+			case <-p.exitSignal:
+				return
+			}
+		}
+	}
+	// the event collector drops the events when the channels are full, so the pool is also polled,
+	// and sealing goes on while a block packs txs and more are pending
+	poll := time.NewTicker(devPollInterval)
+	defer poll.Stop()
+	for {
+		select {
+		case <-p.sub.ReadChan():
+			// txs usually come in bursts, pack all of them into one block
+		L:
+			for {
+				select {
+				case <-p.sub.ReadChan():
+				default:
+					break L
+				}
+			}
+			p.requeue()
+		case <-poll.C:
+			if p.hasPending() {
+				p.requeue()
+			}
+		case <-p.pending:
+			if p.seal() > 0 && p.hasPending() {
+				p.requeue()
+			}
+		case <-p.exitSignal:
+			return
+		}
+	}
+}
+
+// requeue schedules a seal, the requests are merged until the seal runs.
+func (p *DevPoB) requeue() {
+	select {
+	case p.pending <- struct{}{}:
+	default:
+	}
+}
+
+func (p *DevPoB) hasPending() bool {
+	txs, _, err := p.txPool.PendingTxs(1)
+	return err == nil && len(txs) > 0
+}
+
+// seal seals a block, and returns the number of its txs.
+func (p *DevPoB) seal() int {
+	p.txPool.Lock()
+	blk, err := generateBlock(p.account, p.txPool, p.produceDB)
+	p.txPool.Release()
+	if err != nil {
+		ilog.Errorf("generate block failed. err=%v", err)
+		return 0
+	}
+	if err := p.confirm(blk); err != nil {
+		ilog.Errorf("confirm block failed. err=%v", err)
+		return 0
+	}
+	ilog.Infof("sealed block:%v, tx num: %v", blk.Head.Number, len(blk.Txs))
+	return len(blk.Txs)
+}
+
+// confirm links the block to the head and flushes it to the databases.
+func (p *DevPoB) confirm(blk *block.Block) error {
+	node := p.blockCache.Add(blk)
+	p.txPool.AddLinkedNode(node, node)
+	p.blockCache.Link(node)
+	if node.Type != blockcache.Linked {
+		p.blockCache.Del(node)
+		return errSingle
+	}
+	updateWaterMark(node)
+	p.slotStat.recordConfirmed(node, p.blockCache.LinkedRoot())
+	p.blockCache.Flush(node)
+	metricsConfirmedLength.Set(float64(node.Number+1), nil)
+	return nil
+}
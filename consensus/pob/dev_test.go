@@ -0,0 +1,102 @@
+package pob
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/iost-official/go-iost/account"
+	"github.com/iost-official/go-iost/core/blockcache"
+	"github.com/iost-official/go-iost/core/global"
+	"github.com/iost-official/go-iost/core/tx"
+	"github.com/iost-official/go-iost/core/txpool"
+	"github.com/iost-official/go-iost/core/txpool/mock"
+	"github.com/iost-official/go-iost/crypto"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestDevPoB(t *testing.T) {
+	Convey("Test DevPoB", t, func() {
+		baseVariable, err := global.FakeNew()
+		So(err, ShouldBeNil)
+		defer os.RemoveAll("Fakedb")
+		blockCache, err := blockcache.NewBlockCache(baseVariable)
+		So(err, ShouldBeNil)
+		acc, err := account.NewAccount(nil, crypto.Secp256k1)
+		So(err, ShouldBeNil)
+
+		mockController := gomock.NewController(t)
+		defer mockController.Finish()
+		mockTxPool := txpool_mock.NewMockTxPool(mockController)
+		mockTxPool.EXPECT().Lock().AnyTimes()
+		mockTxPool.EXPECT().Release().AnyTimes()
+		mockTxPool.EXPECT().TxTimeOut(gomock.Any()).Return(false).AnyTimes()
+		mockTxPool.EXPECT().DelTxList(gomock.Any()).AnyTimes()
+		mockTxPool.EXPECT().AddLinkedNode(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+		mockTxPool.EXPECT().TxIterator().DoAndReturn(func() (*txpool.Iterator, *blockcache.BlockCacheNode) {
+			return txpool.NewSortedTxMap().Iter(), blockCache.Head()
+		}).AnyTimes()
+
+		p := NewDevPoB(acc, baseVariable, blockCache, mockTxPool, 0)
+		p.seal()
+		p.seal()
+		So(baseVariable.BlockChain().Length(), ShouldEqual, 3)
+		So(blockCache.LinkedRoot().Number, ShouldEqual, 2)
+		So(blockCache.Head(), ShouldEqual, blockCache.LinkedRoot())
+		So(blockCache.LinkedRoot().Witness, ShouldEqual, acc.ID)
+	})
+}
+
+func TestDevPoB_Poll(t *testing.T) {
+	baseVariable, err := global.FakeNew()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll("Fakedb")
+	blockCache, err := blockcache.NewBlockCache(baseVariable)
+	if err != nil {
+		t.Fatal(err)
+	}
+	acc, err := account.NewAccount(nil, crypto.Secp256k1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+	mockTxPool := txpool_mock.NewMockTxPool(mockController)
+	mockTxPool.EXPECT().Lock().AnyTimes()
+	mockTxPool.EXPECT().Release().AnyTimes()
+	mockTxPool.EXPECT().DelTxList(gomock.Any()).AnyTimes()
+	mockTxPool.EXPECT().AddLinkedNode(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	mockTxPool.EXPECT().TxIterator().DoAndReturn(func() (*txpool.Iterator, *blockcache.BlockCacheNode) {
+		return txpool.NewSortedTxMap().Iter(), blockCache.Head()
+	}).AnyTimes()
+	// a tx is pending but its event never arrives
+	polled := make(chan struct{}, 1)
+	mockTxPool.EXPECT().PendingTxs(1).DoAndReturn(func(int) (txpool.TxsList, *blockcache.BlockCacheNode, error) {
+		select {
+		case polled <- struct{}{}:
+			return txpool.TxsList{&tx.Tx{}}, blockCache.Head(), nil
+		default:
+			return nil, blockCache.Head(), nil
+		}
+	}).AnyTimes()
+
+	interval := devPollInterval
+	devPollInterval = 10 * time.Millisecond
+	defer func() { devPollInterval = interval }()
+
+	p := NewDevPoB(acc, baseVariable, blockCache, mockTxPool, 0)
+	p.Start()
+	defer p.Stop()
+	deadline := time.After(3 * time.Second)
+	for blockCache.LinkedRoot().Number < 1 {
+		select {
+		case <-deadline:
+			t.Fatal("pending tx isn't sealed without its event")
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+}
@@ -0,0 +1,137 @@
+package pob
+
+import (
+	"bytes"
+	"errors"
+	"time"
+
+	"github.com/iost-official/go-iost/common"
+	"github.com/iost-official/go-iost/core/block"
+	"github.com/iost-official/go-iost/ilog"
+	"github.com/iost-official/go-iost/metrics"
+	"github.com/iost-official/go-iost/p2p"
+)
+
+var (
+	metricsDoubleSignCount = metrics.NewCounter("iost_pob_double_sign", nil)
+	// slotRecordWindow is the number of recent slots in which the blocks are recorded to detect double-signing.
+	slotRecordWindow int64 = 1200
+	// evidenceSlotWindow is the number of recent slots in which the evidence is accepted, it's the jail time
+	// of the vote contract, 3 vote intervals of 200 blocks, a block in each slot.
+	evidenceSlotWindow int64 = 600
+
+	errEvidenceSchedule = errors.New("witness isn't scheduled in the slot")
+	errEvidenceExpired  = errors.New("slot of the evidence is out of the window")
+	errEvidenceExists   = errors.New("witness has an evidence in the slot")
+)
+
+// checkEvidenceSlot checks that the witness is the scheduled one of the slot by the witness list of the slot,
+// and the slot is in the window before now. Anyone can sign two blocks with a key of his own, only the evidence
+// of the witnesses is kept.
+func checkEvidenceSlot(witness string, slot int64, now int64) error {
+	if slot > now+1 || slot <= now-evidenceSlotWindow {
+		return errEvidenceExpired
+	}
+	list := staticProperty.witnessListOfSlot(slot)
+	if len(list) == 0 || list[slot%int64(len(list))] != witness {
+		return errEvidenceSchedule
+	}
+	return nil
+}
+
+func currentSlot() int64 {
+	return time.Now().Unix() / common.SlotLength
+}
+
+// slotRecorder records the first block of every witness in the recent slots.
+type slotRecorder struct {
+	blocks  map[int64]map[string]*block.Block
+	maxSlot int64
+}
+
+func newSlotRecorder() *slotRecorder {
+	return &slotRecorder{
+		blocks: make(map[int64]map[string]*block.Block),
+	}
+}
+
+// record saves the block and returns the other block signed by the same witness in the same slot if there is one.
+func (r *slotRecorder) record(blk *block.Block) *block.Block {
+	slot := blk.Head.Time
+	if slot <= r.maxSlot-slotRecordWindow {
+		return nil
+	}
+	if slot > r.maxSlot {
+		r.maxSlot = slot
+		for s := range r.blocks {
+			if s <= r.maxSlot-slotRecordWindow {
+				delete(r.blocks, s)
+			}
+		}
+	}
+	witnessBlocks, ok := r.blocks[slot]
+	if !ok {
+		witnessBlocks = make(map[string]*block.Block)
+		r.blocks[slot] = witnessBlocks
+	}
+	prev, ok := witnessBlocks[blk.Head.Witness]
+	if !ok {
+		witnessBlocks[blk.Head.Witness] = blk
+		return nil
+	}
+	if bytes.Equal(prev.HeadHash(), blk.HeadHash()) {
+		return nil
+	}
+	return prev
+}
+
+func (p *PoB) checkDoubleSign(blk *block.Block) {
+	if checkEvidenceSlot(blk.Head.Witness, blk.Head.Time, currentSlot()) != nil {
+		return
+	}
+	prev := p.slotRecorder.record(blk)
+	if prev == nil {
+		return
+	}
+	evidence, err := block.NewEvidence(prev, blk)
+	if err != nil {
+		ilog.Errorf("fail to create double-sign evidence, err:%v", err)
+		return
+	}
+	p.handleEvidence(evidence)
+}
+
+// verifyEvidence checks the evidence received from the peers, the cheap checks go first.
+func (p *PoB) verifyEvidence(evidence *block.Evidence) error {
+	if err := checkEvidenceSlot(evidence.Witness(), evidence.Slot(), currentSlot()); err != nil {
+		return err
+	}
+	if ok, err := p.baseVariable.EvidenceDB().Has(evidence); err != nil || ok {
+		return errEvidenceExists
+	}
+	return evidence.Verify()
+}
+
+// handleEvidence saves the evidence and broadcasts it if it is new. The evidence out of the window
+// is deleted first, only the evidence which can still jail the witness is kept.
+func (p *PoB) handleEvidence(evidence *block.Evidence) {
+	if _, err := p.baseVariable.EvidenceDB().Prune(currentSlot() - evidenceSlotWindow + 1); err != nil {
+		ilog.Errorf("fail to prune double-sign evidence, err:%v", err)
+	}
+	ok, err := p.baseVariable.EvidenceDB().Push(evidence)
+	if err != nil {
+		ilog.Errorf("fail to save double-sign evidence, err:%v", err)
+		return
+	}
+	if !ok {
+		return
+	}
+	ilog.Warnf("witness %v signed two blocks in slot %v", evidence.Witness(), evidence.Slot())
+	metricsDoubleSignCount.Add(1, nil)
+	b, err := evidence.Encode()
+	if err != nil {
+		ilog.Errorf("fail to encode double-sign evidence, err:%v", err)
+		return
+	}
+	p.p2pService.Broadcast(b, p2p.DoubleSignEvidence, p2p.UrgentMessage)
+}
@@ -0,0 +1,101 @@
+package pob
+
+import (
+	"testing"
+
+	"github.com/iost-official/go-iost/account"
+	"github.com/iost-official/go-iost/core/block"
+	"github.com/iost-official/go-iost/crypto"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func genSlotBlock(witness string, slot int64, number int64) *block.Block {
+	blk := &block.Block{
+		Head: &block.BlockHead{
+			Witness: witness,
+			Time:    slot,
+			Number:  number,
+		},
+	}
+	blk.CalculateHeadHash()
+	return blk
+}
+
+func TestSlotRecorder(t *testing.T) {
+	Convey("Test slotRecorder", t, func() {
+		r := newSlotRecorder()
+		a := genSlotBlock("w1", 10, 1)
+		So(r.record(a), ShouldBeNil)
+		So(r.record(a), ShouldBeNil)
+		So(r.record(genSlotBlock("w2", 10, 1)), ShouldBeNil)
+		So(r.record(genSlotBlock("w1", 11, 2)), ShouldBeNil)
+
+		b := genSlotBlock("w1", 10, 2)
+		So(r.record(b), ShouldEqual, a)
+
+		r.record(genSlotBlock("w1", 10+slotRecordWindow, 3))
+		So(r.blocks, ShouldNotContainKey, int64(10))
+		So(r.record(genSlotBlock("w1", 10, 4)), ShouldBeNil)
+	})
+}
+
+func genSignedSlotBlock(acc *account.Account, slot int64, parent string) *block.Block {
+	blk := &block.Block{
+		Head: &block.BlockHead{
+			ParentHash: []byte(parent),
+			Witness:    acc.ID,
+			Time:       slot,
+		},
+	}
+	blk.CalculateHeadHash()
+	blk.Sign = acc.Sign(blk.HeadHash())
+	return blk
+}
+
+func TestCheckEvidenceSlot(t *testing.T) {
+	witness, _ := account.NewAccount(nil, crypto.Secp256k1)
+	forger, _ := account.NewAccount(nil, crypto.Secp256k1)
+	staticProperty = newStaticProperty(witness, []string{witness.ID, "IOSTother"})
+	now := int64(1000)
+
+	// a self-made key signs two blocks in a slot of another witness
+	forged, err := block.NewEvidence(genSignedSlotBlock(forger, now, "a"), genSignedSlotBlock(forger, now, "b"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := checkEvidenceSlot(forged.Witness(), forged.Slot(), now); err != errEvidenceSchedule {
+		t.Fatalf("forged evidence: %v", err)
+	}
+	// the witness signs in the slot of another witness
+	if err := checkEvidenceSlot(witness.ID, now+1, now); err != errEvidenceSchedule {
+		t.Fatalf("out of schedule evidence: %v", err)
+	}
+
+	if err := checkEvidenceSlot(witness.ID, now, now); err != nil {
+		t.Fatalf("evidence of the scheduled witness: %v", err)
+	}
+	if err := checkEvidenceSlot(witness.ID, now-evidenceSlotWindow, now); err != errEvidenceExpired {
+		t.Fatalf("evidence out of the jail window: %v", err)
+	}
+	if err := checkEvidenceSlot(witness.ID, now+2, now); err != errEvidenceExpired {
+		t.Fatalf("evidence of a future slot: %v", err)
+	}
+}
+
+func TestCheckEvidenceSlot_Schedule(t *testing.T) {
+	staticProperty = newStaticProperty(nil, []string{"w0", "w1"})
+	now := currentSlot()
+	old := now - now%2 - 2
+	staticProperty.updateWitness([]string{"w1", "w0"})
+
+	// the evidence in the slots before the change is checked by the list of then
+	if err := checkEvidenceSlot("w0", old, now); err != nil {
+		t.Fatalf("evidence before the change: %v", err)
+	}
+	if err := checkEvidenceSlot("w1", old, now); err != errEvidenceSchedule {
+		t.Fatalf("evidence out of the old schedule: %v", err)
+	}
+	if err := checkEvidenceSlot("w1", now-now%2+2, now+2); err != nil {
+		t.Fatalf("evidence after the change: %v", err)
+	}
+}
@@ -29,6 +29,8 @@ var (
 	metricsConfirmedLength     = metrics.NewGauge("iost_pob_confirmed_length", nil)
 	metricsTxSize              = metrics.NewGauge("iost_block_tx_size", nil)
 	metricsMode                = metrics.NewGauge("iost_node_mode", nil)
+	metricsBlockGasFullness    = metrics.NewGauge("iost_pob_block_gas_fullness", nil)
+	metricsBlockSizeFullness   = metrics.NewGauge("iost_pob_block_size_fullness", nil)
 )
 
 var (
@@ -57,10 +59,13 @@ type PoB struct {
 	verifyDB        db.MVCCDB
 	produceDB       db.MVCCDB
 	blockReqMap     *sync.Map
+	slotRecorder    *slotRecorder
+	slotStat        *slotStat
 	exitSignal      chan struct{}
 	chRecvBlock     chan p2p.IncomingMessage
 	chRecvBlockHash chan p2p.IncomingMessage
 	chQueryBlock    chan p2p.IncomingMessage
+	chRecvEvidence  chan p2p.IncomingMessage
 	chVerifyBlock   chan *verifyBlockMessage
 	//chGenBlock      chan *block.Block
 }
@@ -77,17 +82,26 @@ func NewPoB(account *account.Account, baseVariable global.BaseVariable, blockCac
 		verifyDB:        baseVariable.StateDB(),
 		produceDB:       baseVariable.StateDB().Fork(),
 		blockReqMap:     new(sync.Map),
+		slotRecorder:    newSlotRecorder(),
+		slotStat:        newSlotStat(),
 		exitSignal:      make(chan struct{}),
 		chRecvBlock:     p2pService.Register("consensus channel", p2p.NewBlock, p2p.SyncBlockResponse),
 		chRecvBlockHash: p2pService.Register("consensus block head", p2p.NewBlockHash),
 		chQueryBlock:    p2pService.Register("consensus query block", p2p.NewBlockRequest),
+		chRecvEvidence:  p2pService.Register("consensus evidence", p2p.DoubleSignEvidence),
 		chVerifyBlock:   make(chan *verifyBlockMessage, 1024),
 	}
 
 	staticProperty = newStaticProperty(p.account, blockCache.LinkedRoot().Active())
+	p.recoverWaterMark()
 	return &p
 }
 
+// SlotCounts returns the produced and missed slots of every witness in each of the SlotWindows.
+func (p *PoB) SlotCounts() map[string][]*SlotCount {
+	return p.slotStat.count()
+}
+
 //Start make the PoB run.
 func (p *PoB) Start() error {
 	go p.messageLoop()
@@ -137,6 +151,23 @@ func (p *PoB) messageLoop() {
 				}
 				p.handleBlockQuery(&rh, incomingMessage.From())
 			}
+		case incomingMessage, ok := <-p.chRecvEvidence:
+			if !ok {
+				ilog.Infof("chRecvEvidence has closed")
+				return
+			}
+			var evidence block.Evidence
+			err := evidence.Decode(incomingMessage.Data())
+			if err != nil {
+				continue
+			}
+			if err := p.verifyEvidence(&evidence); err != nil {
+				if err != errEvidenceExists {
+					ilog.Warnf("received wrong double-sign evidence, err:%v", err)
+				}
+				continue
+			}
+			p.handleEvidence(&evidence)
 		case <-p.exitSignal:
 			return
 		}
@@ -208,6 +239,10 @@ func (p *PoB) handleGenesisBlock(blk *block.Block) error {
 		if !bytes.Equal(blk.Receipts[0].Encode(), txr.Encode()) {
 			return fmt.Errorf("wrong tx receipt")
 		}
+		err = global.CheckConsensusParams(p.verifyDB)
+		if err != nil {
+			return fmt.Errorf("check consensus params failed, err:%v", err)
+		}
 		p.verifyDB.Tag(string(blk.HeadHash()))
 		err = p.verifyDB.Flush(string(blk.HeadHash()))
 		if err != nil {
@@ -368,6 +403,7 @@ func (p *PoB) handleRecvBlock(blk *block.Block) error {
 	if err != nil {
 		return err
 	}
+	p.checkDoubleSign(blk)
 	parent, err := p.blockCache.Find(blk.Head.ParentHash)
 	p.blockCache.Add(blk)
 	if err == nil && parent.Type == blockcache.Linked {
@@ -403,9 +439,22 @@ func (p *PoB) addExistingBlock(blk *block.Block, parentBlock *block.Block) error
 	return nil
 }
 
+// recoverWaterMark rebuilds the watermarks of the blocks restored by the block cache at startup.
+func (p *PoB) recoverWaterMark() {
+	queue := []*blockcache.BlockCacheNode{p.blockCache.LinkedRoot()}
+	for len(queue) > 0 {
+		node := queue[0]
+		queue = queue[1:]
+		for child := range node.Children {
+			updateWaterMark(child)
+			queue = append(queue, child)
+		}
+	}
+}
+
 func (p *PoB) updateInfo(node *blockcache.BlockCacheNode) {
 	updateWaterMark(node)
-	updateLib(node, p.blockCache)
+	updateLib(node, p.blockCache, p.slotStat)
 	staticProperty.updateWitness(p.blockCache.LinkedRoot().Active())
 	if staticProperty.isWitness(p.account.ID) {
 		p.p2pService.ConnectBPs(p.blockCache.LinkedRoot().NetID())
@@ -14,6 +14,14 @@ type StaticProperty struct {
 	NumberOfWitnesses int64
 	WitnessList       []string
 	Watermark         map[string]int64
+	// schedules are the witness lists of the recent slots, the oldest first
+	schedules []*witnessSchedule
+}
+
+// witnessSchedule is a witness list and the first slot it's scheduled in.
+type witnessSchedule struct {
+	slot int64
+	list []string
 }
 
 func newStaticProperty(account *account.Account, witnessList []string) *StaticProperty {
@@ -32,6 +40,50 @@ func (property *StaticProperty) updateWitness(witnessList []string) {
 
 	property.NumberOfWitnesses = int64(len(witnessList))
 	property.WitnessList = witnessList
+	property.addSchedule(witnessList)
+}
+
+// addSchedule records the witness list from the current slot if it changes, the first list is used
+// in all the slots before. The lists which aren't used in the evidence window are dropped.
+func (property *StaticProperty) addSchedule(witnessList []string) {
+	n := len(property.schedules)
+	if n == 0 {
+		property.schedules = append(property.schedules, &witnessSchedule{list: witnessList})
+		return
+	}
+	if equalWitnessList(property.schedules[n-1].list, witnessList) {
+		return
+	}
+	slot := currentSlot()
+	property.schedules = append(property.schedules, &witnessSchedule{slot: slot, list: witnessList})
+	for len(property.schedules) > 1 && property.schedules[1].slot <= slot-evidenceSlotWindow {
+		property.schedules = property.schedules[1:]
+	}
+}
+
+// witnessListOfSlot returns the witness list scheduled in the slot.
+func (property *StaticProperty) witnessListOfSlot(slot int64) []string {
+	for i := len(property.schedules) - 1; i > 0; i-- {
+		if property.schedules[i].slot <= slot {
+			return property.schedules[i].list
+		}
+	}
+	if len(property.schedules) == 0 {
+		return property.WitnessList
+	}
+	return property.schedules[0].list
+}
+
+func equalWitnessList(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
 }
 
 func (property *StaticProperty) isWitness(w string) bool {
@@ -0,0 +1,188 @@
+package pob
+
+import (
+	"strconv"
+	"sync"
+
+	"github.com/iost-official/go-iost/core/blockcache"
+	"github.com/iost-official/go-iost/metrics"
+)
+
+var (
+	metricsProducedSlotCount = metrics.NewCounter("iost_pob_produced_slot", []string{"witness"})
+	metricsMissedSlotCount   = metrics.NewCounter("iost_pob_missed_slot", []string{"witness"})
+	metricsMissedSlotRate    = metrics.NewGauge("iost_pob_missed_slot_rate", []string{"witness", "window"})
+)
+
+// SlotWindows are the sizes of the sliding windows, in slots, over which the slots of witnesses are counted.
+var SlotWindows = []int64{100, 1200, 28800}
+
+// SlotCount is the number of produced and missed slots of a witness in a sliding window.
+type SlotCount struct {
+	Window   int64
+	Produced int64
+	Missed   int64
+}
+
+type slotResult struct {
+	slot     int64
+	witness  string
+	produced bool
+}
+
+// slotStat records which slots of the confirmed chain were produced or missed by their witnesses.
+// The counts of the windows are kept while the results slide in and out of them.
+type slotStat struct {
+	mu      sync.RWMutex
+	results []slotResult
+	// starts are the indexes of the first results in the SlotWindows
+	starts []int
+	counts map[string][]*SlotCount
+}
+
+func newSlotStat() *slotStat {
+	return &slotStat{
+		results: make([]slotResult, 0),
+		starts:  make([]int, len(SlotWindows)),
+		counts:  make(map[string][]*SlotCount),
+	}
+}
+
+func maxSlotWindow() int64 {
+	var max int64
+	for _, w := range SlotWindows {
+		if w > max {
+			max = w
+		}
+	}
+	return max
+}
+
+// recordConfirmed records the slots from the root to the confirmed node, it should be called before flushing the node.
+func (s *slotStat) recordConfirmed(confirmed *blockcache.BlockCacheNode, root *blockcache.BlockCacheNode) {
+	nodes := make([]*blockcache.BlockCacheNode, 0)
+	for node := confirmed; node != root && node != nil && node.Parent != nil; node = node.Parent {
+		nodes = append(nodes, node)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	witnesses := make(map[string]bool)
+	for i := len(nodes) - 1; i >= 0; i-- {
+		s.record(nodes[i].Parent, nodes[i], witnesses)
+	}
+	s.trim()
+	s.updateMetrics(witnesses)
+}
+
+// record counts the slots between the parent and the node as missed, and the slot of the node as produced.
+// The witnesses whose counts are changed are added to witnesses.
+func (s *slotStat) record(parent *blockcache.BlockCacheNode, node *blockcache.BlockCacheNode, witnesses map[string]bool) {
+	if parent.Block == nil || node.Block == nil {
+		return
+	}
+	slot := node.Block.Head.Time
+	start := parent.Block.Head.Time + 1
+	if start < slot-maxSlotWindow() {
+		start = slot - maxSlotWindow()
+	}
+	witnessList := parent.Active()
+	if len(witnessList) > 0 {
+		for missed := start; missed < slot; missed++ {
+			witness := witnessList[missed%int64(len(witnessList))]
+			s.add(slotResult{slot: missed, witness: witness}, witnesses)
+			metricsMissedSlotCount.Add(1, map[string]string{"witness": witness})
+		}
+	}
+	s.add(slotResult{slot: slot, witness: node.Witness, produced: true}, witnesses)
+	metricsProducedSlotCount.Add(1, map[string]string{"witness": node.Witness})
+}
+
+// add appends the result to all the windows, and slides the windows to the slot of it.
+func (s *slotStat) add(r slotResult, witnesses map[string]bool) {
+	s.results = append(s.results, r)
+	s.countResult(r, -1, 1)
+	witnesses[r.witness] = true
+	for i, w := range SlotWindows {
+		for s.starts[i] < len(s.results) && r.slot-s.results[s.starts[i]].slot >= w {
+			old := s.results[s.starts[i]]
+			s.countResult(old, i, -1)
+			witnesses[old.witness] = true
+			s.starts[i]++
+		}
+	}
+}
+
+// countResult adds delta to the count of the result in the window i, or in all the windows if i is -1.
+func (s *slotStat) countResult(r slotResult, i int, delta int64) {
+	counts, ok := s.counts[r.witness]
+	if !ok {
+		counts = make([]*SlotCount, len(SlotWindows))
+		for j, w := range SlotWindows {
+			counts[j] = &SlotCount{Window: w}
+		}
+		s.counts[r.witness] = counts
+	}
+	for j, c := range counts {
+		if i >= 0 && i != j {
+			continue
+		}
+		if r.produced {
+			c.Produced += delta
+		} else {
+			c.Missed += delta
+		}
+	}
+}
+
+// trim drops the results out of the largest window, and the witnesses without any result.
+func (s *slotStat) trim() {
+	first := len(s.results)
+	for _, start := range s.starts {
+		if start < first {
+			first = start
+		}
+	}
+	s.results = s.results[first:]
+	for i := range s.starts {
+		s.starts[i] -= first
+	}
+	for witness, counts := range s.counts {
+		empty := true
+		for _, c := range counts {
+			if c.Produced+c.Missed != 0 {
+				empty = false
+			}
+		}
+		if empty {
+			delete(s.counts, witness)
+		}
+	}
+}
+
+func (s *slotStat) updateMetrics(witnesses map[string]bool) {
+	for witness := range witnesses {
+		for _, c := range s.counts[witness] {
+			if c.Produced+c.Missed == 0 {
+				continue
+			}
+			metricsMissedSlotRate.Set(float64(c.Missed)/float64(c.Produced+c.Missed),
+				map[string]string{"witness": witness, "window": strconv.FormatInt(c.Window, 10)})
+		}
+	}
+}
+
+func (s *slotStat) count() map[string][]*SlotCount {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	ret := make(map[string][]*SlotCount, len(s.counts))
+	for witness, counts := range s.counts {
+		cp := make([]*SlotCount, len(counts))
+		for i, c := range counts {
+			cc := *c
+			cp[i] = &cc
+		}
+		ret[witness] = cp
+	}
+	return ret
+}
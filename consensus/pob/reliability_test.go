@@ -0,0 +1,49 @@
+package pob
+
+import (
+	"testing"
+
+	"github.com/iost-official/go-iost/core/block"
+	"github.com/iost-official/go-iost/core/blockcache"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func genSlotNode(parent *blockcache.BlockCacheNode, witness string, slot int64) *blockcache.BlockCacheNode {
+	node := blockcache.NewBCN(parent, &block.Block{
+		Head: &block.BlockHead{
+			Witness: witness,
+			Time:    slot,
+		},
+	})
+	node.SetActive([]string{"w0", "w1", "w2"})
+	return node
+}
+
+func TestSlotStat(t *testing.T) {
+	Convey("Test slotStat", t, func() {
+		s := newSlotStat()
+		root := genSlotNode(nil, "w0", 0)
+		node1 := genSlotNode(root, "w1", 1)
+		node2 := genSlotNode(node1, "w1", 4)
+		node3 := genSlotNode(node2, "w0", 6)
+
+		s.recordConfirmed(node2, root)
+		counts := s.count()
+		So(counts["w1"][0].Produced, ShouldEqual, 2)
+		So(counts["w1"][0].Missed, ShouldEqual, 0)
+		So(counts["w2"][0].Missed, ShouldEqual, 1)
+		So(counts["w0"][0].Missed, ShouldEqual, 1)
+
+		s.recordConfirmed(node3, node2)
+		counts = s.count()
+		So(counts["w0"][0].Produced, ShouldEqual, 1)
+		So(counts["w2"][0].Missed, ShouldEqual, 2)
+
+		node4 := genSlotNode(node3, "w0", 6+SlotWindows[0])
+		s.recordConfirmed(node4, node3)
+		counts = s.count()
+		So(counts["w1"][0].Produced, ShouldEqual, 0)
+		So(counts["w1"][1].Produced, ShouldEqual, 2)
+		So(len(s.results), ShouldEqual, 6+int(SlotWindows[0]))
+	})
+}
@@ -14,6 +14,7 @@ type DownloadController interface {
 	MissionTimeout(hash string, peerID p2p.PeerID)
 	MissionComplete(hash string)
 	FreePeer(hash string, peerID p2p.PeerID)
+	Missions() int64
 	Reset()
 	Start()
 	Stop()
@@ -67,12 +68,14 @@ type DownloadControllerImpl struct {
 	peerMapMutex   *sync.Map
 	newPeerMutex   *sync.Mutex
 	callback       callbackfunc
+	scorer         *peerScorer
 	chDownload     chan struct{}
 	exitSignal     chan struct{}
 }
 
-// NewDownloadController returns a DownloadController instance.
-func NewDownloadController(callback callbackfunc) (*DownloadControllerImpl, error) {
+// NewDownloadController returns a DownloadController instance. The peers are asked for blocks in the order
+// of their scores, and the number of blocks downloading from a peer is limited by its window.
+func NewDownloadController(callback callbackfunc, scorer *peerScorer) (*DownloadControllerImpl, error) {
 	dc := &DownloadControllerImpl{
 		hashState:      new(sync.Map), // map[string]string
 		peerState:      new(sync.Map), // map[PeerID](map[string]bool)
@@ -83,6 +86,7 @@ func NewDownloadController(callback callbackfunc) (*DownloadControllerImpl, erro
 		chDownload:     make(chan struct{}, 2),
 		exitSignal:     make(chan struct{}),
 		callback:       callback,
+		scorer:         scorer,
 	}
 	return dc, nil
 }
@@ -224,6 +228,7 @@ func (dc *DownloadControllerImpl) CreateMission(hash string, p interface{}, peer
 // OnTimeout changes the hash state and frees the peer.
 func (dc *DownloadControllerImpl) MissionTimeout(hash string, peerID p2p.PeerID) {
 	ilog.Debugf("sync timout, hash=%v, peerID=%s", []byte(hash), peerID.Pretty())
+	dc.scorer.timeout(peerID, hash)
 	if hStateIF, ok := dc.hashState.Load(hash); ok {
 		hState, ok := hStateIF.(*hashStateInfo)
 		if !ok {
@@ -271,6 +276,18 @@ func (dc *DownloadControllerImpl) MissionComplete(hash string) {
 	}
 }
 
+// Missions returns the number of the missions not done.
+func (dc *DownloadControllerImpl) Missions() int64 {
+	var n int64
+	dc.hashState.Range(func(k, v interface{}) bool {
+		if hState, ok := v.(*hashStateInfo); ok && hState.state != Done {
+			n++
+		}
+		return true
+	})
+	return n
+}
+
 // FreePeer frees the peer.
 func (dc *DownloadControllerImpl) FreePeer(hash string, peerID p2p.PeerID) {
 	if pStateIF, ok := dc.peerState.Load(peerID); ok {
@@ -308,7 +325,7 @@ func (dc *DownloadControllerImpl) FreePeer(hash string, peerID p2p.PeerID) {
 	}
 }
 
-func (dc *DownloadControllerImpl) findWaitHashes(peerID p2p.PeerID, hashMap *sync.Map, ps timerMap, pmMutex *sync.Mutex, psMutex *sync.Mutex) {
+func (dc *DownloadControllerImpl) findWaitHashes(peerID p2p.PeerID, hashMap *sync.Map, ps timerMap, pmMutex *sync.Mutex, psMutex *sync.Mutex, window int) {
 	pmMutex.Lock()
 	node, ok := dc.getMapEntry(hashMap, Head)
 	if !ok {
@@ -342,7 +359,7 @@ func (dc *DownloadControllerImpl) findWaitHashes(peerID p2p.PeerID, hashMap *syn
 			})
 			psLen := len(ps)
 			psMutex.Unlock()
-			if psLen >= peerConNum {
+			if psLen >= window {
 				return
 			}
 		}
@@ -424,30 +441,39 @@ func (dc *DownloadControllerImpl) downloadLoop() {
 			}
 		case <-dc.chDownload:
 			ilog.Debugf("Download Begin")
+			peers := make([]p2p.PeerID, 0)
 			dc.peerState.Range(func(k, v interface{}) bool {
-				peerID := k.(p2p.PeerID)
+				peers = append(peers, k.(p2p.PeerID))
+				return true
+			})
+			dc.scorer.rank(peers)
+			for _, peerID := range peers {
 				ilog.Debugf("peerID: %s", peerID.Pretty())
+				v, ok := dc.peerState.Load(peerID)
+				if !ok {
+					continue
+				}
 				ps, ok := v.(timerMap)
 				if !ok {
 					ilog.Errorf("get peerstate error: %s", peerID.Pretty())
-					return true
+					continue
 				}
 				pmMutex, pmmok := dc.getPeerMapMutex(peerID)
 				psMutex, psmok := dc.getStateMutex(peerID)
 				hashMap, hmok := dc.getHashMap(peerID)
 				if !psmok || !pmmok || !hmok {
-					return true
+					continue
 				}
+				window := dc.scorer.window(peerID)
 				psMutex.Lock()
 				ilog.Debugf("peerNum: %v", len(ps))
 				psLen := len(ps)
 				psMutex.Unlock()
-				if psLen >= peerConNum {
-					return true
+				if psLen >= window {
+					continue
 				}
-				dc.findWaitHashes(peerID, hashMap, ps, pmMutex, psMutex)
-				return true
-			})
+				dc.findWaitHashes(peerID, hashMap, ps, pmMutex, psMutex, window)
+			}
 			ilog.Debugf("Download End")
 		case <-dc.exitSignal:
 			return
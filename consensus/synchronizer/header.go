@@ -0,0 +1,145 @@
+package synchronizer
+
+import (
+	"sync"
+
+	"github.com/iost-official/go-iost/consensus/verifier"
+	"github.com/iost-official/go-iost/core/block"
+)
+
+var maxOrphanHeaders = 2 * int(maxBlockHashQueryNumber)
+
+// headerChain keeps the block heads validated before downloading the blocks. A head is valid if it
+// links to a valid head or a block in the block cache and is signed by its witness. The heads whose
+// parents aren't known yet wait for them, the oldest waiting head is dropped when too many are waiting.
+type headerChain struct {
+	mu      sync.Mutex
+	valid   map[string]*block.Block
+	orphans map[string][]*block.Block
+	norphan int
+	order   []*block.Block // the waiting heads in the order they are added, may contain the heads not waiting any more
+}
+
+func newHeaderChain() *headerChain {
+	return &headerChain{
+		valid:   make(map[string]*block.Block),
+		orphans: make(map[string][]*block.Block),
+	}
+}
+
+// get returns the valid head with the hash as a block without txs.
+func (hc *headerChain) get(hash []byte) *block.Block {
+	hc.mu.Lock()
+	defer hc.mu.Unlock()
+	return hc.valid[string(hash)]
+}
+
+// add validates the head, known returns the block in the block cache and schedules are the witness lists
+// the heads may be produced by. It returns the heads validated by adding it, which may include the waiting
+// heads linking to it.
+func (hc *headerChain) add(blk *block.Block, known func(hash []byte) *block.Block, schedules [][]string) ([]*block.Block, error) {
+	hc.mu.Lock()
+	defer hc.mu.Unlock()
+	if _, ok := hc.valid[string(blk.HeadHash())]; ok {
+		return nil, nil
+	}
+	parent, ok := hc.valid[string(blk.Head.ParentHash)]
+	if !ok {
+		parent = known(blk.Head.ParentHash)
+	}
+	if parent == nil {
+		hc.wait(blk)
+		return nil, nil
+	}
+	if err := verifier.VerifyHeader(blk, parent, schedules); err != nil {
+		return nil, err
+	}
+	validated := []*block.Block{blk}
+	hc.valid[string(blk.HeadHash())] = blk
+	for i := 0; i < len(validated); i++ {
+		hash := string(validated[i].HeadHash())
+		children := hc.orphans[hash]
+		delete(hc.orphans, hash)
+		hc.norphan -= len(children)
+		for _, child := range children {
+			if _, ok := hc.valid[string(child.HeadHash())]; ok {
+				continue
+			}
+			if verifier.VerifyHeader(child, validated[i], schedules) != nil {
+				continue
+			}
+			hc.valid[string(child.HeadHash())] = child
+			validated = append(validated, child)
+		}
+	}
+	return validated, nil
+}
+
+// index returns the index of the head in the heads waiting for its parent, or -1 if it isn't waiting.
+func (hc *headerChain) index(blk *block.Block) int {
+	for i, b := range hc.orphans[string(blk.Head.ParentHash)] {
+		if b == blk {
+			return i
+		}
+	}
+	return -1
+}
+
+func (hc *headerChain) waiting(blk *block.Block) bool {
+	for _, b := range hc.orphans[string(blk.Head.ParentHash)] {
+		if string(b.HeadHash()) == string(blk.HeadHash()) {
+			return true
+		}
+	}
+	return false
+}
+
+// wait keeps the head until its parent is validated, and drops the oldest waiting heads if too many are waiting.
+func (hc *headerChain) wait(blk *block.Block) {
+	if hc.waiting(blk) {
+		return
+	}
+	for hc.norphan >= maxOrphanHeaders && len(hc.order) > 0 {
+		oldest := hc.order[0]
+		hc.order = hc.order[1:]
+		if i := hc.index(oldest); i >= 0 {
+			key := string(oldest.Head.ParentHash)
+			blks := append(hc.orphans[key][:i:i], hc.orphans[key][i+1:]...)
+			if len(blks) == 0 {
+				delete(hc.orphans, key)
+			} else {
+				hc.orphans[key] = blks
+			}
+			hc.norphan--
+		}
+	}
+	hc.orphans[string(blk.Head.ParentHash)] = append(hc.orphans[string(blk.Head.ParentHash)], blk)
+	hc.norphan++
+	hc.order = append(hc.order, blk)
+	if len(hc.order) > 2*maxOrphanHeaders {
+		order := make([]*block.Block, 0, hc.norphan)
+		for _, b := range hc.order {
+			if hc.index(b) >= 0 {
+				order = append(order, b)
+			}
+		}
+		hc.order = order
+	}
+}
+
+// prune drops the heads not after the number.
+func (hc *headerChain) prune(number int64) {
+	hc.mu.Lock()
+	defer hc.mu.Unlock()
+	for k, blk := range hc.valid {
+		if blk.Head.Number <= number {
+			delete(hc.valid, k)
+		}
+	}
+	for k, blks := range hc.orphans {
+		if len(blks) > 0 && blks[0].Head.Number <= number {
+			hc.norphan -= len(blks)
+			delete(hc.orphans, k)
+		}
+	}
+}
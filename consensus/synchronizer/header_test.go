@@ -0,0 +1,177 @@
+package synchronizer
+
+import (
+	"testing"
+
+	"github.com/iost-official/go-iost/account"
+	"github.com/iost-official/go-iost/common"
+	"github.com/iost-official/go-iost/consensus/verifier"
+	"github.com/iost-official/go-iost/core/block"
+	"github.com/iost-official/go-iost/crypto"
+	"github.com/iost-official/go-iost/p2p"
+)
+
+func signedHeader(t *testing.T, acc *account.Account, parent *block.Block) *block.Block {
+	blk := &block.Block{
+		Head: &block.BlockHead{
+			ParentHash: parent.HeadHash(),
+			Number:     parent.Head.Number + 1,
+			Time:       parent.Head.Time + 1,
+			Witness:    acc.ID,
+		},
+	}
+	if err := blk.CalculateHeadHash(); err != nil {
+		t.Fatal(err)
+	}
+	blk.Sign = acc.Sign(blk.HeadHash())
+	return blk
+}
+
+func TestHeaderChain(t *testing.T) {
+	acc, err := account.NewAccount(common.Sha256([]byte("witness")), crypto.Secp256k1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	root := &block.Block{Head: &block.BlockHead{Number: 10, Time: 100}}
+	root.CalculateHeadHash()
+	known := func(hash []byte) *block.Block {
+		if string(hash) == string(root.HeadHash()) {
+			return root
+		}
+		return nil
+	}
+
+	other := "IOST2g5LzaXkjAwpxCnCm29HK69wdbyRKbfG4BQQT7Yuqk57bgTFkY"
+	schedules := [][]string{{acc.ID}, {other}}
+	h1 := signedHeader(t, acc, root)
+	h2 := signedHeader(t, acc, h1)
+	h3 := signedHeader(t, acc, h2)
+	hc := newHeaderChain()
+
+	validated, err := hc.add(h3, known, schedules)
+	if err != nil || len(validated) != 0 {
+		t.Fatalf("orphan head: validated %v, err %v", validated, err)
+	}
+	validated, err = hc.add(h2, known, schedules)
+	if err != nil || len(validated) != 0 {
+		t.Fatalf("orphan head: validated %v, err %v", validated, err)
+	}
+	validated, err = hc.add(h1, known, schedules)
+	if err != nil || len(validated) != 3 {
+		t.Fatalf("linked heads: validated %v, err %v", validated, err)
+	}
+	if hc.get(h3.HeadHash()) == nil {
+		t.Fatal("h3 should be valid")
+	}
+
+	forged := signedHeader(t, acc, h3)
+	forged.Head.Witness = other
+	forged.CalculateHeadHash()
+	if _, err := hc.add(forged, known, schedules); err == nil {
+		t.Fatal("head signed by another account should be invalid")
+	}
+	if _, err := hc.add(signedHeader(t, acc, h3), known, [][]string{{other}}); err != verifier.ErrSchedule {
+		t.Fatalf("head of the witness not scheduled for the slot: err %v", err)
+	}
+	broken := signedHeader(t, acc, h3)
+	broken.Head.Number = 20
+	broken.CalculateHeadHash()
+	broken.Sign = acc.Sign(broken.HeadHash())
+	if _, err := hc.add(broken, known, schedules); err == nil {
+		t.Fatal("head with wrong number should be invalid")
+	}
+
+	h4 := signedHeader(t, acc, h3)
+	common.SetCheckpoints([]*common.CheckpointConfig{{Number: 14, Hash: common.Base58Encode([]byte("another block"))}})
+	_, err = hc.add(h4, known, schedules)
+	common.SetCheckpoints(nil)
+	if err != verifier.ErrCheckpoint {
+		t.Fatalf("head conflicting with checkpoint: err %v", err)
+	}
+
+	hc.prune(12)
+	if hc.get(h2.HeadHash()) != nil || hc.get(h3.HeadHash()) == nil {
+		t.Fatal("heads not after 12 should be pruned")
+	}
+}
+
+func TestHeaderChain_Orphans(t *testing.T) {
+	defer func(n int) { maxOrphanHeaders = n }(maxOrphanHeaders)
+	maxOrphanHeaders = 3
+	acc, err := account.NewAccount(common.Sha256([]byte("witness")), crypto.Secp256k1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	schedules := [][]string{{acc.ID}}
+	root := &block.Block{Head: &block.BlockHead{Number: 10, Time: 100}}
+	root.CalculateHeadHash()
+	known := func(hash []byte) *block.Block {
+		if string(hash) == string(root.HeadHash()) {
+			return root
+		}
+		return nil
+	}
+	heads := []*block.Block{signedHeader(t, acc, root)}
+	for i := 0; i < 5; i++ {
+		heads = append(heads, signedHeader(t, acc, heads[i]))
+	}
+
+	hc := newHeaderChain()
+	for _, h := range heads[1:] {
+		// the same head is kept once
+		for k := 0; k < 2; k++ {
+			if _, err := hc.add(h, known, schedules); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	if hc.norphan != maxOrphanHeaders {
+		t.Fatalf("waiting heads: %v", hc.norphan)
+	}
+	// the oldest heads 1 and 2 are dropped, the heads after them wait for them again
+	for i, n := range []int{1, 1, 4} {
+		validated, err := hc.add(heads[i], known, schedules)
+		if err != nil || len(validated) != n {
+			t.Fatalf("head %v: validated %v, err %v", i, validated, err)
+		}
+	}
+	if hc.norphan != 0 || len(hc.orphans) != 0 {
+		t.Fatalf("waiting heads: %v", hc.norphan)
+	}
+}
+
+func TestPeerScorer(t *testing.T) {
+	ps := newPeerScorer()
+	ps.requested("fast", "a")
+	ps.requested("slow", "b")
+	ps.requested("bad", "c")
+	if !ps.received("fast", "a", 100000) {
+		t.Fatal("block a is requested from fast")
+	}
+	if ps.received("fast", "b", 100) {
+		t.Fatal("block b isn't requested from fast")
+	}
+	ps.timeout("slow", "b")
+	ps.bad("bad")
+
+	peers := []p2p.PeerID{"bad", "slow", "fast", "new"}
+	ps.rank(peers)
+	if peers[0] != "new" || peers[1] != "fast" {
+		t.Fatalf("wrong rank: %v", peers)
+	}
+	if ps.window("fast") <= ps.window("new") || ps.window("slow") >= ps.window("new") {
+		t.Fatalf("wrong windows: fast %v, new %v, slow %v", ps.window("fast"), ps.window("new"), ps.window("slow"))
+	}
+
+	ps.requested("slow", "d")
+	ps.prune(func(peerID p2p.PeerID) bool { return peerID == "fast" })
+	if _, ok := ps.peers["fast"]; !ok {
+		t.Fatal("alive peer is pruned")
+	}
+	if _, ok := ps.peers["slow"]; !ok {
+		t.Fatal("peer serving a block is pruned")
+	}
+	if _, ok := ps.peers["new"]; ok {
+		t.Fatal("peer not alive is kept")
+	}
+}
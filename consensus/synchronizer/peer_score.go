@@ -0,0 +1,150 @@
+package synchronizer
+
+import (
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/iost-official/go-iost/p2p"
+)
+
+var (
+	scoreAlpha      = 0.2
+	minPeerWindow   = 1
+	badBlockPenalty = 5.0
+)
+
+type peerScore struct {
+	latency    time.Duration // moving average of the time from requesting a block to receiving it
+	throughput float64       // moving average of the received bytes per second
+	failures   float64       // decays with the blocks served
+	window     int
+	requests   map[string]time.Time
+}
+
+// peerScorer measures how fast and reliable the peers serve blocks. The peers are ranked by their
+// throughput over latency, and the number of blocks requested from a peer at the same time grows with
+// each block it serves and halves when it times out or serves a bad block.
+type peerScorer struct {
+	mu    sync.Mutex
+	peers map[p2p.PeerID]*peerScore
+}
+
+func newPeerScorer() *peerScorer {
+	return &peerScorer{
+		peers: make(map[p2p.PeerID]*peerScore),
+	}
+}
+
+func (ps *peerScorer) get(peerID p2p.PeerID) *peerScore {
+	s, ok := ps.peers[peerID]
+	if !ok {
+		s = &peerScore{
+			window:   peerConNum / 2,
+			requests: make(map[string]time.Time),
+		}
+		ps.peers[peerID] = s
+	}
+	return s
+}
+
+func (ps *peerScorer) requested(peerID p2p.PeerID, hash string) {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	ps.get(peerID).requests[hash] = time.Now()
+}
+
+// received records the block of size bytes from the peer, returns false if it isn't requested from the peer.
+func (ps *peerScorer) received(peerID p2p.PeerID, hash string, size int) bool {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	s := ps.get(peerID)
+	start, ok := s.requests[hash]
+	if !ok {
+		return false
+	}
+	delete(s.requests, hash)
+	latency := time.Since(start)
+	if latency <= 0 {
+		latency = time.Microsecond
+	}
+	throughput := float64(size) / latency.Seconds()
+	if s.latency == 0 {
+		s.latency = latency
+		s.throughput = throughput
+	} else {
+		s.latency = time.Duration((1-scoreAlpha)*float64(s.latency) + scoreAlpha*float64(latency))
+		s.throughput = (1-scoreAlpha)*s.throughput + scoreAlpha*throughput
+	}
+	s.failures *= 1 - scoreAlpha
+	if s.window < peerConNum {
+		s.window++
+	}
+	return true
+}
+
+func (ps *peerScorer) penalize(peerID p2p.PeerID, failures float64) {
+	s := ps.get(peerID)
+	s.failures += failures
+	s.window /= 2
+	if s.window < minPeerWindow {
+		s.window = minPeerWindow
+	}
+}
+
+func (ps *peerScorer) timeout(peerID p2p.PeerID, hash string) {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	delete(ps.get(peerID).requests, hash)
+	ps.penalize(peerID, 1)
+}
+
+func (ps *peerScorer) bad(peerID p2p.PeerID) {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	ps.penalize(peerID, badBlockPenalty)
+}
+
+// prune drops the scores of the peers which aren't alive and aren't serving any block, like the disconnected peers.
+func (ps *peerScorer) prune(alive func(p2p.PeerID) bool) {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	for peerID, s := range ps.peers {
+		if len(s.requests) == 0 && !alive(peerID) {
+			delete(ps.peers, peerID)
+		}
+	}
+}
+
+// window returns the max number of blocks requested from the peer at the same time.
+func (ps *peerScorer) window(peerID p2p.PeerID) int {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	return ps.get(peerID).window
+}
+
+func (ps *peerScorer) score(peerID p2p.PeerID) float64 {
+	s := ps.get(peerID)
+	if s.latency == 0 {
+		// a new peer is tried first, a peer which never serves a block is tried last
+		if s.failures == 0 {
+			return math.Inf(1)
+		}
+		return 0
+	}
+	return s.throughput / s.latency.Seconds() / (1 + s.failures)
+}
+
+// rank sorts the peers from the best to the worst.
+func (ps *peerScorer) rank(peers []p2p.PeerID) {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	scores := make(map[p2p.PeerID]float64, len(peers))
+	for _, p := range peers {
+		scores[p] = ps.score(p)
+	}
+	sort.SliceStable(peers, func(i, j int) bool {
+		return scores[peers[i]] > scores[peers[j]]
+	})
+}
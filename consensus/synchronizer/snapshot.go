@@ -0,0 +1,582 @@
+package synchronizer
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/iost-official/go-iost/common"
+	"github.com/iost-official/go-iost/core/block"
+	"github.com/iost-official/go-iost/core/blockcache"
+	"github.com/iost-official/go-iost/core/global"
+	"github.com/iost-official/go-iost/core/message"
+	"github.com/iost-official/go-iost/db"
+	"github.com/iost-official/go-iost/ilog"
+	"github.com/iost-official/go-iost/p2p"
+)
+
+var (
+	snapshotKeep                   = 2
+	snapshotInfoTime               = 10 * time.Second
+	snapshotChunkTimeout           = 30 * time.Second
+	maxSnapshotChunkRequests       = 8
+	snapshotRespChanSize           = 1024
+	errSnapshotQuorum              = errors.New("not enough peers serving the same snapshot")
+	errSnapshotPeers               = errors.New("no peer serving the snapshot")
+	errSnapshotStop                = errors.New("snapshot sync stopped")
+	errSnapshotNotSupported        = errors.New("statedb doesn't support snapshot")
+	errSnapshotBlockNotMatch       = errors.New("snapshot block doesn't match")
+	errSnapshotGenesisNotMatch     = errors.New("snapshot genesis block doesn't match")
+	errSnapshotBlockHashNotMatch   = errors.New("block hash doesn't match")
+	errSnapshotBlockTxsNotMatch    = errors.New("block txs don't match the head")
+	errSnapshotBlockMerkleNotMatch = errors.New("block receipts don't match the head")
+	errSnapshotRootNotMatch        = errors.New("snapshot root doesn't match the trusted root")
+	errSnapshotUntrusted           = errors.New("snapshot is trusted by neither the trusted root nor a quorum of at least 2 peers")
+	errSnapshotGenesisUnknown      = errors.New("genesis hash is neither configured nor a checkpoint")
+)
+
+// servedSnapshot is a snapshot whose chunks are written in the files named by their indexes in dir.
+type servedSnapshot struct {
+	info *message.SnapshotInfo
+	dir  string
+}
+
+type chunkRequest struct {
+	peerID   p2p.PeerID
+	deadline time.Time
+}
+
+// snapshotService takes snapshots of the state at the flushed blocks whose numbers are multiples of
+// the interval and serves them to other nodes. A new node downloads the snapshot at the trusted block
+// from the peers instead of executing all the blocks from the genesis block.
+//
+// The block head has no state root, so a snapshot is only trusted if its root, the hash of the chunk hashes,
+// is the trusted root published with the trusted block, or if at least two peers serve the same chunks with
+// the trusted block, then the peers are trusted not to collude. Each chunk is checked against the trusted
+// chunk hashes when it's downloaded.
+//
+// A snapshot is dumped in the background from the view of the state at its block, the chunks are written
+// to files, so neither the flush of the later blocks nor the memory waits for it.
+type snapshotService struct {
+	p2pService   p2p.Service
+	blockCache   blockcache.BlockCache
+	basevariable global.BaseVariable
+	interval     int64
+	dir          string
+	trustedHash  []byte
+	trustedRoot  []byte
+	quorum       int
+
+	mu        sync.RWMutex
+	snapshots []*servedSnapshot
+	taking    int32
+	wg        sync.WaitGroup
+
+	msgChan    chan p2p.IncomingMessage
+	infoChan   chan p2p.IncomingMessage
+	chunkChan  chan p2p.IncomingMessage
+	exitSignal chan struct{}
+}
+
+func newSnapshotService(basevariable global.BaseVariable, blkcache blockcache.BlockCache, p2pserv p2p.Service) *snapshotService {
+	ss := &snapshotService{
+		p2pService:   p2pserv,
+		blockCache:   blkcache,
+		basevariable: basevariable,
+		quorum:       1,
+		snapshots:    make([]*servedSnapshot, 0),
+		infoChan:     make(chan p2p.IncomingMessage, snapshotRespChanSize),
+		chunkChan:    make(chan p2p.IncomingMessage, snapshotRespChanSize),
+		exitSignal:   make(chan struct{}),
+	}
+	if conf := basevariable.Config(); conf != nil && conf.Snapshot != nil {
+		if conf.Snapshot.Serve && conf.Snapshot.Interval > 0 && conf.DB != nil {
+			ss.interval = conf.Snapshot.Interval
+			// the snapshots taken before the restart aren't served any more
+			ss.dir = conf.DB.LdbPath + "Snapshot/"
+			if err := os.RemoveAll(ss.dir); err != nil {
+				ilog.Errorf("remove old snapshots failed. err=%v", err)
+			}
+			blkcache.SetFlushHandler(ss.take)
+		}
+		if conf.Snapshot.TrustedHash != "" {
+			ss.trustedHash = common.Base58Decode(conf.Snapshot.TrustedHash)
+		}
+		if conf.Snapshot.TrustedRoot != "" {
+			ss.trustedRoot = common.Base58Decode(conf.Snapshot.TrustedRoot)
+		}
+		if conf.Snapshot.Quorum > 1 {
+			ss.quorum = conf.Snapshot.Quorum
+		}
+	}
+	ss.msgChan = p2pserv.Register("snapshot message",
+		p2p.SnapshotInfoRequest,
+		p2p.SnapshotInfoResponse,
+		p2p.SnapshotChunkRequest,
+		p2p.SnapshotChunkResponse,
+	)
+	return ss
+}
+
+func (ss *snapshotService) start() {
+	go ss.messageLoop()
+}
+
+func (ss *snapshotService) stop() {
+	close(ss.exitSignal)
+	ss.wg.Wait()
+}
+
+// take takes the view of the state if the flushed block is at the interval, and dumps it in the background.
+// The block is skipped if the last snapshot is still being dumped.
+func (ss *snapshotService) take(blk *block.Block) {
+	if blk.Head.Number == 0 || blk.Head.Number%ss.interval != 0 {
+		return
+	}
+	if !atomic.CompareAndSwapInt32(&ss.taking, 0, 1) {
+		ilog.Warnf("skip snapshot at block %v, the last one is still being taken", blk.Head.Number)
+		return
+	}
+	v, info, err := ss.view(blk)
+	if err != nil {
+		atomic.StoreInt32(&ss.taking, 0)
+		ilog.Errorf("take snapshot failed. err=%v", err)
+		return
+	}
+	ss.wg.Add(1)
+	go ss.dump(v, info, blk.Head.Number)
+}
+
+func (ss *snapshotService) view(blk *block.Block) (*db.StateView, *message.SnapshotInfo, error) {
+	sn, ok := ss.basevariable.StateDB().(db.Snapshotter)
+	if !ok {
+		return nil, nil, errSnapshotNotSupported
+	}
+	blkByte, err := blk.Encode()
+	if err != nil {
+		return nil, nil, fmt.Errorf("encode block failed. err: %v", err)
+	}
+	genesisHash, err := ss.basevariable.BlockChain().GetHashByNumber(0)
+	if err != nil {
+		return nil, nil, fmt.Errorf("get genesis block failed. err: %v", err)
+	}
+	genesisByte, err := ss.basevariable.BlockChain().GetBlockByteByHash(genesisHash)
+	if err != nil {
+		return nil, nil, fmt.Errorf("get genesis block failed. err: %v", err)
+	}
+	v, err := sn.View()
+	if err != nil {
+		return nil, nil, err
+	}
+	if v.Tag != string(blk.HeadHash()) {
+		v.Release()
+		return nil, nil, errSnapshotBlockNotMatch
+	}
+	info := &message.SnapshotInfo{
+		Hash:        blk.HeadHash(),
+		ChunkHashes: make([][]byte, 0),
+		Block:       blkByte,
+		Genesis:     genesisByte,
+	}
+	return v, info, nil
+}
+
+func (ss *snapshotService) dump(v *db.StateView, info *message.SnapshotInfo, number int64) {
+	defer ss.wg.Done()
+	defer atomic.StoreInt32(&ss.taking, 0)
+	defer v.Release()
+
+	start := time.Now()
+	dir := filepath.Join(ss.dir, common.Base58Encode(info.Hash))
+	if err := ss.writeChunks(v, dir, info); err != nil {
+		os.RemoveAll(dir)
+		ilog.Errorf("take snapshot failed. err=%v", err)
+		return
+	}
+	ss.mu.Lock()
+	ss.snapshots = append(ss.snapshots, &servedSnapshot{info: info, dir: dir})
+	var evicted []*servedSnapshot
+	if len(ss.snapshots) > snapshotKeep {
+		evicted = ss.snapshots[:len(ss.snapshots)-snapshotKeep]
+		ss.snapshots = ss.snapshots[len(ss.snapshots)-snapshotKeep:]
+	}
+	ss.mu.Unlock()
+	for _, s := range evicted {
+		os.RemoveAll(s.dir)
+	}
+	ilog.Infof("take snapshot at block %v, hash: %v, root: %v, chunks: %v, time: %v", number, common.Base58Encode(info.Hash),
+		common.Base58Encode(db.SnapshotRoot(info.ChunkHashes)), len(info.ChunkHashes), time.Since(start))
+}
+
+// writeChunks writes the chunks of the view to the files in dir, and appends their hashes to the info.
+func (ss *snapshotService) writeChunks(v *db.StateView, dir string, info *message.SnapshotInfo) error {
+	if err := os.RemoveAll(dir); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	return v.Chunks(func(chunk []byte) error {
+		select {
+		case <-ss.exitSignal:
+			return errSnapshotStop
+		default:
+		}
+		err := ioutil.WriteFile(filepath.Join(dir, strconv.Itoa(len(info.ChunkHashes))), chunk, 0644)
+		if err != nil {
+			return err
+		}
+		info.ChunkHashes = append(info.ChunkHashes, common.Sha3(chunk))
+		return nil
+	})
+}
+
+func (ss *snapshotService) get(hash []byte) *servedSnapshot {
+	ss.mu.RLock()
+	defer ss.mu.RUnlock()
+	for _, s := range ss.snapshots {
+		if bytes.Equal(s.info.Hash, hash) {
+			return s
+		}
+	}
+	return nil
+}
+
+func (ss *snapshotService) messageLoop() {
+	for {
+		select {
+		case req := <-ss.msgChan:
+			switch req.Type() {
+			case p2p.SnapshotInfoRequest:
+				var r message.SnapshotInfoRequest
+				if err := r.Unmarshal(req.Data()); err != nil {
+					ilog.Errorf("unmarshal SnapshotInfoRequest failed:%v", err)
+					break
+				}
+				go ss.handleInfoRequest(&r, req.From())
+			case p2p.SnapshotChunkRequest:
+				var r message.SnapshotChunkRequest
+				if err := r.Unmarshal(req.Data()); err != nil {
+					ilog.Errorf("unmarshal SnapshotChunkRequest failed:%v", err)
+					break
+				}
+				go ss.handleChunkRequest(&r, req.From())
+			case p2p.SnapshotInfoResponse:
+				select {
+				case ss.infoChan <- req:
+				default:
+				}
+			case p2p.SnapshotChunkResponse:
+				select {
+				case ss.chunkChan <- req:
+				default:
+				}
+			}
+		case <-ss.exitSignal:
+			return
+		}
+	}
+}
+
+func (ss *snapshotService) handleInfoRequest(r *message.SnapshotInfoRequest, peerID p2p.PeerID) {
+	s := ss.get(r.Hash)
+	if s == nil {
+		return
+	}
+	b, err := s.info.Marshal()
+	if err != nil {
+		ilog.Errorf("marshal SnapshotInfo failed. err=%v", err)
+		return
+	}
+	ss.p2pService.SendToPeer(peerID, b, p2p.SnapshotInfoResponse, p2p.NormalMessage)
+}
+
+func (ss *snapshotService) handleChunkRequest(r *message.SnapshotChunkRequest, peerID p2p.PeerID) {
+	s := ss.get(r.Hash)
+	if s == nil || r.Index < 0 || int(r.Index) >= len(s.info.ChunkHashes) {
+		return
+	}
+	data, err := ioutil.ReadFile(filepath.Join(s.dir, strconv.Itoa(int(r.Index))))
+	if err != nil {
+		ilog.Warnf("read snapshot chunk failed. err=%v", err)
+		return
+	}
+	resp := &message.SnapshotChunk{Hash: r.Hash, Index: r.Index, Data: data}
+	b, err := resp.Marshal()
+	if err != nil {
+		ilog.Errorf("marshal SnapshotChunk failed. err=%v", err)
+		return
+	}
+	ss.p2pService.SendToPeer(peerID, b, p2p.SnapshotChunkResponse, p2p.NormalMessage)
+}
+
+func decodeBlock(b []byte, hash []byte) (*block.Block, error) {
+	var blk block.Block
+	if err := blk.Decode(b); err != nil {
+		return nil, err
+	}
+	if hash != nil && !bytes.Equal(blk.HeadHash(), hash) {
+		return nil, errSnapshotBlockHashNotMatch
+	}
+	if !bytes.Equal(blk.CalculateTxsHash(), blk.Head.TxsHash) {
+		return nil, errSnapshotBlockTxsNotMatch
+	}
+	if !bytes.Equal(blk.CalculateMerkleHash(), blk.Head.MerkleHash) {
+		return nil, errSnapshotBlockMerkleNotMatch
+	}
+	return &blk, nil
+}
+
+// genesisHash returns the hash of the genesis block in the config, or the checkpoint at 0.
+func (ss *snapshotService) genesisHash() []byte {
+	if conf := ss.basevariable.Config(); conf != nil && conf.Genesis != nil && conf.Genesis.GenesisHash != "" {
+		return common.Base58Decode(conf.Genesis.GenesisHash)
+	}
+	if hash, ok := common.CheckpointHash(0); ok {
+		return hash
+	}
+	return nil
+}
+
+// verifyInfo checks the block of the snapshot is the trusted block, the genesis block is ours, and the snapshot
+// has the trusted root if it's given.
+func (ss *snapshotService) verifyInfo(info *message.SnapshotInfo) (*block.Block, *block.Block, error) {
+	if !bytes.Equal(info.Hash, ss.trustedHash) {
+		return nil, nil, errSnapshotBlockNotMatch
+	}
+	if ss.trustedRoot != nil && !bytes.Equal(db.SnapshotRoot(info.ChunkHashes), ss.trustedRoot) {
+		return nil, nil, errSnapshotRootNotMatch
+	}
+	blk, err := decodeBlock(info.Block, ss.trustedHash)
+	if err != nil {
+		return nil, nil, err
+	}
+	genesisHash := ss.genesisHash()
+	if genesisHash == nil {
+		return nil, nil, errSnapshotGenesisUnknown
+	}
+	genesis, err := decodeBlock(info.Genesis, genesisHash)
+	if err != nil {
+		return nil, nil, err
+	}
+	if genesis.Head.Number != 0 {
+		return nil, nil, errSnapshotGenesisNotMatch
+	}
+	return blk, genesis, nil
+}
+
+type snapshotCandidate struct {
+	info    *message.SnapshotInfo
+	blk     *block.Block
+	genesis *block.Block
+	peers   []p2p.PeerID
+}
+
+// findSnapshot asks the peers for the snapshot at the trusted block, returns the snapshot served by
+// the most peers if they are enough, one peer is enough if the snapshot has the trusted root.
+func (ss *snapshotService) findSnapshot() (*snapshotCandidate, error) {
+	for len(ss.infoChan) > 0 {
+		<-ss.infoChan
+	}
+	req := &message.SnapshotInfoRequest{Hash: ss.trustedHash}
+	b, err := req.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	ss.p2pService.Broadcast(b, p2p.SnapshotInfoRequest, p2p.UrgentMessage)
+
+	candidates := make(map[string]*snapshotCandidate)
+	served := make(map[p2p.PeerID]bool)
+	timer := time.NewTimer(snapshotInfoTime)
+	defer timer.Stop()
+	for {
+		select {
+		case resp := <-ss.infoChan:
+			if served[resp.From()] {
+				continue
+			}
+			var info message.SnapshotInfo
+			if err := info.Unmarshal(resp.Data()); err != nil {
+				ilog.Errorf("unmarshal SnapshotInfo failed:%v", err)
+				continue
+			}
+			blk, genesis, err := ss.verifyInfo(&info)
+			if err != nil {
+				ilog.Warnf("invalid snapshot from peer %s. err=%v", resp.From().Pretty(), err)
+				continue
+			}
+			served[resp.From()] = true
+			key := string(db.SnapshotRoot(info.ChunkHashes)) + string(genesis.HeadHash())
+			c, ok := candidates[key]
+			if !ok {
+				c = &snapshotCandidate{info: &info, blk: blk, genesis: genesis}
+				candidates[key] = c
+			}
+			c.peers = append(c.peers, resp.From())
+		case <-timer.C:
+			var best *snapshotCandidate
+			for _, c := range candidates {
+				if best == nil || len(c.peers) > len(best.peers) {
+					best = c
+				}
+			}
+			if best == nil {
+				return nil, errSnapshotPeers
+			}
+			if ss.trustedRoot == nil && len(best.peers) < ss.quorum {
+				return nil, errSnapshotQuorum
+			}
+			if len(candidates) > 1 {
+				ilog.Warnf("peers serve %v different snapshots at the trusted block", len(candidates))
+			}
+			return best, nil
+		case <-ss.exitSignal:
+			return nil, errSnapshotStop
+		}
+	}
+}
+
+// download downloads the chunks from the peers, a chunk which doesn't match its hash is dropped and
+// the peer serving it isn't asked any more.
+func (ss *snapshotService) download(c *snapshotCandidate) ([][]byte, error) {
+	for len(ss.chunkChan) > 0 {
+		<-ss.chunkChan
+	}
+	hashes := c.info.ChunkHashes
+	chunks := make([][]byte, len(hashes))
+	peers := append([]p2p.PeerID{}, c.peers...)
+	pending := make([]int, 0, len(hashes))
+	for i := range hashes {
+		pending = append(pending, i)
+	}
+	requests := make(map[int]*chunkRequest)
+	done := 0
+	next := 0
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+	for done < len(hashes) {
+		for len(pending) > 0 && len(requests) < maxSnapshotChunkRequests {
+			if len(peers) == 0 {
+				return nil, errSnapshotPeers
+			}
+			i := pending[0]
+			pending = pending[1:]
+			peerID := peers[next%len(peers)]
+			next++
+			req := &message.SnapshotChunkRequest{Hash: c.info.Hash, Index: int32(i)}
+			b, err := req.Marshal()
+			if err != nil {
+				return nil, err
+			}
+			ss.p2pService.SendToPeer(peerID, b, p2p.SnapshotChunkRequest, p2p.NormalMessage)
+			requests[i] = &chunkRequest{peerID: peerID, deadline: time.Now().Add(snapshotChunkTimeout)}
+		}
+		select {
+		case resp := <-ss.chunkChan:
+			var chunk message.SnapshotChunk
+			if err := chunk.Unmarshal(resp.Data()); err != nil {
+				ilog.Errorf("unmarshal SnapshotChunk failed:%v", err)
+				continue
+			}
+			r, ok := requests[int(chunk.Index)]
+			if !ok || r.peerID != resp.From() || !bytes.Equal(chunk.Hash, c.info.Hash) {
+				continue
+			}
+			delete(requests, int(chunk.Index))
+			if !bytes.Equal(common.Sha3(chunk.Data), hashes[chunk.Index]) {
+				ilog.Warnf("invalid snapshot chunk %v from peer %s", chunk.Index, resp.From().Pretty())
+				peers = removePeer(peers, resp.From())
+				pending = append(pending, int(chunk.Index))
+				continue
+			}
+			chunks[chunk.Index] = chunk.Data
+			done++
+			if done%100 == 0 {
+				ilog.Infof("downloaded snapshot chunks: %v/%v", done, len(hashes))
+			}
+		case now := <-ticker.C:
+			for i, r := range requests {
+				if now.After(r.deadline) {
+					delete(requests, i)
+					pending = append(pending, i)
+				}
+			}
+		case <-ss.exitSignal:
+			return nil, errSnapshotStop
+		}
+	}
+	return chunks, nil
+}
+
+func removePeer(peers []p2p.PeerID, peerID p2p.PeerID) []p2p.PeerID {
+	for i, p := range peers {
+		if p == peerID {
+			return append(peers[:i], peers[i+1:]...)
+		}
+	}
+	return peers
+}
+
+// restore downloads the snapshot at the trusted block, writes the state, the genesis block and the trusted
+// block into db and makes the trusted block the root of the block cache. The blocks after it are synced then.
+// The chain stays empty until the blocks are written in the last step, so a failed restore is tried again,
+// and the state written by the failed one is kept if it is the state of the trusted block.
+func (ss *snapshotService) restore() error {
+	sn, ok := ss.basevariable.StateDB().(db.Snapshotter)
+	if !ok {
+		return errSnapshotNotSupported
+	}
+	if ss.trustedRoot == nil && ss.quorum < 2 {
+		return errSnapshotUntrusted
+	}
+	if ss.genesisHash() == nil {
+		return errSnapshotGenesisUnknown
+	}
+	c, err := ss.findSnapshot()
+	if err != nil {
+		return err
+	}
+	if !ss.restored(sn, c.blk) {
+		ilog.Infof("download snapshot at block %v from %v peers, chunks: %v", c.blk.Head.Number, len(c.peers), len(c.info.ChunkHashes))
+		chunks, err := ss.download(c)
+		if err != nil {
+			return err
+		}
+		err = sn.Restore(&db.Snapshot{Tag: string(c.blk.HeadHash()), Chunks: chunks})
+		if err != nil {
+			return fmt.Errorf("restore statedb failed. err: %v", err)
+		}
+	}
+	err = ss.basevariable.TxDB().Push(c.blk.Txs, c.blk.Receipts)
+	if err != nil {
+		return fmt.Errorf("push txDB failed. err: %v", err)
+	}
+	err = global.CheckConsensusParams(ss.basevariable.StateDB())
+	if err != nil {
+		return fmt.Errorf("check consensus params failed. err: %v", err)
+	}
+	err = ss.basevariable.BlockChain().PushCheckpoint(c.genesis, c.blk)
+	if err != nil {
+		return fmt.Errorf("push checkpoint block failed. err: %v", err)
+	}
+	ss.blockCache.AddGenesis(c.blk)
+	ilog.Infof("restored snapshot at block %v", c.blk.Head.Number)
+	return nil
+}
+
+// restored returns whether the state of the block is restored by a failed restore.
+func (ss *snapshotService) restored(sn db.Snapshotter, blk *block.Block) bool {
+	v, err := sn.View()
+	if err != nil {
+		return false
+	}
+	defer v.Release()
+	return v.Tag == string(blk.HeadHash())
+}
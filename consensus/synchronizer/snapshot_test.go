@@ -0,0 +1,256 @@
+package synchronizer
+
+import (
+	"fmt"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/iost-official/go-iost/account"
+	"github.com/iost-official/go-iost/common"
+	"github.com/iost-official/go-iost/core/block"
+	"github.com/iost-official/go-iost/core/blockcache"
+	"github.com/iost-official/go-iost/core/global"
+	"github.com/iost-official/go-iost/core/message"
+	"github.com/iost-official/go-iost/core/mocks"
+	"github.com/iost-official/go-iost/crypto"
+	"github.com/iost-official/go-iost/db"
+	"github.com/iost-official/go-iost/p2p"
+	"github.com/iost-official/go-iost/p2p/mocks"
+)
+
+// fakeBlockCache records the root added by the snapshot service, the other methods aren't used.
+type fakeBlockCache struct {
+	blockcache.BlockCache
+	root *block.Block
+}
+
+func (bc *fakeBlockCache) SetFlushHandler(func(*block.Block)) {}
+
+func (bc *fakeBlockCache) AddGenesis(blk *block.Block) {
+	bc.root = blk
+}
+
+func testBlock(t *testing.T, number int64, parent []byte) *block.Block {
+	blk := &block.Block{
+		Head: &block.BlockHead{
+			ParentHash: parent,
+			Number:     number,
+			Witness:    "witness",
+			Time:       number,
+		},
+	}
+	blk.Head.TxsHash = blk.CalculateTxsHash()
+	blk.Head.MerkleHash = blk.CalculateMerkleHash()
+	if err := blk.CalculateHeadHash(); err != nil {
+		t.Fatal(err)
+	}
+	acc, err := account.NewAccount(common.Sha256([]byte("witness")), crypto.Secp256k1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	blk.Sign = acc.Sign(blk.HeadHash())
+	return blk
+}
+
+// snapshotNet delivers the messages between the snapshot services of the peers.
+type snapshotNet struct {
+	mu    sync.Mutex
+	chans map[p2p.PeerID]chan p2p.IncomingMessage
+}
+
+func (n *snapshotNet) service(ctl *gomock.Controller, id p2p.PeerID) (p2p.Service, chan p2p.IncomingMessage) {
+	ch := make(chan p2p.IncomingMessage, 1024)
+	n.mu.Lock()
+	n.chans[id] = ch
+	n.mu.Unlock()
+	s := p2p_mock.NewMockService(ctl)
+	s.EXPECT().Register(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(ch).AnyTimes()
+	s.EXPECT().Broadcast(gomock.Any(), gomock.Any(), gomock.Any()).Do(func(data []byte, typ p2p.MessageType, _ p2p.MessagePriority) {
+		n.mu.Lock()
+		defer n.mu.Unlock()
+		for peerID, c := range n.chans {
+			if peerID != id {
+				c <- *p2p.NewIncomingMessage(id, data, typ)
+			}
+		}
+	}).AnyTimes()
+	s.EXPECT().SendToPeer(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Do(func(peerID p2p.PeerID, data []byte, typ p2p.MessageType, _ p2p.MessagePriority) {
+		n.mu.Lock()
+		defer n.mu.Unlock()
+		if c, ok := n.chans[peerID]; ok {
+			c <- *p2p.NewIncomingMessage(id, data, typ)
+		}
+	}).AnyTimes()
+	return s, ch
+}
+
+func (n *snapshotNet) leave(id p2p.PeerID) {
+	n.mu.Lock()
+	delete(n.chans, id)
+	n.mu.Unlock()
+}
+
+func snapshotVariable(t *testing.T, ctl *gomock.Controller, path string, conf *common.Config) (global.BaseVariable, db.MVCCDB, block.Chain) {
+	stateDB, err := db.NewMVCCDB(path + "StateDB")
+	if err != nil {
+		t.Fatal(err)
+	}
+	chain, err := block.NewBlockChain(path + "BlockChainDB")
+	if err != nil {
+		t.Fatal(err)
+	}
+	txDB, err := global.NewTxDB(path + "TXDB")
+	if err != nil {
+		t.Fatal(err)
+	}
+	conf.DB = &common.DBConfig{LdbPath: path}
+	bv := core_mock.NewMockBaseVariable(ctl)
+	bv.EXPECT().Config().Return(conf).AnyTimes()
+	bv.EXPECT().StateDB().Return(stateDB).AnyTimes()
+	bv.EXPECT().BlockChain().Return(chain).AnyTimes()
+	bv.EXPECT().TxDB().Return(txDB).AnyTimes()
+	return bv, stateDB, chain
+}
+
+// snapshotServer serves the snapshot of the state at blk.
+func snapshotServer(t *testing.T, ctl *gomock.Controller, net *snapshotNet, id p2p.PeerID, genesis, blk *block.Block) *snapshotService {
+	conf := &common.Config{
+		Genesis:  &common.GenesisConfig{},
+		Snapshot: &common.SnapshotConfig{Serve: true, Interval: blk.Head.Number},
+	}
+	bv, stateDB, chain := snapshotVariable(t, ctl, "snapshot_"+string(id)+"/", conf)
+	for i := 0; i < 50; i++ {
+		stateDB.Put("state", fmt.Sprintf("key%02d", i), fmt.Sprintf("value%02d", i))
+	}
+	stateDB.Commit()
+	stateDB.Tag(string(blk.HeadHash()))
+	if err := stateDB.Flush(string(blk.HeadHash())); err != nil {
+		t.Fatal(err)
+	}
+	if err := chain.Push(genesis); err != nil {
+		t.Fatal(err)
+	}
+	p2pService, _ := net.service(ctl, id)
+	ss := newSnapshotService(bv, &fakeBlockCache{}, p2pService)
+	ss.take(blk)
+	ss.wg.Wait()
+	if ss.get(blk.HeadHash()) == nil {
+		t.Fatalf("%v doesn't serve the snapshot", id)
+	}
+	ss.start()
+	return ss
+}
+
+// forger serves the info of the snapshot at blk with the forged chunks.
+func forger(ctl *gomock.Controller, net *snapshotNet, id p2p.PeerID, info *message.SnapshotInfo) chan struct{} {
+	s, ch := net.service(ctl, id)
+	exit := make(chan struct{})
+	go func() {
+		for {
+			select {
+			case req := <-ch:
+				switch req.Type() {
+				case p2p.SnapshotInfoRequest:
+					b, _ := info.Marshal()
+					s.SendToPeer(req.From(), b, p2p.SnapshotInfoResponse, p2p.NormalMessage)
+				case p2p.SnapshotChunkRequest:
+					var r message.SnapshotChunkRequest
+					r.Unmarshal(req.Data())
+					b, _ := (&message.SnapshotChunk{Hash: r.Hash, Index: r.Index, Data: []byte("forged")}).Marshal()
+					s.SendToPeer(req.From(), b, p2p.SnapshotChunkResponse, p2p.NormalMessage)
+				}
+			case <-exit:
+				return
+			}
+		}
+	}()
+	return exit
+}
+
+func TestSnapshotService(t *testing.T) {
+	defer func(size int, infoTime time.Duration) {
+		db.SnapshotChunkSize = size
+		snapshotInfoTime = infoTime
+		for _, dir := range []string{"honest1", "honest2", "dst", "untrusted", "rooted"} {
+			os.RemoveAll("snapshot_" + dir)
+		}
+	}(db.SnapshotChunkSize, snapshotInfoTime)
+	db.SnapshotChunkSize = 100
+	snapshotInfoTime = 300 * time.Millisecond
+
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	genesis := testBlock(t, 0, nil)
+	blk := testBlock(t, 10, []byte("parent"))
+	net := &snapshotNet{chans: make(map[p2p.PeerID]chan p2p.IncomingMessage)}
+	var info message.SnapshotInfo
+	for _, id := range []p2p.PeerID{"honest1", "honest2"} {
+		srv := snapshotServer(t, ctl, net, id, genesis, blk)
+		defer srv.stop()
+		info = *srv.get(blk.HeadHash()).info
+	}
+	if len(info.ChunkHashes) < 2 {
+		t.Fatalf("chunks: %v", len(info.ChunkHashes))
+	}
+	defer close(forger(ctl, net, "forger", &info))
+	forgedInfo := info
+	forgedInfo.ChunkHashes = [][]byte{common.Sha3([]byte("forged"))}
+	defer close(forger(ctl, net, "forged_info", &forgedInfo))
+
+	client := func(id p2p.PeerID, snapshot *common.SnapshotConfig) (*snapshotService, *fakeBlockCache, db.MVCCDB, block.Chain) {
+		snapshot.TrustedHash = common.Base58Encode(blk.HeadHash())
+		conf := &common.Config{
+			Genesis:  &common.GenesisConfig{GenesisHash: common.Base58Encode(genesis.HeadHash())},
+			Snapshot: snapshot,
+		}
+		bv, stateDB, chain := snapshotVariable(t, ctl, "snapshot_"+string(id)+"/", conf)
+		bc := &fakeBlockCache{}
+		p2pService, _ := net.service(ctl, id)
+		ss := newSnapshotService(bv, bc, p2pService)
+		ss.start()
+		return ss, bc, stateDB, chain
+	}
+
+	// one peer isn't trusted without the root
+	ss, _, _, _ := client("untrusted", &common.SnapshotConfig{Quorum: 1})
+	if err := ss.restore(); err != errSnapshotUntrusted {
+		t.Fatalf("restore without quorum: %v", err)
+	}
+	ss.stop()
+	net.leave("untrusted")
+
+	// the snapshot served by the most peers is downloaded, the forged chunks are dropped
+	ss, bc, stateDB, chain := client("dst", &common.SnapshotConfig{Quorum: 2})
+	if err := ss.restore(); err != nil {
+		t.Fatal(err)
+	}
+	ss.stop()
+	net.leave("dst")
+	if bc.root == nil || string(bc.root.HeadHash()) != string(blk.HeadHash()) {
+		t.Fatalf("root: %v", bc.root)
+	}
+	if chain.Length() != 11 || chain.Start() != 10 {
+		t.Fatalf("chain length %v, start %v", chain.Length(), chain.Start())
+	}
+	if !stateDB.Checkout(string(blk.HeadHash())) {
+		t.Fatal("state isn't tagged with the block")
+	}
+	for i := 0; i < 50; i++ {
+		if v, err := stateDB.Get("state", fmt.Sprintf("key%02d", i)); err != nil || v != fmt.Sprintf("value%02d", i) {
+			t.Fatalf("state key%02d: %v %v", i, v, err)
+		}
+	}
+
+	// a single peer is enough if the snapshot has the trusted root, the forged info doesn't
+	ss, bc, _, _ = client("rooted", &common.SnapshotConfig{Quorum: 1, TrustedRoot: common.Base58Encode(db.SnapshotRoot(info.ChunkHashes))})
+	if err := ss.restore(); err != nil {
+		t.Fatal(err)
+	}
+	ss.stop()
+	if bc.root == nil {
+		t.Fatal("snapshot with the trusted root isn't restored")
+	}
+}
@@ -0,0 +1,104 @@
+package synchronizer
+
+import (
+	"sync"
+	"time"
+
+	"github.com/iost-official/go-iost/core/global"
+	"github.com/iost-official/go-iost/metrics"
+)
+
+var (
+	metricsSyncCurrentHeight   = metrics.NewGauge("iost_sync_current_height", nil)
+	metricsSyncHighestHeight   = metrics.NewGauge("iost_sync_highest_height", nil)
+	metricsSyncBlocksPerSecond = metrics.NewGauge("iost_sync_blocks_per_second", nil)
+	metricsSyncRemainingTime   = metrics.NewGauge("iost_sync_remaining_seconds", nil)
+	metricsSyncMissions        = metrics.NewGauge("iost_sync_missions", nil)
+)
+
+var syncSpeedAlpha = 0.3
+
+var progress = &syncProgress{}
+
+// SyncStatus is the progress of the synchronizer.
+type SyncStatus struct {
+	// whether the progress is updated since the node starts
+	Initialized bool
+	Syncing     bool
+	// the height of the head of the block cache
+	CurrentHeight int64
+	// the height of the network, the median of the heights of the live peers and the current height
+	HighestHeight int64
+	// the moving average of the blocks added per second
+	BlocksPerSecond float64
+	// the estimated seconds to reach the highest height, -1 if unknown
+	RemainingSeconds int64
+	// the number of blocks waiting to be downloaded or downloading
+	Missions int64
+	Peers    int64
+}
+
+// Done returns whether the node has caught up with its peers, it isn't done until a peer reports its height.
+func (s SyncStatus) Done() bool {
+	return s.Initialized && s.Peers > 0 && !s.Syncing && s.CurrentHeight >= s.HighestHeight
+}
+
+type syncProgress struct {
+	mu         sync.RWMutex
+	status     SyncStatus
+	lastHeight int64
+	lastTime   time.Time
+}
+
+// Status returns the current progress of the synchronizer.
+func Status() SyncStatus {
+	progress.mu.RLock()
+	defer progress.mu.RUnlock()
+	return progress.status
+}
+
+// update records the heights and estimates the sync speed from the blocks added since the last update.
+func (p *syncProgress) update(syncing bool, current, highest, missions, peers int64, now time.Time) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if highest < current {
+		highest = current
+	}
+	s := &p.status
+	if !p.lastTime.IsZero() && now.After(p.lastTime) {
+		speed := float64(current-p.lastHeight) / now.Sub(p.lastTime).Seconds()
+		if speed < 0 {
+			speed = 0
+		}
+		s.BlocksPerSecond = (1-syncSpeedAlpha)*s.BlocksPerSecond + syncSpeedAlpha*speed
+	}
+	p.lastHeight = current
+	p.lastTime = now
+	s.Initialized = true
+	s.Syncing = syncing
+	s.CurrentHeight = current
+	s.HighestHeight = highest
+	s.Missions = missions
+	s.Peers = peers
+	switch {
+	case current >= highest:
+		s.RemainingSeconds = 0
+	case s.BlocksPerSecond > 0:
+		s.RemainingSeconds = int64(float64(highest-current) / s.BlocksPerSecond)
+	default:
+		s.RemainingSeconds = -1
+	}
+
+	metricsSyncCurrentHeight.Set(float64(s.CurrentHeight), nil)
+	metricsSyncHighestHeight.Set(float64(s.HighestHeight), nil)
+	metricsSyncBlocksPerSecond.Set(s.BlocksPerSecond, nil)
+	metricsSyncRemainingTime.Set(float64(s.RemainingSeconds), nil)
+	metricsSyncMissions.Set(float64(s.Missions), nil)
+}
+
+// updateStatus publishes the sync progress with the height of the network which the synchronizer syncs to.
+func (sy *SyncImpl) updateStatus() {
+	heights := sy.liveHeights()
+	progress.update(sy.basevariable.Mode() == global.ModeSync, sy.blockCache.Head().Number, heights[len(heights)/2],
+		sy.dc.Missions(), int64(len(heights)-1), time.Now())
+}
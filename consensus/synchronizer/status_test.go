@@ -0,0 +1,82 @@
+package synchronizer
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/iost-official/go-iost/core/blockcache"
+	"github.com/iost-official/go-iost/core/message"
+	"github.com/iost-official/go-iost/p2p"
+)
+
+func TestSyncProgress(t *testing.T) {
+	p := &syncProgress{}
+	if p.status.Done() {
+		t.Fatal("sync isn't done before the first update")
+	}
+	now := time.Now()
+	p.update(true, 100, 1100, 50, 3, now)
+	if p.status.RemainingSeconds != -1 || p.status.BlocksPerSecond != 0 {
+		t.Fatalf("speed unknown at the first update: %+v", p.status)
+	}
+	p.update(true, 200, 1100, 50, 3, now.Add(10*time.Second))
+	if p.status.BlocksPerSecond != 10*syncSpeedAlpha {
+		t.Fatalf("wrong speed: %v", p.status.BlocksPerSecond)
+	}
+	if p.status.RemainingSeconds != int64(900/p.status.BlocksPerSecond) {
+		t.Fatalf("wrong remaining seconds: %v", p.status.RemainingSeconds)
+	}
+	if p.status.Done() {
+		t.Fatal("sync isn't done")
+	}
+	p.update(false, 1200, 1100, 0, 3, now.Add(20*time.Second))
+	if !p.status.Done() || p.status.HighestHeight != 1200 || p.status.RemainingSeconds != 0 {
+		t.Fatalf("sync should be done: %+v", p.status)
+	}
+}
+
+func TestSyncProgress_NoPeer(t *testing.T) {
+	p := &syncProgress{}
+	p.update(false, 100, 0, 0, 0, time.Now())
+	if p.status.Done() {
+		t.Fatalf("sync isn't done before a peer reports its height: %+v", p.status)
+	}
+	p.update(false, 100, 100, 0, 1, time.Now().Add(time.Second))
+	if !p.status.Done() {
+		t.Fatalf("sync should be done: %+v", p.status)
+	}
+}
+
+type headCache struct {
+	blockcache.BlockCache
+	head *blockcache.BlockCacheNode
+}
+
+func (c *headCache) Head() *blockcache.BlockCacheNode {
+	return c.head
+}
+
+func TestLiveHeights(t *testing.T) {
+	head := blockcache.NewBCN(nil, nil)
+	head.Number = 100
+	sy := &SyncImpl{blockCache: &headCache{head: head}, heightMap: new(sync.Map)}
+	now := time.Now().Unix()
+	sy.heightMap.Store(p2p.PeerID("a"), &message.SyncHeight{Height: 120, Time: now})
+	sy.heightMap.Store(p2p.PeerID("b"), &message.SyncHeight{Height: 110, Time: now})
+	// a peer far ahead doesn't make the network higher
+	sy.heightMap.Store(p2p.PeerID("c"), &message.SyncHeight{Height: 100000, Time: now})
+	sy.heightMap.Store(p2p.PeerID("d"), &message.SyncHeight{Height: 200000, Time: now - heightAvailableTime - 1})
+	sy.heightMap.Store(p2p.PeerID("e"), &message.SyncHeight{Height: 300000, Time: now - heightTimeout - 1})
+
+	heights := sy.liveHeights()
+	if len(heights) != 4 || heights[len(heights)/2] != 120 {
+		t.Fatalf("wrong live heights: %v", heights)
+	}
+	if _, ok := sy.heightMap.Load(p2p.PeerID("d")); !ok {
+		t.Fatal("the height not available is dropped before the timeout")
+	}
+	if _, ok := sy.heightMap.Load(p2p.PeerID("e")); ok {
+		t.Fatal("the height timed out isn't dropped")
+	}
+}
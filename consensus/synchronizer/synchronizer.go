@@ -1,6 +1,7 @@
 package synchronizer
 
 import (
+	"bytes"
 	"sort"
 	"sync"
 	"sync/atomic"
@@ -8,6 +9,8 @@ import (
 
 	"github.com/gogo/protobuf/proto"
 
+	"github.com/iost-official/go-iost/common"
+	"github.com/iost-official/go-iost/consensus/verifier"
 	"github.com/iost-official/go-iost/core/block"
 	"github.com/iost-official/go-iost/core/blockcache"
 	"github.com/iost-official/go-iost/core/global"
@@ -17,8 +20,6 @@ import (
 )
 
 var (
-	// TODO: configurable
-	confirmNumber = 8
 	// SyncNumber    int64 = int64(ConfirmNumber) * 2 / 3
 	syncNumber int64 = 11
 
@@ -28,6 +29,9 @@ var (
 	syncHeightTime                = 3 * time.Second
 	heightAvailableTime     int64 = 22 * 3
 	heightTimeout           int64 = 100 * 22 * 3
+	syncWindows             int64 = 2
+	headerTimeout                 = 5 * time.Second
+	maxHeaderRetries              = 1
 )
 
 // Synchronizer defines the functions of synchronizer module
@@ -39,6 +43,13 @@ type Synchronizer interface {
 	CheckSyncProcess()
 }
 
+// blockRequest is a request for the head of a block, the peer is empty if it's broadcast.
+type blockRequest struct {
+	peerID  p2p.PeerID
+	time    time.Time
+	retries int
+}
+
 //SyncImpl is the implementation of Synchronizer.
 type SyncImpl struct {
 	p2pService   p2p.Service
@@ -46,12 +57,18 @@ type SyncImpl struct {
 	lastBcn      *blockcache.BlockCacheNode
 	basevariable global.BaseVariable
 	dc           DownloadController
+	scorer       *peerScorer
+	headers      *headerChain
+	snapshot     *snapshotService
 	reqMap       *sync.Map
 	heightMap    *sync.Map
+	rejectedPeer *sync.Map
+	conflictPeer *sync.Map
 	syncEnd      int64
 	button       int32
 
 	messageChan    chan p2p.IncomingMessage
+	blockChan      chan p2p.IncomingMessage
 	syncHeightChan chan p2p.IncomingMessage
 	exitSignal     chan struct{}
 }
@@ -64,11 +81,15 @@ func NewSynchronizer(basevariable global.BaseVariable, blkcache blockcache.Block
 		basevariable: basevariable,
 		reqMap:       new(sync.Map),
 		heightMap:    new(sync.Map),
+		rejectedPeer: new(sync.Map),
+		conflictPeer: new(sync.Map),
+		scorer:       newPeerScorer(),
+		headers:      newHeaderChain(),
 		lastBcn:      nil,
 		syncEnd:      0,
 	}
 	var err error
-	sy.dc, err = NewDownloadController(sy.reqSyncBlock)
+	sy.dc, err = NewDownloadController(sy.reqSyncBlock, sy.scorer)
 	if err != nil {
 		return nil, err
 	}
@@ -77,9 +98,13 @@ func NewSynchronizer(basevariable global.BaseVariable, blkcache blockcache.Block
 		p2p.SyncBlockRequest,
 		p2p.SyncBlockHashRequest,
 		p2p.SyncBlockHashResponse,
+		p2p.SyncBlockHeaderRequest,
+		p2p.SyncBlockHeaderResponse,
 	)
+	sy.blockChan = sy.p2pService.Register("sync block", p2p.SyncBlockResponse)
 
 	sy.syncHeightChan = sy.p2pService.Register("sync height", p2p.SyncHeight)
+	sy.snapshot = newSnapshotService(basevariable, blkcache, p2pserv)
 	sy.exitSignal = make(chan struct{})
 	atomic.StoreInt32(&sy.button, 0)
 
@@ -139,6 +164,7 @@ func (sy *SyncImpl) reqSyncBlock(hash string, p interface{}, peerID p2p.PeerID,
 		return false
 	}
 	sy.p2pService.SendToPeer(peerID, bytes, p2p.SyncBlockRequest, p2p.UrgentMessage)
+	sy.scorer.requested(peerID, hash)
 	return true
 }
 
@@ -149,12 +175,14 @@ func (sy *SyncImpl) Start() error {
 	go sy.messageLoop()
 	go sy.retryDownloadLoop()
 	go sy.initializer()
+	sy.snapshot.start()
 	return nil
 }
 
 // Stop stops the synchronizer module.
 func (sy *SyncImpl) Stop() {
 	sy.dc.Stop()
+	sy.snapshot.stop()
 	close(sy.exitSignal)
 }
 
@@ -166,6 +194,12 @@ func (sy *SyncImpl) initializer() {
 		select {
 		case <-time.After(retryTime):
 			if sy.basevariable.BlockChain().Length() == 0 {
+				if sy.snapshot.trustedHash != nil {
+					if err := sy.snapshot.restore(); err != nil {
+						ilog.Errorf("restore snapshot failed. err=%v", err)
+					}
+					continue
+				}
 				ilog.Errorf("block chain is empty")
 				continue
 			} else {
@@ -186,7 +220,7 @@ func (sy *SyncImpl) syncHeightLoop() {
 		select {
 		case <-syncHeightTicker.C:
 			num := sy.blockCache.Head().Number
-			sh := &message.SyncHeight{Height: num, Time: time.Now().Unix()}
+			sh := &message.SyncHeight{Height: num, Time: time.Now().Unix(), GenesisHash: sy.genesisHash()}
 			bytes, err := proto.Marshal(sh)
 			if err != nil {
 				ilog.Errorf("marshal syncheight failed. err=%v", err)
@@ -201,6 +235,9 @@ func (sy *SyncImpl) syncHeightLoop() {
 				ilog.Errorf("unmarshal syncheight failed. err=%v", err)
 				continue
 			}
+			if !sy.checkGenesis(&sh, req.From()) || sy.isRejected(req.From()) {
+				continue
+			}
 			if shIF, ok := sy.heightMap.Load(req.From()); ok {
 				if shOld, ok := shIF.(*message.SyncHeight); ok {
 					if shOld.Height == sh.Height {
@@ -215,6 +252,7 @@ func (sy *SyncImpl) syncHeightLoop() {
 			sy.checkSync()
 			sy.checkGenBlock()
 			sy.CheckSyncProcess()
+			sy.updateStatus()
 		case <-sy.exitSignal:
 			syncHeightTicker.Stop()
 			checkTicker.Stop()
@@ -223,24 +261,56 @@ func (sy *SyncImpl) syncHeightLoop() {
 	}
 }
 
-func (sy *SyncImpl) checkSync() bool {
-	if sy.basevariable.Mode() != global.ModeNormal {
-		return false
+func (sy *SyncImpl) genesisHash() []byte {
+	hash, err := sy.basevariable.BlockChain().GetHashByNumber(0)
+	if err != nil {
+		return nil
 	}
-	/*
-		if atomic.LoadInt32(&sy.button) == 0 {
-			return false
-		}
-		atomic.StoreInt32(&sy.button, 0)
-	*/
-	height := sy.basevariable.BlockChain().Length() - 1
+	return hash
+}
+
+// checkGenesis rejects the peer if its genesis block, which contains the consensus parameters, is different from ours.
+func (sy *SyncImpl) checkGenesis(sh *message.SyncHeight, peerID p2p.PeerID) bool {
+	genesisHash := sy.genesisHash()
+	if genesisHash == nil || bytes.Equal(sh.GenesisHash, genesisHash) {
+		sy.rejectedPeer.Delete(peerID)
+		return true
+	}
+	if _, ok := sy.rejectedPeer.Load(peerID); !ok {
+		ilog.Warnf("reject peer %s with different genesis block %v", peerID.Pretty(), common.Base58Encode(sh.GenesisHash))
+	}
+	sy.rejectedPeer.Store(peerID, true)
+	sy.heightMap.Delete(peerID)
+	return false
+}
+
+// rejectConflict rejects the peer serving a branch conflicting with a checkpoint.
+func (sy *SyncImpl) rejectConflict(peerID p2p.PeerID, number int64) {
+	if _, ok := sy.conflictPeer.Load(peerID); !ok {
+		ilog.Warnf("reject peer %s with block %v conflicting with checkpoint", peerID.Pretty(), number)
+	}
+	sy.conflictPeer.Store(peerID, true)
+	sy.heightMap.Delete(peerID)
+}
+
+func (sy *SyncImpl) isRejected(peerID p2p.PeerID) bool {
+	if _, ok := sy.conflictPeer.Load(peerID); ok {
+		return true
+	}
+	_, ok := sy.rejectedPeer.Load(peerID)
+	return ok
+}
+
+// liveHeights returns the sorted heights of the head and the peers which reported their heights recently,
+// the median of them is the height of the network. The heights timed out are dropped.
+func (sy *SyncImpl) liveHeights() []int64 {
 	heights := make([]int64, 0, 0)
 	heights = append(heights, sy.blockCache.Head().Number)
 	now := time.Now().Unix()
 	sy.heightMap.Range(func(k, v interface{}) bool {
 		sh, ok := v.(*message.SyncHeight)
 		if !ok || sh.Time+heightAvailableTime < now {
-			if sh.Time+heightTimeout < now {
+			if !ok || sh.Time+heightTimeout < now {
 				sy.heightMap.Delete(k)
 			}
 			return true
@@ -254,6 +324,21 @@ func (sy *SyncImpl) checkSync() bool {
 		heights[r] = sh.Height
 		return true
 	})
+	return heights
+}
+
+func (sy *SyncImpl) checkSync() bool {
+	if sy.basevariable.Mode() != global.ModeNormal {
+		return false
+	}
+	/*
+		if atomic.LoadInt32(&sy.button) == 0 {
+			return false
+		}
+		atomic.StoreInt32(&sy.button, 0)
+	*/
+	height := sy.basevariable.BlockChain().Length() - 1
+	heights := sy.liveHeights()
 	netHeight := heights[len(heights)/2]
 	ilog.Infof("check sync, heights: %+v", heights)
 	if netHeight > height+syncNumber {
@@ -281,7 +366,7 @@ func (sy *SyncImpl) checkGenBlock() bool {
 	if bcn != sy.lastBcn {
 		sy.lastBcn = bcn
 		witness := bcn.Block.Head.Witness
-		for i := 0; i < confirmNumber; i++ {
+		for i := int64(0); i < common.ConsensusParams.ConfirmNumber; i++ {
 			bcn = bcn.Parent
 			if bcn == nil {
 				break
@@ -298,33 +383,89 @@ func (sy *SyncImpl) checkGenBlock() bool {
 	return false
 }
 
-func (sy *SyncImpl) queryBlockHash(hr *message.BlockHashQuery) {
-	bytes, err := hr.Marshal()
+// headerPeers returns the peers whose heights aren't less than the height, from the best to the worst.
+func (sy *SyncImpl) headerPeers(height int64) []p2p.PeerID {
+	peers := make([]p2p.PeerID, 0)
+	sy.heightMap.Range(func(k, v interface{}) bool {
+		peerID, ok := k.(p2p.PeerID)
+		if !ok {
+			return true
+		}
+		if sh, ok := v.(*message.SyncHeight); ok && sh.Height >= height && !sy.isRejected(peerID) {
+			peers = append(peers, peerID)
+		}
+		return true
+	})
+	sy.scorer.rank(peers)
+	return peers
+}
+
+// queryBlockHeader asks the best peer which has the blocks for the block heads, or all the peers if none is known.
+func (sy *SyncImpl) queryBlockHeader(hq *message.BlockHashQuery, height int64) {
+	bytes, err := hq.Marshal()
+	if err != nil {
+		ilog.Errorf("marshal blockhashquery failed. err=%v", err)
+		return
+	}
+	ilog.Infof("[sync] request block header. reqtype=%v, start=%v, end=%v, nums size=%v", hq.ReqType, hq.Start, hq.End, len(hq.Nums))
+	var peerID p2p.PeerID
+	if peers := sy.headerPeers(height); len(peers) > 0 {
+		peerID = peers[0]
+		sy.p2pService.SendToPeer(peerID, bytes, p2p.SyncBlockHeaderRequest, p2p.UrgentMessage)
+	} else {
+		sy.p2pService.Broadcast(bytes, p2p.SyncBlockHeaderRequest, p2p.UrgentMessage)
+	}
+	sy.storeRequests(hq, peerID)
+}
+
+// queryBlockHash asks all the peers for the block hashes, it's the fallback for the peers not serving the block heads.
+func (sy *SyncImpl) queryBlockHash(hq *message.BlockHashQuery) {
+	bytes, err := hq.Marshal()
 	if err != nil {
 		ilog.Errorf("marshal blockhashquery failed. err=%v", err)
 		return
 	}
-	ilog.Infof("[sync] request block hash. reqtype=%v, start=%v, end=%v, nums size=%v", hr.ReqType, hr.Start, hr.End, len(hr.Nums))
+	ilog.Infof("[sync] request block hash. reqtype=%v, start=%v, end=%v, nums size=%v", hq.ReqType, hq.Start, hq.End, len(hq.Nums))
 	sy.p2pService.Broadcast(bytes, p2p.SyncBlockHashRequest, p2p.UrgentMessage)
+	sy.storeRequests(hq, "")
 }
 
+// storeRequests records the requested numbers, counting the retries of the numbers already requested.
+func (sy *SyncImpl) storeRequests(hq *message.BlockHashQuery, peerID p2p.PeerID) {
+	nums := hq.Nums
+	if hq.ReqType == 0 {
+		nums = make([]int64, 0, hq.End-hq.Start+1)
+		for i := hq.Start; i <= hq.End; i++ {
+			nums = append(nums, i)
+		}
+	}
+	now := time.Now()
+	for _, i := range nums {
+		req := &blockRequest{peerID: peerID, time: now}
+		if v, ok := sy.reqMap.Load(i); ok {
+			if old, ok := v.(*blockRequest); ok {
+				req.retries = old.retries + 1
+			}
+		}
+		sy.reqMap.Store(i, req)
+	}
+}
+
+// syncBlocks downloads the block heads in windows, the blocks of a window are downloaded in parallel
+// after their heads are validated. Headers of the next windows are requested before the blocks of the
+// current window are all applied.
 func (sy *SyncImpl) syncBlocks(startNumber int64, endNumber int64) error {
 	sy.syncEnd = endNumber
 	for endNumber > startNumber+maxBlockHashQueryNumber-1 {
-		for sy.blockCache.Head().Number+3 < startNumber {
+		for sy.blockCache.Head().Number+(syncWindows-1)*maxBlockHashQueryNumber+3 < startNumber {
 			time.Sleep(500 * time.Millisecond)
 		}
-		for i := startNumber; i < startNumber+maxBlockHashQueryNumber; i++ {
-			sy.reqMap.Store(i, true)
-		}
-		sy.queryBlockHash(&message.BlockHashQuery{ReqType: 0, Start: startNumber, End: startNumber + maxBlockHashQueryNumber - 1, Nums: nil})
+		end := startNumber + maxBlockHashQueryNumber - 1
+		sy.queryBlockHeader(&message.BlockHashQuery{ReqType: 0, Start: startNumber, End: end, Nums: nil}, end)
 		startNumber += maxBlockHashQueryNumber
 	}
 	if startNumber <= endNumber {
-		for i := startNumber; i <= endNumber; i++ {
-			sy.reqMap.Store(i, true)
-		}
-		sy.queryBlockHash(&message.BlockHashQuery{ReqType: 0, Start: startNumber, End: endNumber, Nums: nil})
+		sy.queryBlockHeader(&message.BlockHashQuery{ReqType: 0, Start: startNumber, End: endNumber, Nums: nil}, endNumber)
 	}
 	return nil
 }
@@ -336,12 +477,27 @@ func (sy *SyncImpl) CheckSyncProcess() {
 		sy.basevariable.SetMode(global.ModeNormal)
 		sy.dc.Reset()
 	}
+	sy.headers.prune(sy.blockCache.LinkedRoot().Number)
+	sy.scorer.prune(sy.isAlive)
+}
+
+// isAlive returns true if the peer has sent its height recently.
+func (sy *SyncImpl) isAlive(peerID p2p.PeerID) bool {
+	v, ok := sy.heightMap.Load(peerID)
+	if !ok {
+		return false
+	}
+	sh, ok := v.(*message.SyncHeight)
+	return ok && sh.Time+heightAvailableTime >= time.Now().Unix()
 }
 
 func (sy *SyncImpl) messageLoop() {
 	for {
 		select {
 		case req := <-sy.messageChan:
+			if sy.isRejected(req.From()) {
+				break
+			}
 			if req.Type() == p2p.SyncBlockHashRequest {
 				var rh message.BlockHashQuery
 				err := rh.Unmarshal(req.Data())
@@ -358,6 +514,23 @@ func (sy *SyncImpl) messageLoop() {
 					break
 				}
 				go sy.handleHashResp(&rh, req.From())
+			} else if req.Type() == p2p.SyncBlockHeaderRequest {
+				var rh message.BlockHashQuery
+				err := rh.Unmarshal(req.Data())
+				if err != nil {
+					ilog.Errorf("unmarshal BlockHashQuery failed:%v", err)
+					break
+				}
+				go sy.handleHeaderQuery(&rh, req.From())
+			} else if req.Type() == p2p.SyncBlockHeaderResponse {
+				var rh message.BlockHeaderResponse
+				err := rh.Unmarshal(req.Data())
+				if err != nil {
+					ilog.Errorf("unmarshal BlockHeaderResponse failed:%v", err)
+					sy.scorer.bad(req.From())
+					break
+				}
+				go sy.handleHeaderResp(&rh, req.From())
 			} else if req.Type() == p2p.SyncBlockRequest {
 				var rh message.BlockInfo
 				err := rh.Unmarshal(req.Data())
@@ -366,6 +539,8 @@ func (sy *SyncImpl) messageLoop() {
 				}
 				go sy.handleBlockQuery(&rh, req.From())
 			}
+		case req := <-sy.blockChan:
+			go sy.handleBlockResp(&req)
 		case <-sy.exitSignal:
 			return
 		}
@@ -393,6 +568,9 @@ func (sy *SyncImpl) getBlockHashes(start int64, end int64) *message.BlockHashRes
 			hash = node.Block.HeadHash()
 		} else {
 			hash, err = sy.basevariable.BlockChain().GetHashByNumber(i)
+			if err == block.ErrPruned {
+				break
+			}
 			if err != nil {
 				ilog.Errorf("get hash by number from db failed. err=%v, number=%v", err, i)
 				continue
@@ -459,9 +637,18 @@ func (sy *SyncImpl) handleHashQuery(rh *message.BlockHashQuery, peerID p2p.PeerI
 	sy.p2pService.SendToPeer(peerID, bytes, p2p.SyncBlockHashResponse, p2p.NormalMessage)
 }
 
+// handleHashResp downloads the blocks of the hashes, the heads of the blocks aren't validated before
+// downloading. It serves the peers without the block heads.
 func (sy *SyncImpl) handleHashResp(rh *message.BlockHashResponse, peerID p2p.PeerID) {
 	ilog.Infof("receive block hashes: len=%v", len(rh.BlockInfos))
 	for _, blkInfo := range rh.BlockInfos {
+		v, ok := sy.reqMap.Load(blkInfo.Number)
+		if !ok {
+			continue
+		}
+		if req, ok := v.(*blockRequest); !ok || req.retries < maxHeaderRetries {
+			continue
+		}
 		sy.reqMap.Delete(blkInfo.Number)
 		if blkInfo.Number <= sy.blockCache.LinkedRoot().Number {
 			continue
@@ -472,26 +659,208 @@ func (sy *SyncImpl) handleHashResp(rh *message.BlockHashResponse, peerID p2p.Pee
 	}
 }
 
+func (sy *SyncImpl) getBlockByNumber(num int64) (*block.Block, error) {
+	blk, err := sy.blockCache.GetBlockByNumber(num)
+	if err == nil {
+		return blk, nil
+	}
+	return sy.basevariable.BlockChain().GetBlockByNumber(num)
+}
+
+// handleHeaderQuery serves the block heads, the blocks pruned before the start of the chain are skipped.
+func (sy *SyncImpl) handleHeaderQuery(rh *message.BlockHashQuery, peerID p2p.PeerID) {
+	start := sy.basevariable.BlockChain().Start()
+	var nums []int64
+	if rh.ReqType == 0 {
+		if rh.End < rh.Start || rh.Start < 0 || rh.End-rh.Start >= maxBlockHashQueryNumber {
+			return
+		}
+		for i := rh.Start; i <= rh.End; i++ {
+			nums = append(nums, i)
+		}
+	}
+	if rh.ReqType == 1 {
+		nums = rh.Nums
+		if int64(len(nums)) > maxBlockHashQueryNumber {
+			nums = nums[:maxBlockHashQueryNumber]
+		}
+	}
+	resp := &message.BlockHeaderResponse{Headers: make([][]byte, 0, len(nums))}
+	for _, num := range nums {
+		if num > 0 && num < start {
+			continue
+		}
+		blk, err := sy.getBlockByNumber(num)
+		if err != nil {
+			continue
+		}
+		header := &block.Block{Head: blk.Head, Sign: blk.Sign}
+		b, err := header.Encode()
+		if err != nil {
+			ilog.Errorf("Fail to encode block head: %v, err=%v", num, err)
+			continue
+		}
+		resp.Headers = append(resp.Headers, b)
+	}
+	if len(resp.Headers) == 0 {
+		return
+	}
+	bytes, err := resp.Marshal()
+	if err != nil {
+		ilog.Errorf("marshal BlockHeaderResponse failed. err=%v", err)
+		return
+	}
+	sy.p2pService.SendToPeer(peerID, bytes, p2p.SyncBlockHeaderResponse, p2p.NormalMessage)
+}
+
+func (sy *SyncImpl) knownBlock(hash []byte) *block.Block {
+	if bcn, err := sy.blockCache.Find(hash); err == nil {
+		return bcn.Block
+	}
+	return nil
+}
+
+// handleHeaderResp validates the block heads, the blocks are downloaded only for the valid heads.
+// The peer is down-ranked if any head is invalid.
+func (sy *SyncImpl) handleHeaderResp(rh *message.BlockHeaderResponse, peerID p2p.PeerID) {
+	ilog.Infof("receive block headers: len=%v", len(rh.Headers))
+	headers := make([]*block.Block, 0, len(rh.Headers))
+	for _, b := range rh.Headers {
+		var blk block.Block
+		if err := blk.Decode(b); err != nil {
+			ilog.Warnf("decode block head from peer %s failed. err=%v", peerID.Pretty(), err)
+			sy.scorer.bad(peerID)
+			return
+		}
+		headers = append(headers, &blk)
+	}
+	sort.Slice(headers, func(i int, j int) bool {
+		return headers[i].Head.Number < headers[j].Head.Number
+	})
+	root := sy.blockCache.LinkedRoot()
+	schedules := [][]string{root.Active(), root.Pending()}
+	for _, blk := range headers {
+		if blk.Head.Number <= sy.blockCache.LinkedRoot().Number {
+			sy.reqMap.Delete(blk.Head.Number)
+			continue
+		}
+		validated, err := sy.headers.add(blk, sy.knownBlock, schedules)
+		if err == verifier.ErrCheckpoint {
+			sy.rejectConflict(peerID, blk.Head.Number)
+			return
+		}
+		if err == verifier.ErrSchedule {
+			// the witness list may change after the linked root, the head is requested again later
+			ilog.Infof("block head %v from peer %s isn't scheduled by the linked root.", blk.Head.Number, peerID.Pretty())
+			return
+		}
+		if err != nil {
+			ilog.Warnf("invalid block head %v from peer %s. err=%v", blk.Head.Number, peerID.Pretty(), err)
+			sy.scorer.bad(peerID)
+			return
+		}
+		for _, h := range validated {
+			sy.reqMap.Delete(h.Head.Number)
+			sy.createMissions(h, peerID)
+		}
+	}
+}
+
+// createMissions downloads the block from the peer sending its head and the other peers having it.
+func (sy *SyncImpl) createMissions(header *block.Block, peerID p2p.PeerID) {
+	if _, err := sy.blockCache.Find(header.HeadHash()); err == nil {
+		return
+	}
+	hash := string(header.HeadHash())
+	sy.dc.CreateMission(hash, header.Head.Number, peerID)
+	for _, p := range sy.headerPeers(header.Head.Number) {
+		if p != peerID {
+			sy.dc.CreateMission(hash, header.Head.Number, p)
+		}
+	}
+}
+
+// handleBlockResp measures the peer serving the block, and checks the block with its parent. The block
+// is downloaded from another peer if it's invalid.
+func (sy *SyncImpl) handleBlockResp(req *p2p.IncomingMessage) {
+	var blk block.Block
+	if err := blk.Decode(req.Data()); err != nil {
+		return
+	}
+	hash := string(blk.HeadHash())
+	if !sy.scorer.received(req.From(), hash, len(req.Data())) {
+		return
+	}
+	parent := sy.headers.get(blk.Head.ParentHash)
+	if parent == nil {
+		parent = sy.knownBlock(blk.Head.ParentHash)
+	}
+	lib := sy.blockCache.LinkedRoot().Block
+	if parent == nil || lib == nil {
+		return
+	}
+	if err := verifier.VerifyBlockHead(&blk, parent, lib); err != nil {
+		if err == verifier.ErrCheckpoint {
+			sy.rejectConflict(req.From(), blk.Head.Number)
+		}
+		ilog.Warnf("invalid block %v from peer %s. err=%v", blk.Head.Number, req.From().Pretty(), err)
+		sy.scorer.bad(req.From())
+		sy.dc.FreePeer(hash, req.From())
+	}
+}
+
+// retryDownloadLoop requests the block heads again if their requests time out, and penalizes the peers
+// of the timed out requests. The heads retried too many times are requested by the block hashes.
 func (sy *SyncImpl) retryDownloadLoop() {
 	for {
 		select {
 		case <-time.After(retryTime):
 			hq := &message.BlockHashQuery{ReqType: 1, Start: 0, End: 0, Nums: make([]int64, 0)}
+			hashq := &message.BlockHashQuery{ReqType: 1, Start: 0, End: 0, Nums: make([]int64, 0)}
+			timeoutPeers := make(map[p2p.PeerID]bool)
 			sy.reqMap.Range(func(k, v interface{}) bool {
 				num, ok := k.(int64)
 				if !ok {
 					sy.reqMap.Delete(k)
 					return true
 				}
-				hq.Nums = append(hq.Nums, num)
+				req, ok := v.(*blockRequest)
+				if !ok {
+					sy.reqMap.Delete(k)
+					return true
+				}
+				if time.Since(req.time) < headerTimeout {
+					return true
+				}
+				if req.peerID != "" {
+					timeoutPeers[req.peerID] = true
+				}
+				if req.retries >= maxHeaderRetries {
+					hashq.Nums = append(hashq.Nums, num)
+				} else {
+					hq.Nums = append(hq.Nums, num)
+				}
 				return true
 			})
-			if len(hq.Nums) > 0 {
-				// ilog.Debug("retry download ", hr.Nums)
-				sort.Slice(hq.Nums, func(i int, j int) bool {
-					return hq.Nums[i] < hq.Nums[j]
+			for peerID := range timeoutPeers {
+				sy.scorer.timeout(peerID, "")
+			}
+			for _, q := range []*message.BlockHashQuery{hq, hashq} {
+				if len(q.Nums) == 0 {
+					continue
+				}
+				// ilog.Debug("retry download ", q.Nums)
+				sort.Slice(q.Nums, func(i int, j int) bool {
+					return q.Nums[i] < q.Nums[j]
 				})
-				sy.queryBlockHash(hq)
+				if int64(len(q.Nums)) > maxBlockHashQueryNumber {
+					q.Nums = q.Nums[:maxBlockHashQueryNumber]
+				}
+				if q == hq {
+					sy.queryBlockHeader(q, q.Nums[len(q.Nums)-1])
+				} else {
+					sy.queryBlockHash(q)
+				}
 			}
 		case <-sy.exitSignal:
 			return
@@ -21,7 +21,7 @@ func TestDownloadController(t *testing.T) {
 			dHash <- hash
 			dPID <- peerID
 			return true
-		})
+		}, newPeerScorer())
 		dc.Start()
 		So(err, ShouldBeNil)
 		Convey("Check OnRecvHash", func() {
@@ -59,8 +59,8 @@ func TestSynchronizer(t *testing.T) {
 		mockController := gomock.NewController(t)
 		mockP2PService := p2p_mock.NewMockService(mockController)
 		channel := make(chan p2p.IncomingMessage, 1024)
-		mockP2PService.EXPECT().Register(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(channel).AnyTimes()
-		mockP2PService.EXPECT().Register(gomock.Any(), gomock.Any()).Return(channel)
+		mockP2PService.EXPECT().Register(gomock.Any(), gomock.Any()).Return(channel).Times(2)
+		mockP2PService.EXPECT().Register(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(channel).Times(2)
 		mockP2PService.EXPECT().Broadcast(gomock.Any(), gomock.Any(), gomock.Any()).Do(func(a interface{}, b interface{}, c interface{}) {
 			channel <- *p2p.NewIncomingMessage("abc", a.([]byte), b.(p2p.MessageType))
 		}).AnyTimes()
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"time"
 
+	"github.com/iost-official/go-iost/account"
 	"github.com/iost-official/go-iost/common"
 	"github.com/iost-official/go-iost/core/block"
 	"github.com/iost-official/go-iost/core/tx"
@@ -21,7 +22,16 @@ var (
 	errTxHash     = errors.New("wrong txs hash")
 	errMerkleHash = errors.New("wrong tx receipt merkle hash")
 	errTxReceipt  = errors.New("wrong tx receipt")
-	// TxExecTimeLimit the maximum verify execution time of a transaction
+	errTime       = errors.New("block time isn't after the parent")
+	errWitness    = errors.New("wrong witness")
+	errSignature  = errors.New("wrong signature")
+	// ErrCheckpoint is returned if the block conflicts with a checkpoint
+	ErrCheckpoint = errors.New("block conflicts with checkpoint")
+	// ErrSchedule is returned if the witness of the head isn't scheduled for its slot
+	ErrSchedule = errors.New("witness isn't scheduled for the slot")
+	// TxExecTimeLimit the maximum verify execution time of a transaction, from the metering fork on it only
+	// protects this node, a block is rejected if any of its txs exceeds it, since the receipts don't depend on time.
+	// It is set by the verifytimelimit of the vm config.
 	TxExecTimeLimit = 400 * time.Millisecond
 )
 
@@ -46,24 +56,93 @@ func VerifyBlockHead(blk *block.Block, parentBlock *block.Block, lib *block.Bloc
 	if !bytes.Equal(blk.CalculateMerkleHash(), bh.MerkleHash) {
 		return errMerkleHash
 	}
+	return VerifyCheckpoint(blk)
+}
+
+// VerifyCheckpoint checks the block is the checkpoint if there is one at its number.
+func VerifyCheckpoint(blk *block.Block) error {
+	if hash, ok := common.CheckpointHash(blk.Head.Number); ok && !bytes.Equal(hash, blk.HeadHash()) {
+		return ErrCheckpoint
+	}
 	return nil
 }
 
-//VerifyBlockWithVM verifies the block with VM.
+// VerifyHeader verifies the block head links to the parent and is signed by its witness, without the txs of the block.
+// The witness must be the one of the slot in any of the schedules, which are the witness lists the head may be produced by.
+func VerifyHeader(blk *block.Block, parentBlock *block.Block, schedules [][]string) error {
+	bh := blk.Head
+	if bh.Time > time.Now().Unix()/common.SlotLength+1 {
+		return errFutureBlk
+	}
+	if !bytes.Equal(bh.ParentHash, parentBlock.HeadHash()) {
+		return errParentHash
+	}
+	if bh.Number != parentBlock.Head.Number+1 {
+		return errNumber
+	}
+	if bh.Time <= parentBlock.Head.Time {
+		return errTime
+	}
+	if err := VerifyCheckpoint(blk); err != nil {
+		return err
+	}
+	if len(bh.Witness) <= 4 || len(common.Base58Decode(bh.Witness[4:])) <= 4 {
+		return errWitness
+	}
+	if !scheduled(bh, schedules) {
+		return ErrSchedule
+	}
+	if blk.Sign == nil {
+		return errSignature
+	}
+	blk.Sign.SetPubkey(account.GetPubkeyByID(bh.Witness))
+	if !blk.Sign.Verify(blk.HeadHash()) {
+		return errSignature
+	}
+	return nil
+}
+
+func scheduled(bh *block.BlockHead, schedules [][]string) bool {
+	for _, witnesses := range schedules {
+		if len(witnesses) > 0 && witnesses[bh.Time%int64(len(witnesses))] == bh.Witness {
+			return true
+		}
+	}
+	return false
+}
+
+// VerifyBlockWithVM verifies the block with VM, the txs are executed in batches of the parallel workers.
 func VerifyBlockWithVM(blk *block.Block, db db.MVCCDB) error {
+	vm.RecordRandom(blk.Head, db)
 	engine := vm.NewEngine(blk.Head, db)
-	for k, t := range blk.Txs {
-		et := TxExecTimeLimit
-		if blk.Receipts[k].Status.Code == tx.ErrorTimeout {
-			et /= 4
+	executor := vm.NewExecutor(engine, blk.Head, db, vm.ParallelWorkers())
+	batchSize := vm.ParallelWorkers()
+	if batchSize < 1 {
+		batchSize = 1
+	}
+	for start := 0; start < len(blk.Txs); start += batchSize {
+		end := start + batchSize
+		if end > len(blk.Txs) {
+			end = len(blk.Txs)
 		}
-		receipt, err := engine.Exec(t, et)
-		if err != nil {
-			return err
+		limits := make([]time.Duration, 0, end-start)
+		for k := start; k < end; k++ {
+			et := TxExecTimeLimit
+			if !common.Forks.IsMetering(blk.Head.Number) && blk.Receipts[k].Status.Code == tx.ErrorTimeout {
+				et /= 4
+			}
+			limits = append(limits, et)
 		}
-		if !bytes.Equal(blk.Receipts[k].Encode(), receipt.Encode()) {
-			ilog.Errorf("block num: %v , receipt: %v, blk.Receipts[%v]: %v, action name: %v", blk.Head.Number, receipt, k, blk.Receipts[k], blk.Txs[k].Actions[0].ActionName)
-			return errTxReceipt
+		receipts, errs := executor.Exec(blk.Txs[start:end], limits)
+		for i, receipt := range receipts {
+			k := start + i
+			if errs[i] != nil {
+				return errs[i]
+			}
+			if !bytes.Equal(blk.Receipts[k].Encode(), receipt.Encode()) {
+				ilog.Errorf("block num: %v , receipt: %v, blk.Receipts[%v]: %v, action name: %v", blk.Head.Number, receipt, k, blk.Receipts[k], blk.Txs[k].Actions[0].ActionName)
+				return errTxReceipt
+			}
 		}
 	}
 	return nil
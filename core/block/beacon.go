@@ -0,0 +1,90 @@
+package block
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+
+	"github.com/iost-official/go-iost/common"
+	"github.com/iost-official/go-iost/crypto"
+)
+
+var (
+	// ErrNoBeacon is returned if the block head has no beacon, it's required from the BeaconHeight of the forks
+	ErrNoBeacon = errors.New("block has no beacon")
+	// ErrBeacon is returned if the beacon isn't the vrf output of the witness
+	ErrBeacon = errors.New("wrong beacon")
+	// ErrBeaconAlgorithm is returned if the key of the witness isn't ed25519, which is the only one with the vrf
+	ErrBeaconAlgorithm = errors.New("beacon needs an ed25519 witness key")
+)
+
+// Beacon is the randomness of a block, kept in BlockHead.Info. It's the VRF output of the witness
+// on the randomness of the parent, the witness can't choose it and anyone can verify it with the proof.
+// It's the ECVRF of ed25519 in RFC 9381, so the witnesses need ed25519 keys from the BeaconHeight of the forks.
+//
+// The witness still knows the beacon of its block before the txs are packed, and may drop the txs it
+// doesn't like, so a contract shouldn't resolve a bet with the beacon of the block the bet is made in.
+// The bet is committed in block N and resolved later with the beacon of a block after N, which isn't
+// known when the bet is committed.
+type Beacon struct {
+	Output []byte `json:"vrf_output"`
+	Proof  []byte `json:"vrf_proof"`
+}
+
+// NewBeacon proves the beacon of the child of parent with the witness key.
+func NewBeacon(parent *Block, algo crypto.Algorithm, seckey []byte) (*Beacon, error) {
+	if algo != crypto.Ed25519 {
+		return nil, ErrBeaconAlgorithm
+	}
+	output, proof := algo.VRFProve(parent.Randomness(), seckey)
+	return &Beacon{
+		Output: output,
+		Proof:  proof,
+	}, nil
+}
+
+// Verify checks the beacon is proved on the randomness of parent by the witness pubkey.
+func (b *Beacon) Verify(parent *Block, algo crypto.Algorithm, pubkey []byte) error {
+	if algo != crypto.Ed25519 {
+		return ErrBeaconAlgorithm
+	}
+	output, ok := algo.VRFVerify(parent.Randomness(), pubkey, b.Proof)
+	if !ok || !bytes.Equal(output, b.Output) {
+		return ErrBeacon
+	}
+	return nil
+}
+
+// Beacon returns the beacon in the info of the block head.
+func (b *BlockHead) Beacon() (*Beacon, error) {
+	if len(b.Info) == 0 {
+		return nil, ErrNoBeacon
+	}
+	beacon := &Beacon{}
+	if err := json.Unmarshal(b.Info, beacon); err != nil {
+		return nil, err
+	}
+	return beacon, nil
+}
+
+// SetBeacon sets the beacon to the info of the block head.
+func (b *BlockHead) SetBeacon(beacon *Beacon) error {
+	info, err := json.Marshal(beacon)
+	if err != nil {
+		return err
+	}
+	b.Info = info
+	return nil
+}
+
+// Randomness returns the randomness of the block, it's the output of the beacon, or the head hash
+// if the block has none, such as the genesis and the blocks before the BeaconHeight of the forks.
+func (b *Block) Randomness() []byte {
+	if !common.Forks.IsBeacon(b.Head.Number) {
+		return b.HeadHash()
+	}
+	if beacon, err := b.Head.Beacon(); err == nil {
+		return beacon.Output
+	}
+	return b.HeadHash()
+}
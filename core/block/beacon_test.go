@@ -0,0 +1,76 @@
+package block
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/iost-official/go-iost/common"
+	"github.com/iost-official/go-iost/crypto"
+)
+
+func TestBeacon(t *testing.T) {
+	forks := common.Forks
+	common.SetForks(&common.ForkConfig{ParallelHeight: -1, MeteringHeight: -1, BeaconHeight: 2})
+	defer common.SetForks(forks)
+
+	parent := &Block{Head: &BlockHead{Number: 1, Witness: "w"}}
+	if err := parent.CalculateHeadHash(); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(parent.Randomness(), parent.HeadHash()) {
+		t.Fatal("randomness of the block without beacon should be its head hash")
+	}
+
+	algo := crypto.Ed25519
+	seckey := algo.GenSeckey()
+	pubkey := algo.GetPubkey(seckey)
+
+	head := &BlockHead{Number: 2, ParentHash: parent.HeadHash()}
+	if _, err := head.Beacon(); err != ErrNoBeacon {
+		t.Fatalf("expect %v, got %v", ErrNoBeacon, err)
+	}
+	newBeacon, err := NewBeacon(parent, algo, seckey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := head.SetBeacon(newBeacon); err != nil {
+		t.Fatal(err)
+	}
+	blk := &Block{Head: head}
+	beacon, err := blk.Head.Beacon()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := beacon.Verify(parent, algo, pubkey); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(blk.Randomness(), beacon.Output) {
+		t.Error("randomness of the block should be the beacon output")
+	}
+	early := &Block{Head: &BlockHead{Number: 1, Info: head.Info}}
+	if err := early.CalculateHeadHash(); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(early.Randomness(), early.HeadHash()) {
+		t.Error("randomness of the block before the beacon height should be its head hash")
+	}
+
+	if err := beacon.Verify(blk, algo, pubkey); err != ErrBeacon {
+		t.Errorf("beacon on another parent: expect %v, got %v", ErrBeacon, err)
+	}
+	if err := beacon.Verify(parent, algo, algo.GetPubkey(algo.GenSeckey())); err != ErrBeacon {
+		t.Errorf("beacon of another witness: expect %v, got %v", ErrBeacon, err)
+	}
+	forged := &Beacon{Output: parent.HeadHash(), Proof: beacon.Proof}
+	if err := forged.Verify(parent, algo, pubkey); err != ErrBeacon {
+		t.Errorf("forged output: expect %v, got %v", ErrBeacon, err)
+	}
+
+	secp := crypto.Secp256k1
+	if _, err := NewBeacon(parent, secp, secp.GenSeckey()); err != ErrBeaconAlgorithm {
+		t.Errorf("beacon of secp256k1 key: expect %v, got %v", ErrBeaconAlgorithm, err)
+	}
+	if err := beacon.Verify(parent, secp, pubkey); err != ErrBeaconAlgorithm {
+		t.Errorf("beacon verified by secp256k1: expect %v, got %v", ErrBeaconAlgorithm, err)
+	}
+}
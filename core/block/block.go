@@ -111,6 +111,22 @@ func (b *Block) LenTx() int {
 	return len(b.Txs)
 }
 
+// Events return the contract events in the receipts with their location in this block
+func (b *Block) Events() []*tx.Event {
+	events := make([]*tx.Event, 0)
+	blkHash := common.Base58Encode(b.HeadHash())
+	for _, r := range b.Receipts {
+		txHash := common.Base58Encode(r.TxHash)
+		for _, e := range r.Events() {
+			e.TxHash = txHash
+			e.BlockHash = blkHash
+			e.BlockNumber = b.Head.Number
+			events = append(events, e)
+		}
+	}
+	return events
+}
+
 // Encode is marshal
 func (b *BlockHead) Encode() ([]byte, error) {
 	bhByte, err := proto.Marshal(b)
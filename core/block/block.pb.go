@@ -38,7 +38,7 @@ func (m *BlockHead) Reset()         { *m = BlockHead{} }
 func (m *BlockHead) String() string { return proto.CompactTextString(m) }
 func (*BlockHead) ProtoMessage()    {}
 func (*BlockHead) Descriptor() ([]byte, []int) {
-	return fileDescriptor_block_1079e4dc6faad51b, []int{0}
+	return fileDescriptor_block_8f37b2f2a2d8b6fc, []int{0}
 }
 func (m *BlockHead) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -137,7 +137,7 @@ func (m *BlockRaw) Reset()         { *m = BlockRaw{} }
 func (m *BlockRaw) String() string { return proto.CompactTextString(m) }
 func (*BlockRaw) ProtoMessage()    {}
 func (*BlockRaw) Descriptor() ([]byte, []int) {
-	return fileDescriptor_block_1079e4dc6faad51b, []int{1}
+	return fileDescriptor_block_8f37b2f2a2d8b6fc, []int{1}
 }
 func (m *BlockRaw) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -194,9 +194,81 @@ func (m *BlockRaw) GetReceipts() [][]byte {
 	return nil
 }
 
+type Evidence struct {
+	HeadA                *BlockHead `protobuf:"bytes,1,opt,name=headA" json:"headA,omitempty"`
+	SignA                []byte     `protobuf:"bytes,2,opt,name=signA,proto3" json:"signA,omitempty"`
+	HeadB                *BlockHead `protobuf:"bytes,3,opt,name=headB" json:"headB,omitempty"`
+	SignB                []byte     `protobuf:"bytes,4,opt,name=signB,proto3" json:"signB,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *Evidence) Reset()         { *m = Evidence{} }
+func (m *Evidence) String() string { return proto.CompactTextString(m) }
+func (*Evidence) ProtoMessage()    {}
+func (*Evidence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_block_8f37b2f2a2d8b6fc, []int{2}
+}
+func (m *Evidence) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Evidence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Evidence.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *Evidence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Evidence.Merge(dst, src)
+}
+func (m *Evidence) XXX_Size() int {
+	return m.Size()
+}
+func (m *Evidence) XXX_DiscardUnknown() {
+	xxx_messageInfo_Evidence.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Evidence proto.InternalMessageInfo
+
+func (m *Evidence) GetHeadA() *BlockHead {
+	if m != nil {
+		return m.HeadA
+	}
+	return nil
+}
+
+func (m *Evidence) GetSignA() []byte {
+	if m != nil {
+		return m.SignA
+	}
+	return nil
+}
+
+func (m *Evidence) GetHeadB() *BlockHead {
+	if m != nil {
+		return m.HeadB
+	}
+	return nil
+}
+
+func (m *Evidence) GetSignB() []byte {
+	if m != nil {
+		return m.SignB
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*BlockHead)(nil), "block.BlockHead")
 	proto.RegisterType((*BlockRaw)(nil), "block.BlockRaw")
+	proto.RegisterType((*Evidence)(nil), "block.Evidence")
 }
 func (m *BlockHead) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
@@ -317,6 +389,59 @@ func (m *BlockRaw) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *Evidence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Evidence) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.HeadA != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintBlock(dAtA, i, uint64(m.HeadA.Size()))
+		n2, err := m.HeadA.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
+	}
+	if len(m.SignA) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintBlock(dAtA, i, uint64(len(m.SignA)))
+		i += copy(dAtA[i:], m.SignA)
+	}
+	if m.HeadB != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintBlock(dAtA, i, uint64(m.HeadB.Size()))
+		n3, err := m.HeadB.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
+	}
+	if len(m.SignB) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintBlock(dAtA, i, uint64(len(m.SignB)))
+		i += copy(dAtA[i:], m.SignB)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
 func encodeVarintBlock(dAtA []byte, offset int, v uint64) int {
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
@@ -399,6 +524,34 @@ func (m *BlockRaw) Size() (n int) {
 	return n
 }
 
+func (m *Evidence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HeadA != nil {
+		l = m.HeadA.Size()
+		n += 1 + l + sovBlock(uint64(l))
+	}
+	l = len(m.SignA)
+	if l > 0 {
+		n += 1 + l + sovBlock(uint64(l))
+	}
+	if m.HeadB != nil {
+		l = m.HeadB.Size()
+		n += 1 + l + sovBlock(uint64(l))
+	}
+	l = len(m.SignB)
+	if l > 0 {
+		n += 1 + l + sovBlock(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovBlock(x uint64) (n int) {
 	for {
 		n++
@@ -846,6 +999,185 @@ func (m *BlockRaw) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Evidence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlock
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Evidence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Evidence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeadA", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBlock
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HeadA == nil {
+				m.HeadA = &BlockHead{}
+			}
+			if err := m.HeadA.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignA", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBlock
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignA = append(m.SignA[:0], dAtA[iNdEx:postIndex]...)
+			if m.SignA == nil {
+				m.SignA = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeadB", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBlock
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HeadB == nil {
+				m.HeadB = &BlockHead{}
+			}
+			if err := m.HeadB.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignB", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBlock
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignB = append(m.SignB[:0], dAtA[iNdEx:postIndex]...)
+			if m.SignB == nil {
+				m.SignB = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlock(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBlock
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipBlock(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -951,26 +1283,28 @@ var (
 	ErrIntOverflowBlock   = fmt.Errorf("proto: integer overflow")
 )
 
-func init() { proto.RegisterFile("core/block/block.proto", fileDescriptor_block_1079e4dc6faad51b) }
-
-var fileDescriptor_block_1079e4dc6faad51b = []byte{
-	// 273 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x90, 0xb1, 0x4e, 0xc3, 0x30,
-	0x10, 0x86, 0x31, 0x49, 0xd3, 0xf4, 0xe8, 0x10, 0x79, 0xa8, 0x2c, 0x86, 0x28, 0xaa, 0x18, 0x32,
-	0x15, 0x09, 0xde, 0xa0, 0x53, 0x67, 0xbf, 0x41, 0x92, 0x1e, 0xd4, 0x6a, 0x63, 0x47, 0xb6, 0xa1,
-	0x7d, 0x14, 0x1e, 0x89, 0x91, 0x95, 0x0d, 0x85, 0x17, 0x41, 0xbe, 0x34, 0xa5, 0x8b, 0xf5, 0x7f,
-	0xf7, 0xfb, 0xce, 0xfe, 0x0f, 0x16, 0x8d, 0xb1, 0xf8, 0x58, 0x1f, 0x4c, 0xb3, 0x1f, 0xce, 0x55,
-	0x67, 0x8d, 0x37, 0x7c, 0x42, 0xb0, 0xfc, 0x66, 0x30, 0x5b, 0x07, 0xb5, 0xc1, 0x6a, 0xcb, 0x05,
-	0x4c, 0xdf, 0xd1, 0x3a, 0x65, 0xb4, 0x60, 0x05, 0x2b, 0x23, 0x39, 0x22, 0xcf, 0x01, 0xba, 0xca,
-	0xa2, 0xf6, 0x9b, 0xca, 0xed, 0xc4, 0x6d, 0xc1, 0xca, 0xb9, 0xbc, 0xaa, 0x84, 0x4e, 0x7f, 0x72,
-	0x64, 0x46, 0x64, 0x8e, 0x18, 0x3a, 0x5b, 0xb4, 0xfb, 0x03, 0x92, 0x19, 0x0f, 0x9d, 0xff, 0x15,
-	0xce, 0x21, 0x56, 0xfa, 0xc5, 0x88, 0x09, 0x39, 0xa4, 0xf9, 0x02, 0x12, 0xfd, 0xd6, 0xd6, 0x68,
-	0x45, 0x42, 0xdf, 0x38, 0x53, 0x78, 0xe5, 0xa8, 0xbc, 0x46, 0xe7, 0xc4, 0xb4, 0x60, 0xe5, 0x4c,
-	0x8e, 0x18, 0xa6, 0x78, 0xd5, 0xa2, 0x48, 0xe9, 0x3e, 0xe9, 0xa5, 0x85, 0x94, 0xa2, 0xc9, 0xea,
-	0xc8, 0x1f, 0x20, 0xde, 0x61, 0xb5, 0xa5, 0x58, 0x77, 0x4f, 0xd9, 0x6a, 0x58, 0xc5, 0x25, 0xb9,
-	0x24, 0x37, 0x4c, 0x71, 0xea, 0x55, 0x9f, 0xf3, 0x91, 0xe6, 0x19, 0x44, 0xfe, 0xe4, 0x44, 0x54,
-	0x44, 0xe5, 0x5c, 0x06, 0xc9, 0xef, 0x21, 0xb5, 0xd8, 0xa0, 0xea, 0xbc, 0x13, 0x31, 0x95, 0x2f,
-	0xbc, 0xce, 0x3e, 0xfb, 0x9c, 0x7d, 0xf5, 0x39, 0xfb, 0xe9, 0x73, 0xf6, 0xf1, 0x9b, 0xdf, 0xd4,
-	0x09, 0xed, 0xfb, 0xf9, 0x2f, 0x00, 0x00, 0xff, 0xff, 0xb6, 0x62, 0xd4, 0x4e, 0x89, 0x01, 0x00,
-	0x00,
+func init() { proto.RegisterFile("core/block/block.proto", fileDescriptor_block_8f37b2f2a2d8b6fc) }
+
+var fileDescriptor_block_8f37b2f2a2d8b6fc = []byte{
+	// 318 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0xc1, 0x4e, 0xc2, 0x40,
+	0x10, 0x86, 0x5d, 0xdb, 0x42, 0x19, 0x39, 0x90, 0x8d, 0x21, 0x1b, 0x0f, 0x4d, 0x43, 0x8c, 0xe9,
+	0x09, 0x13, 0x7d, 0x02, 0x9a, 0x98, 0x70, 0xee, 0x1b, 0x94, 0x32, 0xca, 0x06, 0xd8, 0x92, 0xdd,
+	0x15, 0x78, 0x02, 0x9f, 0xc1, 0x47, 0xf2, 0xe8, 0xd5, 0x9b, 0xa9, 0x2f, 0x62, 0x76, 0xb6, 0x45,
+	0x2f, 0x7a, 0x69, 0xfe, 0x6f, 0xfe, 0xfe, 0x33, 0x9d, 0x29, 0x8c, 0xab, 0x5a, 0xe3, 0xed, 0x62,
+	0x53, 0x57, 0x6b, 0xff, 0x9c, 0xee, 0x74, 0x6d, 0x6b, 0x1e, 0x11, 0x4c, 0x3e, 0x18, 0x0c, 0x72,
+	0xa7, 0xe6, 0x58, 0x2e, 0xb9, 0x80, 0xfe, 0x1e, 0xb5, 0x91, 0xb5, 0x12, 0x2c, 0x65, 0x59, 0x50,
+	0x74, 0xc8, 0x13, 0x80, 0x5d, 0xa9, 0x51, 0xd9, 0x79, 0x69, 0x56, 0xe2, 0x3c, 0x65, 0xd9, 0xb0,
+	0xf8, 0x55, 0x71, 0x49, 0x7b, 0x34, 0x64, 0x06, 0x64, 0x76, 0xe8, 0x92, 0x5b, 0xd4, 0xeb, 0x0d,
+	0x92, 0x19, 0xfa, 0xe4, 0x4f, 0x85, 0x73, 0x08, 0xa5, 0x7a, 0xac, 0x45, 0x44, 0x0e, 0x69, 0x3e,
+	0x86, 0x9e, 0x7a, 0xde, 0x2e, 0x50, 0x8b, 0x1e, 0x7d, 0x46, 0x4b, 0x6e, 0xca, 0x41, 0x5a, 0x85,
+	0xc6, 0x88, 0x7e, 0xca, 0xb2, 0x41, 0xd1, 0xa1, 0xeb, 0x62, 0xe5, 0x16, 0x45, 0x4c, 0xef, 0x93,
+	0x9e, 0x68, 0x88, 0x69, 0xb5, 0xa2, 0x3c, 0xf0, 0x6b, 0x08, 0x57, 0x58, 0x2e, 0x69, 0xad, 0x8b,
+	0xbb, 0xd1, 0xd4, 0x9f, 0xe2, 0xb4, 0x79, 0x41, 0xae, 0xeb, 0x62, 0xe4, 0x93, 0x6a, 0xf7, 0x23,
+	0xcd, 0x47, 0x10, 0xd8, 0xa3, 0x11, 0x41, 0x1a, 0x64, 0xc3, 0xc2, 0x49, 0x7e, 0x05, 0xb1, 0xc6,
+	0x0a, 0xe5, 0xce, 0x1a, 0x11, 0x52, 0xf9, 0xc4, 0x93, 0x17, 0x06, 0xf1, 0xc3, 0x5e, 0x2e, 0x51,
+	0x55, 0xc8, 0x6f, 0x20, 0x72, 0x6d, 0x67, 0x7f, 0x4e, 0xf5, 0x36, 0xbf, 0x84, 0xc8, 0x8d, 0x9a,
+	0xb5, 0x73, 0x3d, 0x74, 0xe9, 0x5c, 0x04, 0xff, 0xa5, 0xf3, 0x2e, 0x9d, 0xb7, 0xb7, 0xf5, 0x90,
+	0x8f, 0xde, 0x9a, 0x84, 0xbd, 0x37, 0x09, 0xfb, 0x6c, 0x12, 0xf6, 0xfa, 0x95, 0x9c, 0x2d, 0x7a,
+	0xf4, 0xe3, 0xef, 0xbf, 0x07, 0x00, 0xd9, 0x27, 0xb4, 0x83, 0x12, 0x02, 0x00, 0x00,
 }
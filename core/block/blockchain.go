@@ -15,10 +15,15 @@ import (
 type BlockChain struct {
 	blockChainDB *kv.Storage
 	length       int64
+	start        int64
 }
 
+// ErrPruned is returned for the blocks between the genesis block and the start of a chain starting from a checkpoint.
+var ErrPruned = errors.New("block is pruned before the checkpoint")
+
 var (
 	blockLength       = []byte("BlockLength")
+	blockStart        = []byte("BlockStart")
 	blockNumberPrefix = []byte("n")
 	blockPrefix       = []byte("H")
 )
@@ -59,7 +64,15 @@ func NewBlockChain(path string) (Chain, error) {
 			err = errors.New("fail to put blocklength")
 		}
 	}
-	BC := &BlockChain{levelDB, length}
+	var start int64
+	startByte, tempErr := levelDB.Get(blockStart)
+	if tempErr != nil {
+		return nil, fmt.Errorf("fail to get blockstart, %v", tempErr)
+	}
+	if len(startByte) > 0 {
+		start = ByteToInt64(startByte)
+	}
+	BC := &BlockChain{levelDB, length, start}
 	BC.CheckLength()
 	return BC, err
 }
@@ -69,20 +82,56 @@ func (bc *BlockChain) Length() int64 {
 	return bc.length
 }
 
-// Push save the block to database
-func (bc *BlockChain) Push(block *Block) error {
+// Start returns the number of the first block of the chain after the genesis block, the blocks between
+// them are not stored if the chain starts from a checkpoint.
+func (bc *BlockChain) Start() int64 {
+	return bc.start
+}
+
+// PushCheckpoint saves the genesis block and the trusted block whose state is restored from a snapshot in one batch,
+// the chain starts from the trusted block.
+func (bc *BlockChain) PushCheckpoint(genesis *Block, block *Block) error {
+	if bc.length > 0 {
+		return errors.New("fail to start from checkpoint, the chain isn't empty")
+	}
+	if genesis.Head.Number != 0 || block.Head.Number <= 0 {
+		return errors.New("fail to start from checkpoint before the genesis block")
+	}
 	err := bc.blockChainDB.BeginBatch()
 	if err != nil {
 		return errors.New("fail to begin batch")
 	}
-	hash := block.HeadHash()
+	if err := bc.putBlock(genesis); err != nil {
+		bc.blockChainDB.RollbackBatch()
+		return err
+	}
+	if err := bc.putBlock(block); err != nil {
+		bc.blockChainDB.RollbackBatch()
+		return err
+	}
 	number := block.Head.Number
-	bc.blockChainDB.Put(append(blockNumberPrefix, Int64ToByte(number)...), hash)
-	blockByte, err := block.Encode()
+	bc.blockChainDB.Put(blockLength, Int64ToByte(number+1))
+	bc.blockChainDB.Put(blockStart, Int64ToByte(number))
+	err = bc.blockChainDB.CommitBatch()
 	if err != nil {
-		return errors.New("fail to encode block")
+		bc.blockChainDB.RollbackBatch()
+		return fmt.Errorf("fail to put block, err:%s", err)
 	}
-	bc.blockChainDB.Put(append(blockPrefix, hash...), blockByte)
+	bc.length = number + 1
+	bc.start = number
+	return nil
+}
+
+// Push save the block to database
+func (bc *BlockChain) Push(block *Block) error {
+	err := bc.blockChainDB.BeginBatch()
+	if err != nil {
+		return errors.New("fail to begin batch")
+	}
+	if err := bc.putBlock(block); err != nil {
+		return err
+	}
+	number := block.Head.Number
 	bc.blockChainDB.Put(blockLength, Int64ToByte(number+1))
 	err = bc.blockChainDB.CommitBatch()
 	if err != nil {
@@ -92,6 +141,17 @@ func (bc *BlockChain) Push(block *Block) error {
 	return nil
 }
 
+func (bc *BlockChain) putBlock(block *Block) error {
+	hash := block.HeadHash()
+	bc.blockChainDB.Put(append(blockNumberPrefix, Int64ToByte(block.Head.Number)...), hash)
+	blockByte, err := block.Encode()
+	if err != nil {
+		return errors.New("fail to encode block")
+	}
+	bc.blockChainDB.Put(append(blockPrefix, hash...), blockByte)
+	return nil
+}
+
 // CheckLength is check length of block in database
 func (bc *BlockChain) CheckLength() {
 	for i := bc.length; i > 0; i-- {
@@ -116,6 +176,9 @@ func (bc *BlockChain) Top() (*Block, error) {
 
 // GetHashByNumber is get hash by number
 func (bc *BlockChain) GetHashByNumber(number int64) ([]byte, error) {
+	if number > 0 && number < bc.start {
+		return nil, ErrPruned
+	}
 	hash, err := bc.blockChainDB.Get(append(blockNumberPrefix, Int64ToByte(number)...))
 	if err != nil || len(hash) == 0 {
 		return nil, errors.New("fail to get hash by number")
@@ -160,7 +223,11 @@ func (bc *BlockChain) Close() {
 	bc.blockChainDB.Close()
 }
 
+// Draw returns the numbers and the witnesses of the blocks in [start, end], from the start of the chain on.
 func (bc *BlockChain) Draw(start int64, end int64) string {
+	if start < bc.start {
+		start = bc.start
+	}
 	ret := ""
 	for i := start; i <= end; i++ {
 		blk, err := bc.GetBlockByNumber(i)
@@ -169,6 +236,9 @@ func (bc *BlockChain) Draw(start int64, end int64) string {
 		}
 		ret += strconv.FormatInt(blk.Head.Number, 10) + "(" + blk.Head.Witness[4:6] + ")-"
 	}
+	if ret == "" {
+		return ret
+	}
 	ret = ret[0 : len(ret)-1]
 	return ret
 }
@@ -82,3 +82,37 @@ func TestChainImpl(t *testing.T) {
 		os.RemoveAll("./BlockChainDB/")
 	})
 }
+
+func TestChainImpl_Checkpoint(t *testing.T) {
+	bc, err := NewBlockChain("./CheckpointDB/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll("./CheckpointDB/")
+	genesis := &Block{Head: &BlockHead{Number: 0, Witness: "IOSTgenesis"}, Sign: &crypto.Signature{}}
+	genesis.CalculateHeadHash()
+	checkpoint := &Block{Head: &BlockHead{Number: 10, ParentHash: []byte("parent"), Witness: "IOSTwitness"}, Sign: &crypto.Signature{}}
+	checkpoint.CalculateHeadHash()
+	if err := bc.PushCheckpoint(genesis, checkpoint); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := bc.GetBlockByNumber(0); err != nil {
+		t.Fatalf("genesis block: %v", err)
+	}
+	if _, err := bc.GetBlockByNumber(10); err != nil {
+		t.Fatalf("checkpoint block: %v", err)
+	}
+	if _, err := bc.GetBlockByNumber(5); err != ErrPruned {
+		t.Fatalf("block before the checkpoint: err %v", err)
+	}
+	if _, err := bc.GetBlockByNumber(11); err == nil || err == ErrPruned {
+		t.Fatalf("block after the top: err %v", err)
+	}
+	if s := bc.Draw(0, 10); s != "10(wi)" {
+		t.Fatalf("draw: %q", s)
+	}
+	if s := bc.Draw(1, 5); s != "" {
+		t.Fatalf("draw pruned blocks: %q", s)
+	}
+}
@@ -0,0 +1,114 @@
+package block
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/iost-official/go-iost/account"
+	"github.com/iost-official/go-iost/common"
+	"github.com/iost-official/go-iost/crypto"
+)
+
+var (
+	errEvidenceHead      = errors.New("evidence has no block head")
+	errEvidenceWitness   = errors.New("evidence has wrong witness")
+	errEvidenceSlot      = errors.New("evidence blocks are in different slots")
+	errEvidenceSameBlock = errors.New("evidence blocks are the same")
+	errEvidenceSignature = errors.New("evidence has wrong signature")
+)
+
+// NewEvidence returns the evidence that the witness signed both blocks in the same slot.
+// The blocks are ordered by hash, so the same pair of blocks always gives the same evidence.
+func NewEvidence(a *Block, b *Block) (*Evidence, error) {
+	if bytes.Compare(a.HeadHash(), b.HeadHash()) > 0 {
+		a, b = b, a
+	}
+	signA, err := a.Sign.Encode()
+	if err != nil {
+		return nil, err
+	}
+	signB, err := b.Sign.Encode()
+	if err != nil {
+		return nil, err
+	}
+	e := &Evidence{
+		HeadA: a.Head,
+		SignA: signA,
+		HeadB: b.Head,
+		SignB: signB,
+	}
+	return e, e.Verify()
+}
+
+// Witness returns the witness who signed the blocks.
+func (e *Evidence) Witness() string {
+	if e.HeadA == nil {
+		return ""
+	}
+	return e.HeadA.Witness
+}
+
+// Slot returns the slot of the blocks.
+func (e *Evidence) Slot() int64 {
+	if e.HeadA == nil {
+		return 0
+	}
+	return e.HeadA.Time
+}
+
+// Key returns the key of the evidence, one witness has at most one evidence in a slot.
+func (e *Evidence) Key() []byte {
+	return append([]byte(e.Witness()+"-"), Int64ToByte(e.Slot())...)
+}
+
+// Verify checks that the blocks are different, in the same slot, and both signed by the witness.
+func (e *Evidence) Verify() error {
+	if e.HeadA == nil || e.HeadB == nil {
+		return errEvidenceHead
+	}
+	if e.HeadA.Witness != e.HeadB.Witness || !strings.HasPrefix(e.HeadA.Witness, "IOST") ||
+		len(common.Base58Decode(e.HeadA.Witness[4:])) <= 4 {
+		return errEvidenceWitness
+	}
+	if e.HeadA.Time != e.HeadB.Time {
+		return errEvidenceSlot
+	}
+	hashA, err := e.HeadA.Hash()
+	if err != nil {
+		return err
+	}
+	hashB, err := e.HeadB.Hash()
+	if err != nil {
+		return err
+	}
+	if bytes.Equal(hashA, hashB) {
+		return errEvidenceSameBlock
+	}
+	pubkey := account.GetPubkeyByID(e.HeadA.Witness)
+	for _, s := range []struct {
+		hash []byte
+		sign []byte
+	}{{hashA, e.SignA}, {hashB, e.SignB}} {
+		var sig crypto.Signature
+		if err := sig.Decode(s.sign); err != nil {
+			return errEvidenceSignature
+		}
+		sig.SetPubkey(pubkey)
+		if !sig.Verify(s.hash) {
+			return errEvidenceSignature
+		}
+	}
+	return nil
+}
+
+// Encode is marshal
+func (e *Evidence) Encode() ([]byte, error) {
+	return proto.Marshal(e)
+}
+
+// Decode is unmarshal
+func (e *Evidence) Decode(b []byte) error {
+	return proto.Unmarshal(b, e)
+}
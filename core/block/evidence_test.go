@@ -0,0 +1,60 @@
+package block
+
+import (
+	"testing"
+
+	"github.com/iost-official/go-iost/account"
+	"github.com/iost-official/go-iost/crypto"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func genSignedBlock(acc *account.Account, number int64, time int64, parent []byte) *Block {
+	blk := &Block{
+		Head: &BlockHead{
+			ParentHash: parent,
+			Number:     number,
+			Witness:    acc.ID,
+			Time:       time,
+		},
+	}
+	blk.CalculateHeadHash()
+	blk.Sign = acc.Sign(blk.HeadHash())
+	return blk
+}
+
+func TestEvidence(t *testing.T) {
+	convey.Convey("Test of evidence", t, func() {
+		acc, _ := account.NewAccount(nil, crypto.Secp256k1)
+		other, _ := account.NewAccount(nil, crypto.Secp256k1)
+		a := genSignedBlock(acc, 10, 100, []byte("parent"))
+		b := genSignedBlock(acc, 10, 100, []byte("another parent"))
+
+		e, err := NewEvidence(a, b)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(e.Witness(), convey.ShouldEqual, acc.ID)
+		convey.So(e.Slot(), convey.ShouldEqual, 100)
+
+		e2, err := NewEvidence(b, a)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(e2.Key(), convey.ShouldResemble, e.Key())
+		convey.So(e2.SignA, convey.ShouldResemble, e.SignA)
+
+		data, err := e.Encode()
+		convey.So(err, convey.ShouldBeNil)
+		var decoded Evidence
+		convey.So(decoded.Decode(data), convey.ShouldBeNil)
+		convey.So(decoded.Verify(), convey.ShouldBeNil)
+
+		_, err = NewEvidence(a, a)
+		convey.So(err, convey.ShouldEqual, errEvidenceSameBlock)
+		_, err = NewEvidence(a, genSignedBlock(acc, 11, 101, a.HeadHash()))
+		convey.So(err, convey.ShouldEqual, errEvidenceSlot)
+		_, err = NewEvidence(a, genSignedBlock(other, 10, 100, []byte("parent")))
+		convey.So(err, convey.ShouldEqual, errEvidenceWitness)
+
+		forged := genSignedBlock(acc, 10, 100, []byte("forged"))
+		forged.Sign = other.Sign(forged.HeadHash())
+		_, err = NewEvidence(a, forged)
+		convey.So(err, convey.ShouldEqual, errEvidenceSignature)
+	})
+}
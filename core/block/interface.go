@@ -6,7 +6,9 @@ package block
 // Chain defines Chain's API.
 type Chain interface {
 	Push(block *Block) error
+	PushCheckpoint(genesis *Block, block *Block) error
 	Length() int64
+	Start() int64
 	CheckLength()
 	Top() (*Block, error)
 	GetHashByNumber(number int64) ([]byte, error)
@@ -3,6 +3,7 @@ package blockcache
 import (
 	"errors"
 	"fmt"
+	"sort"
 	"sync"
 
 	"strconv"
@@ -120,6 +121,8 @@ type BlockCache interface {
 	LinkedRoot() *BlockCacheNode
 	Head() *BlockCacheNode
 	Draw() string
+	Export() *TreeInfo
+	SetFlushHandler(func(*block.Block))
 }
 
 // BlockCacheImpl is the implementation of BlockCache
@@ -131,6 +134,11 @@ type BlockCacheImpl struct {
 	leaf         map[*BlockCacheNode]int64
 	baseVariable global.BaseVariable
 	stateDB      db.MVCCDB
+	store        *blockStore
+	recovering   bool
+	flushHandler func(*block.Block)
+	// mu is held for writing while the tree is changed, and for reading while it's exported
+	mu sync.RWMutex
 }
 
 func (bc *BlockCacheImpl) hmget(hash []byte) (*BlockCacheNode, bool) {
@@ -186,11 +194,72 @@ func NewBlockCache(baseVariable global.BaseVariable) (*BlockCacheImpl, error) {
 		}
 	}
 	bc.head = bc.linkedRoot
+	if conf := baseVariable.Config(); conf != nil && conf.DB != nil {
+		bc.store, err = newBlockStore(conf.DB.LdbPath + "BlockCacheDB")
+		if err != nil {
+			return nil, err
+		}
+	}
 	return &bc, nil
 }
 
+// Recover rebuilds the fork tree from the blocks persisted before the last shutdown.
+// The blocks which can be linked are executed again into the state db by verify, so the node
+// goes back to the same heads it had. The subscribers have seen the events of these blocks
+// before the shutdown, so they aren't posted again.
+func (bc *BlockCacheImpl) Recover(verify func(*block.Block, db.MVCCDB) error) error {
+	if bc.store == nil || bc.linkedRoot.Block == nil {
+		return nil
+	}
+	blks, err := bc.store.blocks()
+	if err != nil {
+		return fmt.Errorf("load blocks from blockcachedb failed, err: %v", err)
+	}
+	sort.Slice(blks, func(i, j int) bool {
+		return blks[i].Head.Number < blks[j].Head.Number
+	})
+	stateDB := bc.baseVariable.StateDB()
+	var linked int
+	bc.recovering = true
+	defer func() { bc.recovering = false }()
+	for _, blk := range blks {
+		if blk.Head.Number <= bc.linkedRoot.Number {
+			bc.store.del(blk.HeadHash())
+			continue
+		}
+		node := bc.Add(blk)
+		if node.Parent == nil || node.Parent.Type != Linked {
+			continue
+		}
+		if !stateDB.Checkout(string(blk.HeadHash())) {
+			stateDB.Checkout(string(blk.Head.ParentHash))
+			if err := verify(blk, stateDB); err != nil {
+				ilog.Errorf("recover block failed, block number: %v, err: %v", blk.Head.Number, err)
+				bc.del(node)
+				continue
+			}
+			stateDB.Tag(string(blk.HeadHash()))
+		}
+		bc.Link(node)
+		linked++
+	}
+	bc.updateLongest()
+	ilog.Infof("recovered %v blocks from blockcachedb, linked: %v, head: %v", len(blks), linked, bc.head.Number)
+	return nil
+}
+
+// Close closes the block store of the block cache.
+func (bc *BlockCacheImpl) Close() {
+	if bc.store != nil {
+		bc.store.close()
+	}
+}
+
 // Link call this when you run the block verify after Add() to ensure add single bcn to linkedRoot
 func (bc *BlockCacheImpl) Link(bcn *BlockCacheNode) {
+	bc.mu.Lock()
+	defer bc.mu.Unlock()
+
 	if bcn == nil {
 		return
 	}
@@ -203,7 +272,9 @@ func (bc *BlockCacheImpl) Link(bcn *BlockCacheNode) {
 	bc.leaf[bcn] = bcn.Number
 	bc.setHead(bcn)
 	if bcn.Number > bc.head.Number {
+		old := bc.branch(bc.head)
 		bc.head = bcn
+		bc.checkReorg(old)
 	}
 }
 
@@ -251,6 +322,9 @@ func (bc *BlockCacheImpl) updateLongest() {
 
 // Add is add a block
 func (bc *BlockCacheImpl) Add(blk *block.Block) *BlockCacheNode {
+	bc.mu.Lock()
+	defer bc.mu.Unlock()
+
 	newNode, nok := bc.hmget(blk.HeadHash())
 	if nok && newNode.Type != Virtual {
 		return newNode
@@ -267,11 +341,19 @@ func (bc *BlockCacheImpl) Add(blk *block.Block) *BlockCacheNode {
 		newNode = NewBCN(fa, blk)
 		bc.hmset(blk.HeadHash(), newNode)
 	}
+	if bc.store != nil {
+		if err := bc.store.put(blk); err != nil {
+			ilog.Errorf("Database error, BlockCache put err:%v", err)
+		}
+	}
 	return newNode
 }
 
 // AddGenesis is add genesis block
 func (bc *BlockCacheImpl) AddGenesis(blk *block.Block) {
+	bc.mu.Lock()
+	defer bc.mu.Unlock()
+
 	bc.linkedRoot = NewBCN(nil, blk)
 	bc.linkedRoot.Type = Linked
 
@@ -288,6 +370,9 @@ func (bc *BlockCacheImpl) delNode(bcn *BlockCacheNode) {
 	bcn.Parent = nil
 	if bcn.Block != nil {
 		bc.hmdel(bcn.Block.HeadHash())
+		if bc.store != nil {
+			bc.store.del(bcn.Block.HeadHash())
+		}
 	}
 	if fa != nil {
 		fa.delChild(bcn)
@@ -296,8 +381,13 @@ func (bc *BlockCacheImpl) delNode(bcn *BlockCacheNode) {
 
 // Del is delete a block
 func (bc *BlockCacheImpl) Del(bcn *BlockCacheNode) {
+	bc.mu.Lock()
+	defer bc.mu.Unlock()
+
+	old := bc.branch(bc.head)
 	bc.del(bcn)
 	bc.updateLongest()
+	bc.checkReorg(old)
 }
 
 func (bc *BlockCacheImpl) del(bcn *BlockCacheNode) {
@@ -357,7 +447,13 @@ func (bc *BlockCacheImpl) flush(retain *BlockCacheNode) error {
 			ilog.Errorf("Database error, Transaction Push err:%v", err)
 			return err
 		}
+		if bc.flushHandler != nil {
+			bc.flushHandler(retain.Block)
+		}
 		bc.delNode(cur)
+		if bc.store != nil {
+			bc.store.del(retain.Block.HeadHash())
+		}
 		retain.Parent = nil
 		retain.LibWitnessHandle()
 		bc.linkedRoot = retain
@@ -367,9 +463,19 @@ func (bc *BlockCacheImpl) flush(retain *BlockCacheNode) error {
 
 // Flush is save a block
 func (bc *BlockCacheImpl) Flush(bcn *BlockCacheNode) {
+	bc.mu.Lock()
+	defer bc.mu.Unlock()
+
+	old := bc.branch(bc.head)
 	bc.flush(bcn)
 	bc.delSingle()
 	bc.updateLongest()
+	bc.checkReorg(old)
+}
+
+// SetFlushHandler sets the function called after a block and its state are flushed to db
+func (bc *BlockCacheImpl) SetFlushHandler(f func(*block.Block)) {
+	bc.flushHandler = f
 }
 
 // Find is find the block
@@ -1,14 +1,24 @@
 package blockcache
 
 import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
 	"testing"
-	//	"fmt"
+	"time"
 
 	. "github.com/golang/mock/gomock"
 	"github.com/iost-official/go-iost/core/mocks"
 	"github.com/iost-official/go-iost/db/mocks"
 
+	"github.com/iost-official/go-iost/common"
 	"github.com/iost-official/go-iost/core/block"
+	"github.com/iost-official/go-iost/core/event"
+	"github.com/iost-official/go-iost/core/tx"
+	"github.com/iost-official/go-iost/crypto"
+	"github.com/iost-official/go-iost/db"
 	"github.com/iost-official/go-iost/vm/database"
 	. "github.com/smartystreets/goconvey/convey"
 )
@@ -19,6 +29,7 @@ func genBlock(fa *block.Block, wit string, num uint64) *block.Block {
 			Witness: wit,
 			Number:  int64(num),
 		},
+		Sign: &crypto.Signature{},
 	}
 	if fa == nil {
 		ret.Head.ParentHash = []byte("Im a single block")
@@ -77,6 +88,7 @@ func TestBlockCache(t *testing.T) {
 	global.EXPECT().BlockChain().AnyTimes().Return(base)
 	global.EXPECT().TxDB().AnyTimes().Return(txdb)
 	global.EXPECT().StateDB().AnyTimes().Return(statedb)
+	global.EXPECT().Config().AnyTimes().Return(&common.Config{})
 	Convey("Test of Block Cache", t, func() {
 		Convey("Add:", func() {
 			bc, _ := NewBlockCache(global)
@@ -154,6 +166,95 @@ func TestBlockCache(t *testing.T) {
 
 		})
 
+		Convey("Reorg", func() {
+			ec := event.GetEventCollectorInstance()
+			sub := event.NewSubscription(10, []event.Event_Topic{event.Event_ChainReorg})
+			ec.Subscribe(sub)
+			defer ec.Unsubscribe(sub)
+
+			bc, _ := NewBlockCache(global)
+			bc.Link(bc.Add(b1))
+			bc.Link(bc.Add(b2))
+			bc.Link(bc.Add(b3))
+			bc.Link(bc.Add(b2a))
+			bc.Link(bc.Add(b4))
+			So(bc.head.Block, ShouldEqual, b4)
+
+			select {
+			case e := <-sub.ReadChan():
+				var re ReorgEvent
+				So(json.Unmarshal([]byte(e.Data), &re), ShouldBeNil)
+				So(re.Depth, ShouldEqual, 2)
+				So(re.CommonAncestor, ShouldEqual, common.Base58Encode(b1.HeadHash()))
+				So(re.Reverted, ShouldResemble, []string{common.Base58Encode(b3.HeadHash()), common.Base58Encode(b2.HeadHash())})
+				So(re.Applied, ShouldResemble, []string{common.Base58Encode(b2a.HeadHash()), common.Base58Encode(b4.HeadHash())})
+			case <-time.After(time.Second):
+				t.Fatal("no reorg event")
+			}
+		})
+
+		Convey("Export", func() {
+			bc, _ := NewBlockCache(global)
+			bc.Link(bc.Add(b1))
+			bc.Link(bc.Add(b2a))
+			bc.Link(bc.Add(b2))
+			bc.Add(s1)
+
+			tree := bc.Export()
+			So(tree.LinkedRoot.Hash, ShouldEqual, common.Base58Encode(b0.HeadHash()))
+			So(len(tree.LinkedRoot.Children), ShouldEqual, 1)
+			n1 := tree.LinkedRoot.Children[0]
+			So(n1.Type, ShouldEqual, "Linked")
+			So(len(n1.Children), ShouldEqual, 2)
+			So(n1.Children[0].Hash, ShouldEqual, common.Base58Encode(b2.HeadHash()))
+			So(n1.Children[1].Hash, ShouldEqual, common.Base58Encode(b2a.HeadHash()))
+			So(tree.SingleRoot.Children[0].Type, ShouldEqual, "Virtual")
+			So(tree.SingleRoot.Children[0].Children[0].Type, ShouldEqual, "Single")
+
+			dot := tree.DOT()
+			So(strings.HasPrefix(dot, "digraph blockcache {"), ShouldBeTrue)
+			So(dot, ShouldContainSubstring, fmt.Sprintf("%q -> %q;", n1.Hash, n1.Children[1].Hash))
+			So(dot, ShouldContainSubstring, "style=dashed")
+		})
+
+		Convey("Recover", func() {
+			dir, err := ioutil.TempDir("", "blockcache")
+			So(err, ShouldBeNil)
+			defer os.RemoveAll(dir)
+			persistent := core_mock.NewMockBaseVariable(ctl)
+			persistent.EXPECT().BlockChain().AnyTimes().Return(base)
+			persistent.EXPECT().TxDB().AnyTimes().Return(txdb)
+			persistent.EXPECT().StateDB().AnyTimes().Return(statedb)
+			persistent.EXPECT().Config().AnyTimes().Return(&common.Config{DB: &common.DBConfig{LdbPath: dir + "/"}})
+
+			bc, err := NewBlockCache(persistent)
+			So(err, ShouldBeNil)
+			for _, b := range []*block.Block{b1, b2, b2a, b3, b3a, b5} {
+				bc.Link(bc.Add(b))
+			}
+			bc.Add(s1)
+			b4node := bc.Add(b4)
+			bc.Del(b4node)
+			bc.Close()
+
+			bc, err = NewBlockCache(persistent)
+			So(err, ShouldBeNil)
+			defer bc.Close()
+			So(bc.Recover(func(*block.Block, db.MVCCDB) error {
+				return nil
+			}), ShouldBeNil)
+			So(bc.head.Block.HeadHash(), ShouldResemble, b5.HeadHash())
+			node, err := bc.Find(b3.HeadHash())
+			So(err, ShouldBeNil)
+			So(node.Type, ShouldEqual, Linked)
+			So(node.Parent.Block.HeadHash(), ShouldResemble, b2.HeadHash())
+			_, err = bc.Find(b4.HeadHash())
+			So(err, ShouldNotBeNil)
+			node, err = bc.Find(s1.HeadHash())
+			So(err, ShouldBeNil)
+			So(node.Type, ShouldEqual, Single)
+		})
+
 	})
 }
 
@@ -202,6 +303,7 @@ func TestVote(t *testing.T) {
 	global.EXPECT().BlockChain().AnyTimes().Return(base)
 	global.EXPECT().TxDB().AnyTimes().Return(txdb)
 	global.EXPECT().StateDB().AnyTimes().Return(statedb)
+	global.EXPECT().Config().AnyTimes().Return(&common.Config{})
 
 	Convey("test api", t, func() {
 		var wl WitnessList
@@ -248,3 +350,84 @@ func StringSliceEqual(a, b []string) bool {
 	}
 	return true
 }
+
+func TestExportChain(t *testing.T) {
+	ctl := NewController(t)
+	defer ctl.Finish()
+	b10 := genBlock(nil, "IOSTwitness", 10)
+	b11 := genBlock(b10, "IOSTwitness", 11)
+	chain := core_mock.NewMockChain(ctl)
+	chain.EXPECT().Start().Return(int64(10)).AnyTimes()
+	chain.EXPECT().GetBlockByNumber(int64(10)).Return(b10, nil)
+	chain.EXPECT().GetBlockByNumber(int64(11)).Return(b11, nil)
+	chain.EXPECT().GetBlockByNumber(int64(12)).Return(nil, fmt.Errorf("fail to get hash by number"))
+
+	// the blocks pruned before the checkpoint aren't read
+	root := ExportChain(chain, 0, 12)
+	if root == nil || root.Number != 10 || len(root.Children) != 1 || root.Children[0].Number != 11 {
+		t.Fatalf("exported chain: %+v", root)
+	}
+}
+
+func TestBlockCache_ContractEvents(t *testing.T) {
+	ctl := NewController(t)
+	defer ctl.Finish()
+	b0 := &block.Block{Head: &block.BlockHead{ParentHash: []byte("nothing"), Witness: "w0"}}
+	withEvent := func(blk *block.Block) *block.Block {
+		e, _ := json.Marshal(&tx.Event{Contract: "Contractabc", Name: "mint", Data: blk.Head.Witness})
+		blk.Receipts = []*tx.TxReceipt{{TxHash: []byte("tx"), Receipts: []tx.Receipt{{Type: tx.EventDefined, Content: string(e)}}}}
+		return blk
+	}
+	b1 := withEvent(genBlock(b0, "w1", 1))
+	b2 := withEvent(genBlock(b1, "w2", 2))
+	b3 := withEvent(genBlock(b2, "w3", 3))
+	b2a := withEvent(genBlock(b1, "w2a", 2))
+	b3a := withEvent(genBlock(b2a, "w3a", 3))
+	b4a := withEvent(genBlock(b3a, "w4a", 4))
+
+	statedb := db_mock.NewMockMVCCDB(ctl)
+	statedb.EXPECT().Fork().AnyTimes().Return(statedb)
+	statedb.EXPECT().Checkout(Any()).AnyTimes().Return(true)
+	statedb.EXPECT().Get("state", "b-iost.vote-"+"pendingBlockNumber").AnyTimes().Return(database.MustMarshal("1"), nil)
+	statedb.EXPECT().Get("state", "b-iost.vote-"+"pendingProducerList").AnyTimes().Return(database.MustMarshal(`["w1"]`), nil)
+	statedb.EXPECT().Get("state", Any()).AnyTimes().Return(database.MustMarshal(`{"online":true}`), nil)
+	chain := core_mock.NewMockChain(ctl)
+	chain.EXPECT().Top().AnyTimes().Return(b0, nil)
+	base := core_mock.NewMockBaseVariable(ctl)
+	base.EXPECT().BlockChain().AnyTimes().Return(chain)
+	base.EXPECT().StateDB().AnyTimes().Return(statedb)
+	base.EXPECT().Config().AnyTimes().Return(&common.Config{})
+
+	ec := event.GetEventCollectorInstance()
+	sub := event.NewSubscription(10, []event.Event_Topic{event.Event_ContractEvent})
+	ec.Subscribe(sub)
+	defer ec.Unsubscribe(sub)
+
+	bc, err := NewBlockCache(base)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the events of the fork blocks are posted when their branch becomes the head branch
+	for _, blk := range []*block.Block{b1, b2, b2a, b3, b3a, b4a} {
+		bc.Link(bc.Add(blk))
+	}
+	for _, witness := range []string{"w1", "w2", "w3", "w2a", "w3a", "w4a"} {
+		select {
+		case ev := <-sub.ReadChan():
+			var e tx.Event
+			if err := json.Unmarshal([]byte(ev.Data), &e); err != nil {
+				t.Fatal(err)
+			}
+			if e.Data != witness {
+				t.Fatalf("event of block %v, expect %v", e.Data, witness)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("no event of block %v", witness)
+		}
+	}
+	select {
+	case ev := <-sub.ReadChan():
+		t.Fatalf("unexpected event %v", ev.Data)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
@@ -0,0 +1,61 @@
+package blockcache
+
+import (
+	"fmt"
+
+	"github.com/iost-official/go-iost/core/block"
+	"github.com/iost-official/go-iost/db/kv"
+)
+
+var blockStorePrefix = []byte("b")
+
+// blockStore keeps the blocks of the block cache on disk so that the fork tree
+// survives a restart. Blocks are removed once they are flushed or deleted.
+type blockStore struct {
+	db *kv.Storage
+}
+
+func newBlockStore(path string) (*blockStore, error) {
+	levelDB, err := kv.NewStorage(path, kv.LevelDBStorage)
+	if err != nil {
+		return nil, fmt.Errorf("fail to init blockcachedb, %v", err)
+	}
+	return &blockStore{db: levelDB}, nil
+}
+
+func (s *blockStore) put(blk *block.Block) error {
+	b, err := blk.Encode()
+	if err != nil {
+		return err
+	}
+	return s.db.Put(append(blockStorePrefix, blk.HeadHash()...), b)
+}
+
+func (s *blockStore) del(hash []byte) error {
+	return s.db.Delete(append(blockStorePrefix, hash...))
+}
+
+// blocks returns all the blocks in the store, in no particular order.
+func (s *blockStore) blocks() ([]*block.Block, error) {
+	keys, err := s.db.Keys(blockStorePrefix)
+	if err != nil {
+		return nil, err
+	}
+	blks := make([]*block.Block, 0, len(keys))
+	for _, k := range keys {
+		b, err := s.db.Get(k)
+		if err != nil {
+			return nil, err
+		}
+		var blk block.Block
+		if err := blk.Decode(b); err != nil {
+			return nil, err
+		}
+		blks = append(blks, &blk)
+	}
+	return blks, nil
+}
+
+func (s *blockStore) close() error {
+	return s.db.Close()
+}
@@ -0,0 +1,154 @@
+package blockcache
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"github.com/iost-official/go-iost/common"
+	"github.com/iost-official/go-iost/core/block"
+)
+
+// String returns the name of the node type.
+func (t BCNType) String() string {
+	switch t {
+	case Linked:
+		return "Linked"
+	case Single:
+		return "Single"
+	case Virtual:
+		return "Virtual"
+	default:
+		return ""
+	}
+}
+
+// NodeInfo is the exported form of a block cache node and its subtree.
+type NodeInfo struct {
+	Number       int64       `json:"number"`
+	Hash         string      `json:"hash"`
+	ParentHash   string      `json:"parent_hash"`
+	Witness      string      `json:"witness"`
+	Type         string      `json:"type"`
+	ConfirmUntil int64       `json:"confirm_until"`
+	Children     []*NodeInfo `json:"children"`
+}
+
+// TreeInfo is the exported form of the block cache, which contains the linked tree and the single tree.
+type TreeInfo struct {
+	LinkedRoot *NodeInfo `json:"linked_root"`
+	SingleRoot *NodeInfo `json:"single_root"`
+}
+
+// Export returns the subtree of the node. Children are sorted by number and hash,
+// so that the same tree always gives the same output.
+func (bcn *BlockCacheNode) Export() *NodeInfo {
+	info := &NodeInfo{
+		Number:       bcn.Number,
+		Witness:      bcn.Witness,
+		Type:         bcn.Type.String(),
+		ConfirmUntil: bcn.ConfirmUntil,
+		Children:     make([]*NodeInfo, 0, len(bcn.Children)),
+	}
+	if bcn.Block != nil {
+		info.Hash = common.Base58Encode(bcn.Block.HeadHash())
+		info.ParentHash = common.Base58Encode(bcn.Block.Head.ParentHash)
+	}
+	for child := range bcn.Children {
+		info.Children = append(info.Children, child.Export())
+	}
+	sort.Slice(info.Children, func(i, j int) bool {
+		if info.Children[i].Number != info.Children[j].Number {
+			return info.Children[i].Number < info.Children[j].Number
+		}
+		return info.Children[i].Hash < info.Children[j].Hash
+	})
+	return info
+}
+
+// Export returns the linked tree and the single tree of the block cache.
+func (bc *BlockCacheImpl) Export() *TreeInfo {
+	bc.mu.RLock()
+	defer bc.mu.RUnlock()
+
+	return &TreeInfo{
+		LinkedRoot: bc.linkedRoot.Export(),
+		SingleRoot: bc.singleRoot.Export(),
+	}
+}
+
+// ExportChain returns the blocks of the chain in [start, end] as a tree without forks. The blocks before the
+// start of a chain starting from a checkpoint are pruned, so the tree begins at the start of the chain at least.
+func ExportChain(chain block.Chain, start int64, end int64) *NodeInfo {
+	if start < chain.Start() {
+		start = chain.Start()
+	}
+	var root, cur *NodeInfo
+	for i := start; i <= end; i++ {
+		blk, err := chain.GetBlockByNumber(i)
+		if err != nil {
+			continue
+		}
+		info := &NodeInfo{
+			Number:     blk.Head.Number,
+			Hash:       common.Base58Encode(blk.HeadHash()),
+			ParentHash: common.Base58Encode(blk.Head.ParentHash),
+			Witness:    blk.Head.Witness,
+			Type:       Linked.String(),
+			Children:   make([]*NodeInfo, 0, 1),
+		}
+		if root == nil {
+			root = info
+		} else {
+			cur.Children = append(cur.Children, info)
+		}
+		cur = info
+	}
+	return root
+}
+
+// DOT returns the Graphviz DOT format of the tree.
+func (t *TreeInfo) DOT() string {
+	var buf bytes.Buffer
+	buf.WriteString("digraph blockcache {\n\tnode [shape=box];\n")
+	writeDOT(&buf, t.LinkedRoot, "l")
+	writeDOT(&buf, t.SingleRoot, "s")
+	buf.WriteString("}\n")
+	return buf.String()
+}
+
+// DOT returns the Graphviz DOT format of the subtree.
+func (n *NodeInfo) DOT() string {
+	var buf bytes.Buffer
+	buf.WriteString("digraph blockchain {\n\tnode [shape=box];\n")
+	writeDOT(&buf, n, "n")
+	buf.WriteString("}\n")
+	return buf.String()
+}
+
+// writeDOT writes the subtree to buf and returns the name of n in the graph.
+// Nodes are named by their hashes, and by id if they have no block.
+func writeDOT(buf *bytes.Buffer, n *NodeInfo, id string) string {
+	if n == nil {
+		return ""
+	}
+	name := n.Hash
+	if name == "" {
+		name = id
+	}
+	var style string
+	switch n.Type {
+	case Single.String():
+		style = "dashed"
+	case Virtual.String():
+		style = "dotted"
+	default:
+		style = "solid"
+	}
+	fmt.Fprintf(buf, "\t%q [label=%q, style=%v];\n", name, fmt.Sprintf("%v\n%v\n%v\nconfirm until %v", n.Number, n.Hash, n.Witness, n.ConfirmUntil), style)
+	for i, child := range n.Children {
+		childName := writeDOT(buf, child, fmt.Sprintf("%v.%v", id, i))
+		fmt.Fprintf(buf, "\t%q -> %q;\n", name, childName)
+	}
+	return name
+}
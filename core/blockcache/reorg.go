@@ -0,0 +1,116 @@
+package blockcache
+
+import (
+	"encoding/json"
+
+	"github.com/iost-official/go-iost/common"
+	"github.com/iost-official/go-iost/core/block"
+	"github.com/iost-official/go-iost/core/event"
+	"github.com/iost-official/go-iost/ilog"
+	"github.com/iost-official/go-iost/metrics"
+)
+
+var (
+	metricsReorgCount = metrics.NewCounter("iost_blockcache_reorg_count", nil)
+	metricsReorgDepth = metrics.NewSummary("iost_blockcache_reorg_depth", nil)
+)
+
+// ReorgEvent is the data of the ChainReorg event, which is posted when the head switches to another branch.
+// Reverted lists the blocks of the old branch from the old head down to the common ancestor,
+// Applied lists the blocks of the new branch from the common ancestor up to the new head.
+type ReorgEvent struct {
+	OldHead        string   `json:"old_head"`
+	NewHead        string   `json:"new_head"`
+	CommonAncestor string   `json:"common_ancestor"`
+	Depth          int      `json:"depth"`
+	Reverted       []string `json:"reverted"`
+	Applied        []string `json:"applied"`
+}
+
+// postContractEvents posts the events emitted by the contracts in the block applied to the head branch,
+// the subscribers should revert the events of the blocks reverted by the ChainReorg event.
+func postContractEvents(blk *block.Block) {
+	for _, e := range blk.Events() {
+		data, err := json.Marshal(e)
+		if err != nil {
+			ilog.Errorf("marshal contract event failed. err=%v", err)
+			continue
+		}
+		event.GetEventCollectorInstance().Post(event.NewEvent(event.Event_ContractEvent, string(data)))
+	}
+}
+
+// branch returns the nodes from h back to the linked root.
+func (bc *BlockCacheImpl) branch(h *BlockCacheNode) []*BlockCacheNode {
+	nodes := make([]*BlockCacheNode, 0)
+	for h != nil && h.Block != nil {
+		nodes = append(nodes, h)
+		if h == bc.linkedRoot {
+			break
+		}
+		h = h.Parent
+	}
+	return nodes
+}
+
+// checkReorg compares the branch of the old head with the one of the current head, and posts a ChainReorg
+// event if the old head is not an ancestor of the current head. Then it posts the contract events of the
+// blocks applied to the head branch, so the events of the blocks linked to the forks aren't posted.
+func (bc *BlockCacheImpl) checkReorg(old []*BlockCacheNode) {
+	if bc.recovering || (len(old) > 0 && old[0] == bc.head) {
+		return
+	}
+	cur := bc.branch(bc.head)
+	onCur := make(map[*BlockCacheNode]int, len(cur))
+	for i, n := range cur {
+		onCur[n] = i
+	}
+	var ancestor *BlockCacheNode
+	reverted := make([]string, 0)
+	for _, n := range old {
+		if _, ok := onCur[n]; ok {
+			ancestor = n
+			break
+		}
+		reverted = append(reverted, common.Base58Encode(n.Block.HeadHash()))
+	}
+	end := len(cur)
+	if ancestor != nil {
+		end = onCur[ancestor]
+	}
+	if len(reverted) > 0 {
+		bc.postReorg(reverted, ancestor, cur[:end])
+	}
+	for i := end - 1; i >= 0; i-- {
+		postContractEvents(cur[i].Block)
+	}
+}
+
+// postReorg posts the ChainReorg event, the applied nodes are from the current head down to the common ancestor.
+func (bc *BlockCacheImpl) postReorg(reverted []string, ancestor *BlockCacheNode, nodes []*BlockCacheNode) {
+	applied := make([]string, 0, len(nodes))
+	for i := len(nodes) - 1; i >= 0; i-- {
+		applied = append(applied, common.Base58Encode(nodes[i].Block.HeadHash()))
+	}
+
+	e := &ReorgEvent{
+		OldHead:  reverted[0],
+		NewHead:  common.Base58Encode(bc.head.Block.HeadHash()),
+		Depth:    len(reverted),
+		Reverted: reverted,
+		Applied:  applied,
+	}
+	if ancestor != nil {
+		e.CommonAncestor = common.Base58Encode(ancestor.Block.HeadHash())
+	}
+	ilog.Infof("chain reorg, depth: %v, old head: %v, new head: %v", e.Depth, e.OldHead, e.NewHead)
+	metricsReorgCount.Add(1, nil)
+	metricsReorgDepth.Observe(float64(e.Depth), nil)
+
+	data, err := json.Marshal(e)
+	if err != nil {
+		ilog.Errorf("marshal reorg event failed. err=%v", err)
+		return
+	}
+	event.GetEventCollectorInstance().Post(event.NewEvent(event.Event_ChainReorg, string(data)))
+}
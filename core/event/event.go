@@ -105,6 +105,20 @@ func (ec *EventCollector) Unsubscribe(sub *Subscription) {
 	}
 }
 
+// HasSubscriber returns whether any subscription listens to the topic
+func (ec *EventCollector) HasSubscriber(topic Event_Topic) bool {
+	v, ok := ec.subMap.Load(topic)
+	if !ok || v == nil {
+		return false
+	}
+	has := false
+	v.(*sync.Map).Range(func(key, value interface{}) bool {
+		has = true
+		return false
+	})
+	return has
+}
+
 func (ec *EventCollector) deliverLoop() {
 	for {
 		select {
@@ -27,6 +27,12 @@ const (
 	Event_ContractEvent       Event_Topic = 1
 	Event_ContractUserEvent   Event_Topic = 2
 	Event_ContractSystemEvent Event_Topic = 3
+	Event_TxPoolAccepted      Event_Topic = 4
+	Event_TxPoolRejected      Event_Topic = 5
+	Event_TxPoolPacked        Event_Topic = 6
+	Event_TxPoolEvicted       Event_Topic = 7
+	Event_TxPoolExpired       Event_Topic = 8
+	Event_ChainReorg          Event_Topic = 9
 )
 
 var Event_Topic_name = map[int32]string{
@@ -34,19 +40,31 @@ var Event_Topic_name = map[int32]string{
 	1: "ContractEvent",
 	2: "ContractUserEvent",
 	3: "ContractSystemEvent",
+	4: "TxPoolAccepted",
+	5: "TxPoolRejected",
+	6: "TxPoolPacked",
+	7: "TxPoolEvicted",
+	8: "TxPoolExpired",
+	9: "ChainReorg",
 }
 var Event_Topic_value = map[string]int32{
 	"TransactionResult":   0,
 	"ContractEvent":       1,
 	"ContractUserEvent":   2,
 	"ContractSystemEvent": 3,
+	"TxPoolAccepted":      4,
+	"TxPoolRejected":      5,
+	"TxPoolPacked":        6,
+	"TxPoolEvicted":       7,
+	"TxPoolExpired":       8,
+	"ChainReorg":          9,
 }
 
 func (x Event_Topic) String() string {
 	return proto.EnumName(Event_Topic_name, int32(x))
 }
 func (Event_Topic) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_event_21e993a54336b087, []int{0, 0}
+	return fileDescriptor_event_66060a4bb99c11ca, []int{0, 0}
 }
 
 type Event struct {
@@ -62,7 +80,7 @@ func (m *Event) Reset()         { *m = Event{} }
 func (m *Event) String() string { return proto.CompactTextString(m) }
 func (*Event) ProtoMessage()    {}
 func (*Event) Descriptor() ([]byte, []int) {
-	return fileDescriptor_event_21e993a54336b087, []int{0}
+	return fileDescriptor_event_66060a4bb99c11ca, []int{0}
 }
 func (m *Event) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -420,22 +438,26 @@ var (
 	ErrIntOverflowEvent   = fmt.Errorf("proto: integer overflow")
 )
 
-func init() { proto.RegisterFile("core/event/event.proto", fileDescriptor_event_21e993a54336b087) }
+func init() { proto.RegisterFile("core/event/event.proto", fileDescriptor_event_66060a4bb99c11ca) }
 
-var fileDescriptor_event_21e993a54336b087 = []byte{
-	// 210 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x4b, 0xce, 0x2f, 0x4a,
-	0xd5, 0x4f, 0x2d, 0x4b, 0xcd, 0x2b, 0x81, 0x90, 0x7a, 0x05, 0x45, 0xf9, 0x25, 0xf9, 0x42, 0xac,
-	0x60, 0x8e, 0xd2, 0x1e, 0x46, 0x2e, 0x56, 0x57, 0x10, 0x4b, 0x48, 0x83, 0x8b, 0xb5, 0x24, 0xbf,
-	0x20, 0x33, 0x59, 0x82, 0x51, 0x81, 0x51, 0x83, 0xcf, 0x48, 0x48, 0x0f, 0xa2, 0x1a, 0x2c, 0xa9,
-	0x17, 0x02, 0x92, 0x09, 0x82, 0x28, 0x10, 0x12, 0xe2, 0x62, 0x49, 0x49, 0x2c, 0x49, 0x94, 0x60,
-	0x52, 0x60, 0xd4, 0xe0, 0x0c, 0x02, 0xb3, 0x41, 0x62, 0x25, 0x99, 0xb9, 0xa9, 0x12, 0xcc, 0x0a,
-	0x8c, 0x1a, 0xcc, 0x41, 0x60, 0xb6, 0x52, 0x22, 0x17, 0x2b, 0x58, 0x9f, 0x90, 0x28, 0x97, 0x60,
-	0x48, 0x51, 0x62, 0x5e, 0x71, 0x62, 0x72, 0x49, 0x66, 0x7e, 0x5e, 0x50, 0x6a, 0x71, 0x69, 0x4e,
-	0x89, 0x00, 0x83, 0x90, 0x20, 0x17, 0xaf, 0x73, 0x7e, 0x5e, 0x49, 0x51, 0x62, 0x72, 0x09, 0xd8,
-	0x16, 0x01, 0x46, 0x90, 0x4a, 0x98, 0x50, 0x68, 0x71, 0x6a, 0x11, 0x44, 0x98, 0x49, 0x48, 0x9c,
-	0x4b, 0x18, 0x26, 0x1c, 0x5c, 0x59, 0x5c, 0x92, 0x9a, 0x0b, 0x91, 0x60, 0x76, 0x12, 0x38, 0xf1,
-	0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x67, 0x3c, 0x96, 0x63, 0x48,
-	0x62, 0x03, 0x7b, 0xcf, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0x85, 0xa4, 0x18, 0xbf, 0xf8, 0x00,
+var fileDescriptor_event_66060a4bb99c11ca = []byte{
+	// 274 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0xd0, 0x41, 0x4a, 0x03, 0x31,
+	0x14, 0x06, 0xe0, 0xa6, 0xed, 0x54, 0xfb, 0xd0, 0x92, 0x3e, 0x51, 0xbb, 0x1a, 0x86, 0xae, 0x66,
+	0x35, 0x82, 0x9e, 0x40, 0x4b, 0xf7, 0x25, 0x8e, 0x07, 0x88, 0x99, 0x87, 0x46, 0xdb, 0xc9, 0x90,
+	0x89, 0xa5, 0xde, 0xc4, 0x4b, 0x78, 0x0f, 0x97, 0x7a, 0x03, 0x19, 0x2f, 0x22, 0x49, 0x28, 0xba,
+	0x09, 0x2f, 0xdf, 0xff, 0x87, 0x07, 0x81, 0x33, 0x65, 0x2c, 0x5d, 0xd0, 0x96, 0x6a, 0x17, 0xcf,
+	0xa2, 0xb1, 0xc6, 0x19, 0x4c, 0xc2, 0x65, 0xfe, 0xde, 0x87, 0x64, 0xe9, 0x27, 0xcc, 0x21, 0x71,
+	0xa6, 0xd1, 0x6a, 0xc6, 0x32, 0x96, 0x4f, 0x2e, 0xb1, 0x88, 0xed, 0x10, 0x16, 0xa5, 0x4f, 0x44,
+	0x2c, 0x20, 0xc2, 0xb0, 0x92, 0x4e, 0xce, 0xfa, 0x19, 0xcb, 0xc7, 0x22, 0xcc, 0xde, 0x9c, 0xde,
+	0xd0, 0x6c, 0x90, 0xb1, 0x7c, 0x20, 0xc2, 0x3c, 0xff, 0x62, 0x90, 0x84, 0x87, 0x78, 0x0a, 0xd3,
+	0xd2, 0xca, 0xba, 0x95, 0xca, 0x69, 0x53, 0x0b, 0x6a, 0x5f, 0xd6, 0x8e, 0xf7, 0x70, 0x0a, 0xc7,
+	0x0b, 0x53, 0x3b, 0x2b, 0x95, 0x0b, 0x6b, 0x38, 0xf3, 0xcd, 0x3d, 0xdd, 0xb5, 0x64, 0x23, 0xf7,
+	0xf1, 0x1c, 0x4e, 0xf6, 0x7c, 0xfb, 0xda, 0x3a, 0xda, 0xc4, 0x60, 0x80, 0x08, 0x93, 0x72, 0xb7,
+	0x32, 0x66, 0x7d, 0xad, 0x14, 0x35, 0x8e, 0x2a, 0x3e, 0xfc, 0x33, 0x41, 0x4f, 0xa4, 0xbc, 0x25,
+	0xc8, 0xe1, 0x28, 0xda, 0x4a, 0xaa, 0x67, 0xaa, 0xf8, 0xc8, 0x2f, 0x8f, 0xb2, 0xdc, 0xea, 0x50,
+	0x3a, 0xf8, 0x47, 0xbb, 0x46, 0x5b, 0xaa, 0xf8, 0x21, 0x4e, 0x00, 0x16, 0x8f, 0x52, 0xd7, 0x82,
+	0x8c, 0x7d, 0xe0, 0xe3, 0x1b, 0xfe, 0xd1, 0xa5, 0xec, 0xb3, 0x4b, 0xd9, 0x77, 0x97, 0xb2, 0xb7,
+	0x9f, 0xb4, 0x77, 0x3f, 0x0a, 0xff, 0x79, 0xf5, 0x3b, 0x00, 0x4f, 0x3c, 0x83, 0x47, 0x69, 0x01,
 	0x00, 0x00,
 }
@@ -175,3 +175,19 @@ func TestEventCollector_Full(t *testing.T) {
 		t.Fatalf("expect count1 <= 1001, count2 = 1, count3 <= 1001, got %d %d %d", count1, count2, count3)
 	}
 }
+
+func TestEventCollector_HasSubscriber(t *testing.T) {
+	ec := GetEventCollectorInstance()
+	if ec.HasSubscriber(Event_TxPoolExpired) {
+		t.Fatal("expect no subscriber of Event_TxPoolExpired")
+	}
+	sub := NewSubscription(1, []Event_Topic{Event_TxPoolExpired})
+	ec.Subscribe(sub)
+	if !ec.HasSubscriber(Event_TxPoolExpired) {
+		t.Fatal("expect a subscriber of Event_TxPoolExpired")
+	}
+	ec.Unsubscribe(sub)
+	if ec.HasSubscriber(Event_TxPoolExpired) {
+		t.Fatal("expect no subscriber of Event_TxPoolExpired after unsubscribe")
+	}
+}
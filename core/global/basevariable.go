@@ -1,6 +1,7 @@
 package global
 
 import (
+	"bytes"
 	"fmt"
 
 	"os"
@@ -13,7 +14,9 @@ import (
 	"github.com/iost-official/go-iost/core/tx"
 	"github.com/iost-official/go-iost/crypto"
 	"github.com/iost-official/go-iost/db"
+	"github.com/iost-official/go-iost/ilog"
 	"github.com/iost-official/go-iost/vm"
+	"github.com/iost-official/go-iost/vm/database"
 	"github.com/iost-official/go-iost/vm/native"
 	"time"
 )
@@ -56,14 +59,28 @@ type BaseVariableImpl struct {
 	blockChain  block.Chain
 	stateDB     db.MVCCDB
 	txDB        TxDB
+	evidenceDB  EvidenceDB
 	mode        TMode
 	witnessList []string
 	config      *common.Config
 }
 
-// GenGenesis is create a genesis block
-func GenGenesis(db db.MVCCDB, witnessInfo []string) (*block.Block, error) {
+// GenGenesis is create a genesis block, the consensus parameters are saved in the genesis block if params is not nil
+func GenGenesis(db db.MVCCDB, witnessInfo []string, params *common.GenesisConsensusConfig) (*block.Block, error) {
 	var acts []*tx.Action
+	if params != nil {
+		if err := params.Validate(); err != nil {
+			return nil, err
+		}
+		if err := params.ValidateWitnesses(int64(len(witnessInfo) / 2)); err != nil {
+			return nil, err
+		}
+		act := tx.NewAction("iost.system", "InitConsensus", fmt.Sprintf(`[%v, %v, %v, %v, %v, %v, %v, %v, %v, %v, %v]`,
+			params.SlotLength, params.ConfirmNumber, params.ConfirmNumerator, params.ConfirmDenominator,
+			params.MaxBlockGas, params.MaxBlockSize, params.ProducerNumber, params.Forks.ParallelHeight,
+			params.Forks.MeteringHeight, params.Forks.BeaconHeight, params.Forks.LimitHeight))
+		acts = append(acts, &act)
+	}
 	for i := 0; i < len(witnessInfo)/2; i++ {
 		act := tx.NewAction("iost.system", "IssueIOST", fmt.Sprintf(`["%v", %v]`, witnessInfo[2*i], witnessInfo[2*i+1]))
 		acts = append(acts, &act)
@@ -95,6 +112,10 @@ func GenGenesis(db db.MVCCDB, witnessInfo []string) (*block.Block, error) {
 		act1 := tx.NewAction("iost.vote", "InitProducer", fmt.Sprintf(`["%v"]`, witnessInfo[2*i]))
 		acts = append(acts, &act1)
 	}
+	if params != nil && params.ProducerNumber > 0 {
+		act := tx.NewAction("iost.vote", "InitProducerNumber", fmt.Sprintf(`[%v]`, params.ProducerNumber))
+		acts = append(acts, &act)
+	}
 	act11 := tx.NewAction("iost.vote", "InitAdmin", fmt.Sprintf(`["%v"]`, adminID))
 	acts = append(acts, &act11)
 
@@ -140,6 +161,18 @@ func GenGenesis(db db.MVCCDB, witnessInfo []string) (*block.Block, error) {
 	return &blk, nil
 }
 
+// newStateDB opens the state db which keeps the states of the latest confirmed blocks for tracing their txs
+func newStateDB(conf *common.DBConfig) (db.MVCCDB, error) {
+	stateDB, err := db.NewMVCCDB(conf.LdbPath + "StateDB")
+	if err != nil {
+		return nil, err
+	}
+	if h, ok := stateDB.(db.HistoryDB); ok {
+		h.KeepHistory(conf.HistoryLength)
+	}
+	return stateDB, nil
+}
+
 // New return a BaseVariable instance
 func New(conf *common.Config) (*BaseVariableImpl, error) {
 	var blockChain block.Chain
@@ -157,9 +190,21 @@ func New(conf *common.Config) (*BaseVariableImpl, error) {
 	if err != nil {
 		return nil, fmt.Errorf("new blockchain failed, stop the program. err: %v", err)
 	}
+	for _, number := range common.Checkpoints() {
+		hash, err := blockChain.GetHashByNumber(number)
+		if err != nil {
+			continue
+		}
+		if cp, _ := common.CheckpointHash(number); !bytes.Equal(hash, cp) {
+			return nil, fmt.Errorf("blockchaindb conflicts with the checkpoint at %v, stop the program", number)
+		}
+	}
+	if conf.Genesis.CreateGenesis && conf.Snapshot != nil && conf.Snapshot.TrustedHash != "" {
+		return nil, fmt.Errorf("can't create genesis block when starting from the snapshot at the trusted block")
+	}
 	blk, err := blockChain.GetBlockByNumber(0)
 	if err != nil { //blockchaindb is empty
-		stateDB, err = db.NewMVCCDB(conf.DB.LdbPath + "StateDB")
+		stateDB, err = newStateDB(conf.DB)
 		if err != nil {
 			return nil, fmt.Errorf("new statedb failed, stop the program. err: %v", err)
 		}
@@ -172,7 +217,7 @@ func New(conf *common.Config) (*BaseVariableImpl, error) {
 			return nil, fmt.Errorf("new txDB failed, stop the program. err: %v", err)
 		}
 		if conf.Genesis.CreateGenesis {
-			blk, err = GenGenesis(stateDB, conf.Genesis.WitnessInfo)
+			blk, err = GenGenesis(stateDB, conf.Genesis.WitnessInfo, conf.Genesis.Consensus)
 			if err != nil {
 				return nil, fmt.Errorf("new GenGenesis failed, stop the program. err: %v", err)
 			}
@@ -188,10 +233,23 @@ func New(conf *common.Config) (*BaseVariableImpl, error) {
 			if err != nil {
 				return nil, fmt.Errorf("push txDB failed, stop the pogram. err: %v", err)
 			}
+			err = LoadConsensusParams(stateDB, conf.Genesis.Consensus)
+			if err != nil {
+				return nil, fmt.Errorf("load consensus params failed, stop the pogram. err: %v", err)
+			}
+		} else {
+			err = useConfigConsensusParams(conf.Genesis.Consensus)
+			if err != nil {
+				return nil, fmt.Errorf("invalid consensus params in config, stop the pogram. err: %v", err)
+			}
+		}
+		evidenceDB, err := NewEvidenceDB(conf.DB.LdbPath + "EvidenceDB")
+		if err != nil {
+			return nil, fmt.Errorf("new evidenceDB failed, stop the program. err: %v", err)
 		}
-		return &BaseVariableImpl{blockChain: blockChain, stateDB: stateDB, txDB: txDB, mode: ModeInit, witnessList: witnessList, config: conf}, nil
+		return &BaseVariableImpl{blockChain: blockChain, stateDB: stateDB, txDB: txDB, evidenceDB: evidenceDB, mode: ModeInit, witnessList: witnessList, config: conf}, nil
 	}
-	stateDB, err = db.NewMVCCDB(conf.DB.LdbPath + "StateDB")
+	stateDB, err = newStateDB(conf.DB)
 	if err != nil {
 		return nil, fmt.Errorf("new statedb failed, stop the program. err: %v", err)
 	}
@@ -219,11 +277,74 @@ func New(conf *common.Config) (*BaseVariableImpl, error) {
 			return nil, fmt.Errorf("flush stateDB failed, stop the pogram. err: %v", err)
 		}
 	}
+	err = LoadConsensusParams(stateDB, conf.Genesis.Consensus)
+	if err != nil {
+		return nil, fmt.Errorf("load consensus params failed, stop the pogram. err: %v", err)
+	}
 	txDB, err = NewTxDB(conf.DB.LdbPath + "TXDB")
 	if err != nil {
 		return nil, fmt.Errorf("new txDB failed, stop the program. err: %v", err)
 	}
-	return &BaseVariableImpl{blockChain: blockChain, stateDB: stateDB, txDB: txDB, mode: ModeInit, witnessList: witnessList, config: conf}, nil
+	evidenceDB, err := NewEvidenceDB(conf.DB.LdbPath + "EvidenceDB")
+	if err != nil {
+		return nil, fmt.Errorf("new evidenceDB failed, stop the program. err: %v", err)
+	}
+	return &BaseVariableImpl{blockChain: blockChain, stateDB: stateDB, txDB: txDB, evidenceDB: evidenceDB, mode: ModeInit, witnessList: witnessList, config: conf}, nil
+}
+
+func genesisConsensusParams(stateDB db.MVCCDB) (*common.GenesisConsensusConfig, error) {
+	params, err := native.LoadConsensusParams(database.NewVisitor(0, stateDB))
+	if err != nil {
+		return nil, err
+	}
+	if params == nil {
+		params = common.DefaultGenesisConsensusConfig()
+	}
+	return params, nil
+}
+
+// LoadConsensusParams reads the consensus parameters from the genesis block state and applies them,
+// the default parameters are used if the genesis block has none. The parameters in config are only
+// used to create the genesis block, it warns if they are different from the ones of the chain.
+// It's called at startup, before the goroutines reading the parameters start.
+func LoadConsensusParams(stateDB db.MVCCDB, conf *common.GenesisConsensusConfig) error {
+	params, err := genesisConsensusParams(stateDB)
+	if err != nil {
+		return err
+	}
+	if conf != nil && *conf != *params {
+		ilog.Warnf("consensus params in config %+v are different from the genesis block %+v, use the latter", *conf, *params)
+	}
+	common.SetConsensusParams(params)
+	return nil
+}
+
+// useConfigConsensusParams applies the consensus parameters in config, or the default ones, to a node
+// without the genesis block. The genesis block got from the peers later must have the same ones.
+func useConfigConsensusParams(conf *common.GenesisConsensusConfig) error {
+	params := common.DefaultGenesisConsensusConfig()
+	if conf != nil {
+		c := *conf
+		params = &c
+	}
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	common.SetConsensusParams(params)
+	return nil
+}
+
+// CheckConsensusParams checks that the genesis block state has the consensus parameters in use, they
+// are loaded once at startup and never changed by the genesis block got from the peers.
+func CheckConsensusParams(stateDB db.MVCCDB) error {
+	params, err := genesisConsensusParams(stateDB)
+	if err != nil {
+		return err
+	}
+	if *params != *common.ConsensusParams {
+		return fmt.Errorf("consensus params of the genesis block %+v are different from the ones in use %+v", *params, *common.ConsensusParams)
+	}
+	return nil
 }
 
 // FakeNew is fake BaseVariable
@@ -240,6 +361,10 @@ func FakeNew() (*BaseVariableImpl, error) {
 	if err != nil {
 		return nil, err
 	}
+	evidenceDB, err := NewEvidenceDB("./Fakedb/EvidenceDB")
+	if err != nil {
+		return nil, err
+	}
 	config := common.Config{}
 	config.VM = &common.VMConfig{}
 	config.VM.JsPath = os.Getenv("GOPATH") + "/src/github.com/iost-official/go-iost/vm/v8vm/v8/libjs/"
@@ -248,7 +373,7 @@ func FakeNew() (*BaseVariableImpl, error) {
 	VoteContractPath = os.Getenv("GOPATH") + "/src/github.com/iost-official/go-iost/config/"
 	fmt.Println(VoteContractPath)
 	fmt.Println(config.VM.JsPath)
-	blk, err := GenGenesis(stateDB, []string{"a1", "11111111111", "a2", "2222", "a3", "333"})
+	blk, err := GenGenesis(stateDB, []string{"a1", "11111111111", "a2", "2222", "a3", "333"}, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -268,7 +393,7 @@ func FakeNew() (*BaseVariableImpl, error) {
 		return nil, err
 	}
 
-	return &BaseVariableImpl{blockChain, stateDB, txDB, ModeNormal, []string{""}, &config}, nil
+	return &BaseVariableImpl{blockChain, stateDB, txDB, evidenceDB, ModeNormal, []string{""}, &config}, nil
 }
 
 // TxDB return the transaction database
@@ -276,6 +401,11 @@ func (g *BaseVariableImpl) TxDB() TxDB {
 	return g.txDB
 }
 
+// EvidenceDB return the double-sign evidence database
+func (g *BaseVariableImpl) EvidenceDB() EvidenceDB {
+	return g.evidenceDB
+}
+
 // StateDB return the state database
 func (g *BaseVariableImpl) StateDB() db.MVCCDB {
 	return g.stateDB
@@ -0,0 +1,121 @@
+package global
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/iost-official/go-iost/core/block"
+	"github.com/iost-official/go-iost/db/kv"
+)
+
+//go:generate mockgen -destination ../mocks/mock_evidencedb.go -package core_mock github.com/iost-official/go-iost/core/global EvidenceDB
+
+// EvidenceDB defines the functions of double-sign evidence database.
+type EvidenceDB interface {
+	Push(e *block.Evidence) (bool, error)
+	Has(e *block.Evidence) (bool, error)
+	List() ([]*block.Evidence, error)
+	Prune(slot int64) (int, error)
+	Close()
+}
+
+// EvidenceDBImpl is the implementation of EvidenceDB.
+type EvidenceDBImpl struct {
+	evidenceDB *kv.Storage
+	mu         sync.Mutex
+}
+
+var evidencePrefix = []byte("e") // evidencePrefix + witness + slot -> evidence data
+
+func evidenceKey(e *block.Evidence) []byte {
+	key := make([]byte, 0, len(evidencePrefix)+len(e.Key()))
+	key = append(key, evidencePrefix...)
+	return append(key, e.Key()...)
+}
+
+// NewEvidenceDB returns a EvidenceDB instance.
+func NewEvidenceDB(path string) (EvidenceDB, error) {
+	ldb, err := kv.NewStorage(path, kv.LevelDBStorage)
+	if err != nil {
+		return nil, err
+	}
+	return &EvidenceDBImpl{evidenceDB: ldb}, nil
+}
+
+// Push saves the evidence to database, it returns false if the witness already has an evidence in the slot.
+func (edb *EvidenceDBImpl) Push(e *block.Evidence) (bool, error) {
+	edb.mu.Lock()
+	defer edb.mu.Unlock()
+
+	key := evidenceKey(e)
+	ok, err := edb.evidenceDB.Has(key)
+	if err != nil {
+		return false, fmt.Errorf("failed to check the evidence: %v", err)
+	}
+	if ok {
+		return false, nil
+	}
+	b, err := e.Encode()
+	if err != nil {
+		return false, fmt.Errorf("failed to encode the evidence: %v", err)
+	}
+	err = edb.evidenceDB.Put(key, b)
+	if err != nil {
+		return false, fmt.Errorf("failed to put the evidence: %v", err)
+	}
+	return true, nil
+}
+
+// Has returns whether the witness already has an evidence in the slot of e.
+func (edb *EvidenceDBImpl) Has(e *block.Evidence) (bool, error) {
+	return edb.evidenceDB.Has(evidenceKey(e))
+}
+
+// List returns all the evidence in database.
+func (edb *EvidenceDBImpl) List() ([]*block.Evidence, error) {
+	keys, err := edb.evidenceDB.Keys(evidencePrefix)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the evidence keys: %v", err)
+	}
+	list := make([]*block.Evidence, 0, len(keys))
+	for _, k := range keys {
+		b, err := edb.evidenceDB.Get(k)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the evidence: %v", err)
+		}
+		var e block.Evidence
+		if err := e.Decode(b); err != nil {
+			return nil, fmt.Errorf("failed to decode the evidence: %v", err)
+		}
+		list = append(list, &e)
+	}
+	return list, nil
+}
+
+// Prune deletes the evidence of the slots before slot, and returns the number of the deleted ones.
+func (edb *EvidenceDBImpl) Prune(slot int64) (int, error) {
+	edb.mu.Lock()
+	defer edb.mu.Unlock()
+
+	keys, err := edb.evidenceDB.Keys(evidencePrefix)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get the evidence keys: %v", err)
+	}
+	var cnt int
+	for _, k := range keys {
+		// the key ends with the slot
+		if len(k) < len(evidencePrefix)+8 || block.ByteToInt64(k[len(k)-8:]) >= slot {
+			continue
+		}
+		if err := edb.evidenceDB.Delete(k); err != nil {
+			return cnt, fmt.Errorf("failed to delete the evidence: %v", err)
+		}
+		cnt++
+	}
+	return cnt, nil
+}
+
+// Close closes the database.
+func (edb *EvidenceDBImpl) Close() {
+	edb.evidenceDB.Close()
+}
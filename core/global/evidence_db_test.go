@@ -0,0 +1,64 @@
+package global
+
+import (
+	"testing"
+
+	"github.com/iost-official/go-iost/account"
+	"github.com/iost-official/go-iost/core/block"
+	"github.com/iost-official/go-iost/crypto"
+)
+
+func genEvidence(t *testing.T, acc *account.Account, slot int64) *block.Evidence {
+	blks := make([]*block.Block, 2)
+	for i := range blks {
+		blks[i] = &block.Block{
+			Head: &block.BlockHead{
+				ParentHash: []byte{byte(i)},
+				Witness:    acc.ID,
+				Time:       slot,
+			},
+		}
+		blks[i].CalculateHeadHash()
+		blks[i].Sign = acc.Sign(blks[i].HeadHash())
+	}
+	e, err := block.NewEvidence(blks[0], blks[1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	return e
+}
+
+func TestEvidenceDB_Prune(t *testing.T) {
+	path := t.TempDir()
+	edb, err := NewEvidenceDB(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	acc, _ := account.NewAccount(nil, crypto.Secp256k1)
+	if ok, err := edb.Push(genEvidence(t, acc, 1)); !ok || err != nil {
+		t.Fatalf("push: %v %v", ok, err)
+	}
+	if ok, err := edb.Push(genEvidence(t, acc, 1)); ok || err != nil {
+		t.Fatalf("push the same slot: %v %v", ok, err)
+	}
+	if ok, err := edb.Push(genEvidence(t, acc, 2)); !ok || err != nil {
+		t.Fatalf("push: %v %v", ok, err)
+	}
+	edb.Close()
+
+	edb, err = NewEvidenceDB(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer edb.Close()
+	if cnt, err := edb.Prune(2); cnt != 1 || err != nil {
+		t.Fatalf("prune: %v %v", cnt, err)
+	}
+	list, err := edb.List()
+	if err != nil || len(list) != 1 || list[0].Slot() != 2 {
+		t.Fatalf("list after prune: %v %v", list, err)
+	}
+	if ok, err := edb.Push(genEvidence(t, acc, 1)); !ok || err != nil {
+		t.Fatalf("push the pruned slot: %v %v", ok, err)
+	}
+}
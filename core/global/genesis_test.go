@@ -6,6 +6,7 @@ import (
 
 	"fmt"
 
+	"github.com/iost-official/go-iost/common"
 	"github.com/iost-official/go-iost/db"
 	"github.com/iost-official/go-iost/ilog"
 )
@@ -35,10 +36,56 @@ func TestGenGenesis(t *testing.T) {
 		"13600000000",
 		"IOSTCJqjtLBntuWRGaZumevYgBEZsU8AaAdUpEMnpGieKV676B9St",
 		"13700000000",
-	})
+	}, nil)
 	if err != nil {
 		t.Fatal(err)
 	}
 
 	fmt.Println(blk)
 }
+
+func TestGenGenesis_ProducerNumber(t *testing.T) {
+	ilog.Stop()
+	d, err := db.NewMVCCDB("mvcc_producer")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		d.Close()
+		os.RemoveAll("mvcc_producer")
+	}()
+
+	params := common.DefaultGenesisConsensusConfig()
+	params.ProducerNumber = 2
+	_, err = GenGenesis(d, []string{"IOSTjBxx7sUJvmxrMiyjEQnz9h5bfNrXwLinkoL9YvWjnrGdbKnBP", "13100000000"}, params)
+	if err == nil {
+		t.Fatal("producer number more than the genesis witnesses should be refused")
+	}
+}
+
+func TestCheckConsensusParams(t *testing.T) {
+	d, err := db.NewMVCCDB(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer d.Close()
+	defer common.SetConsensusParams(common.ConsensusParams)
+
+	if err := useConfigConsensusParams(nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := CheckConsensusParams(d); err != nil {
+		t.Fatalf("genesis block without params: %v", err)
+	}
+	params := common.DefaultGenesisConsensusConfig()
+	params.SlotLength = 1
+	if err := useConfigConsensusParams(params); err != nil {
+		t.Fatal(err)
+	}
+	if common.SlotLength != 1 {
+		t.Fatalf("slot length %v of config isn't used", common.SlotLength)
+	}
+	if err := CheckConsensusParams(d); err == nil {
+		t.Fatal("genesis block with different params should be refused")
+	}
+}
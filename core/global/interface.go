@@ -11,6 +11,7 @@ import (
 // BaseVariable defines BaseVariable's API.
 type BaseVariable interface {
 	TxDB() TxDB
+	EvidenceDB() EvidenceDB
 	StateDB() db.MVCCDB
 	Config() *common.Config
 	BlockChain() block.Chain
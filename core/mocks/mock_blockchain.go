@@ -141,6 +141,30 @@ func (mr *MockChainMockRecorder) Push(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Push", reflect.TypeOf((*MockChain)(nil).Push), arg0)
 }
 
+// PushCheckpoint mocks base method
+func (m *MockChain) PushCheckpoint(arg0, arg1 *block.Block) error {
+	ret := m.ctrl.Call(m, "PushCheckpoint", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PushCheckpoint indicates an expected call of PushCheckpoint
+func (mr *MockChainMockRecorder) PushCheckpoint(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PushCheckpoint", reflect.TypeOf((*MockChain)(nil).PushCheckpoint), arg0, arg1)
+}
+
+// Start mocks base method
+func (m *MockChain) Start() int64 {
+	ret := m.ctrl.Call(m, "Start")
+	ret0, _ := ret[0].(int64)
+	return ret0
+}
+
+// Start indicates an expected call of Start
+func (mr *MockChainMockRecorder) Start() *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Start", reflect.TypeOf((*MockChain)(nil).Start))
+}
+
 // Top mocks base method
 func (m *MockChain) Top() (*block.Block, error) {
 	ret := m.ctrl.Call(m, "Top")
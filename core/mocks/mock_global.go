@@ -60,6 +60,18 @@ func (mr *MockBaseVariableMockRecorder) Config() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Config", reflect.TypeOf((*MockBaseVariable)(nil).Config))
 }
 
+// EvidenceDB mocks base method
+func (m *MockBaseVariable) EvidenceDB() global.EvidenceDB {
+	ret := m.ctrl.Call(m, "EvidenceDB")
+	ret0, _ := ret[0].(global.EvidenceDB)
+	return ret0
+}
+
+// EvidenceDB indicates an expected call of EvidenceDB
+func (mr *MockBaseVariableMockRecorder) EvidenceDB() *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EvidenceDB", reflect.TypeOf((*MockBaseVariable)(nil).EvidenceDB))
+}
+
 // Mode mocks base method
 func (m *MockBaseVariable) Mode() global.TMode {
 	ret := m.ctrl.Call(m, "Mode")
@@ -0,0 +1,58 @@
+package tx
+
+import "encoding/json"
+
+// MaxEventTopics is the maximum number of the indexed topics of an event.
+const MaxEventTopics = 4
+
+// Event is a structured event emitted by a contract. The topics are indexed for filtering, and the
+// location of the event is only set when it's delivered to the subscribers or queried.
+type Event struct {
+	Contract    string   `json:"contract"`
+	Name        string   `json:"name"`
+	Topics      []string `json:"topics"`
+	Data        string   `json:"data"`
+	TxHash      string   `json:"tx_hash,omitempty"`
+	BlockHash   string   `json:"block_hash,omitempty"`
+	BlockNumber int64    `json:"block_number,omitempty"`
+}
+
+// Events returns the events in the receipts.
+func (r *TxReceipt) Events() []*Event {
+	events := make([]*Event, 0)
+	for _, re := range r.Receipts {
+		if re.Type != EventDefined {
+			continue
+		}
+		var e Event
+		if err := json.Unmarshal([]byte(re.Content), &e); err != nil {
+			continue
+		}
+		events = append(events, &e)
+	}
+	return events
+}
+
+// EventFilter filters the events like log filters. The empty fields match all, and the topics
+// match the indexed topics of the event by position, an empty topic matches any value.
+type EventFilter struct {
+	Contract string
+	Name     string
+	Topics   []string
+}
+
+// Match returns whether the event passes the filter.
+func (f *EventFilter) Match(e *Event) bool {
+	if f.Contract != "" && e.Contract != f.Contract {
+		return false
+	}
+	if f.Name != "" && e.Name != f.Name {
+		return false
+	}
+	for i, t := range f.Topics {
+		if t != "" && (i >= len(e.Topics) || e.Topics[i] != t) {
+			return false
+		}
+	}
+	return true
+}
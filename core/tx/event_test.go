@@ -0,0 +1,51 @@
+package tx
+
+import (
+	"testing"
+)
+
+func TestEventFilter(t *testing.T) {
+	e := &Event{
+		Contract: "token",
+		Name:     "transfer",
+		Topics:   []string{"alice", "bob"},
+		Data:     "100",
+	}
+	tests := []struct {
+		filter EventFilter
+		match  bool
+	}{
+		{EventFilter{}, true},
+		{EventFilter{Contract: "token"}, true},
+		{EventFilter{Contract: "other"}, false},
+		{EventFilter{Name: "transfer"}, true},
+		{EventFilter{Name: "approve"}, false},
+		{EventFilter{Topics: []string{"alice"}}, true},
+		{EventFilter{Topics: []string{"", "bob"}}, true},
+		{EventFilter{Topics: []string{"bob"}}, false},
+		{EventFilter{Topics: []string{"alice", "bob", ""}}, true},
+		{EventFilter{Topics: []string{"alice", "bob", "carol"}}, false},
+		{EventFilter{Contract: "token", Name: "transfer", Topics: []string{"alice", "carol"}}, false},
+	}
+	for _, tt := range tests {
+		if m := tt.filter.Match(e); m != tt.match {
+			t.Errorf("filter %+v match = %v, want %v", tt.filter, m, tt.match)
+		}
+	}
+}
+
+func TestReceiptEvents(t *testing.T) {
+	r := NewTxReceipt([]byte{1})
+	r.Receipts = append(r.Receipts,
+		Receipt{Type: UserDefined, Content: `{"name":"user"}`},
+		Receipt{Type: EventDefined, Content: `{"contract":"token","name":"transfer","topics":["alice"],"data":"1"}`},
+		Receipt{Type: EventDefined, Content: `invalid`},
+	)
+	events := r.Events()
+	if len(events) != 1 {
+		t.Fatalf("events: %v", len(events))
+	}
+	if e := events[0]; e.Contract != "token" || e.Name != "transfer" || len(e.Topics) != 1 || e.Data != "1" {
+		t.Errorf("event: %+v", e)
+	}
+}
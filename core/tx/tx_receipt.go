@@ -13,9 +13,9 @@ const (
 	Success StatusCode = iota
 	ErrorGasRunOut
 	ErrorBalanceNotEnough
-	ErrorParamter // parameter mismatch when calling function
-	ErrorRuntime  // runtime error
-	ErrorTimeout
+	ErrorParamter         // parameter mismatch when calling function
+	ErrorRuntime          // runtime error
+	ErrorTimeout          // txs exceeding the time limit, not produced from the metering fork on
 	ErrorTxFormat         // tx format errors
 	ErrorDuplicateSetCode // more than one set code action in a tx
 	ErrorUnknown          // other errors
@@ -35,6 +35,8 @@ const (
 	SystemDefined ReceiptType = iota
 	// UserDefined user defined receipt, usually a json string
 	UserDefined
+	// EventDefined structured event emitted by a contract, the content is the json of Event
+	EventDefined
 )
 
 // Receipt generated when applying transaction
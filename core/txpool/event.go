@@ -0,0 +1,121 @@
+package txpool
+
+import (
+	"encoding/json"
+
+	"github.com/iost-official/go-iost/account"
+	"github.com/iost-official/go-iost/common"
+	"github.com/iost-official/go-iost/core/block"
+	"github.com/iost-official/go-iost/core/event"
+	"github.com/iost-official/go-iost/core/tx"
+	"github.com/iost-official/go-iost/ilog"
+)
+
+// TxEvent is the data of the txpool events.
+type TxEvent struct {
+	Hash        string   `json:"hash"`
+	Publisher   string   `json:"publisher"`
+	Contracts   []string `json:"contracts"`
+	Reason      string   `json:"reason,omitempty"`
+	BlockHash   string   `json:"block_hash,omitempty"`
+	BlockNumber int64    `json:"block_number,omitempty"`
+}
+
+// NewTxEvent returns the event data of the tx.
+func NewTxEvent(t *tx.Tx) *TxEvent {
+	e := &TxEvent{
+		Hash:      common.Base58Encode(t.Hash()),
+		Contracts: make([]string, 0, len(t.Actions)),
+	}
+	if t.Publisher != nil && len(t.Publisher.Pubkey) > 0 {
+		e.Publisher = account.GetIDByPubkey(t.Publisher.Pubkey)
+	}
+	for _, a := range t.Actions {
+		e.Contracts = append(e.Contracts, a.Contract)
+	}
+	return e
+}
+
+// Match returns whether the event passes the publisher and contract filter, empty filter matches all.
+func (e *TxEvent) Match(publisher, contract string) bool {
+	if publisher != "" && e.Publisher != publisher {
+		return false
+	}
+	if contract == "" {
+		return true
+	}
+	for _, c := range e.Contracts {
+		if c == contract {
+			return true
+		}
+	}
+	return false
+}
+
+// IsTxPoolTopic returns whether the topic is a txpool event.
+func IsTxPoolTopic(topic event.Event_Topic) bool {
+	switch topic {
+	case event.Event_TxPoolAccepted, event.Event_TxPoolRejected, event.Event_TxPoolPacked,
+		event.Event_TxPoolEvicted, event.Event_TxPoolExpired:
+		return true
+	}
+	return false
+}
+
+func hasTxSubscriber(topic event.Event_Topic) bool {
+	return event.GetEventCollectorInstance().HasSubscriber(topic)
+}
+
+func postTxEvent(topic event.Event_Topic, e *TxEvent) {
+	data, err := json.Marshal(e)
+	if err != nil {
+		ilog.Errorf("failed to marshal tx event, err = %v", err)
+		return
+	}
+	event.GetEventCollectorInstance().Post(event.NewEvent(topic, string(data)))
+}
+
+func postTxAccepted(t *tx.Tx) {
+	if !hasTxSubscriber(event.Event_TxPoolAccepted) {
+		return
+	}
+	postTxEvent(event.Event_TxPoolAccepted, NewTxEvent(t))
+}
+
+func postTxRejected(t *tx.Tx, r TAddTx) {
+	if !hasTxSubscriber(event.Event_TxPoolRejected) {
+		return
+	}
+	e := NewTxEvent(t)
+	e.Reason = r.String()
+	postTxEvent(event.Event_TxPoolRejected, e)
+}
+
+func postTxEvicted(t *tx.Tx, reason string) {
+	if !hasTxSubscriber(event.Event_TxPoolEvicted) {
+		return
+	}
+	e := NewTxEvent(t)
+	e.Reason = reason
+	postTxEvent(event.Event_TxPoolEvicted, e)
+}
+
+func postTxExpired(t *tx.Tx) {
+	if !hasTxSubscriber(event.Event_TxPoolExpired) {
+		return
+	}
+	postTxEvent(event.Event_TxPoolExpired, NewTxEvent(t))
+}
+
+func postBlockPacked(blk *block.Block) {
+	if !hasTxSubscriber(event.Event_TxPoolPacked) {
+		return
+	}
+	blkHash := common.Base58Encode(blk.HeadHash())
+	for _, t := range blk.Txs {
+		e := NewTxEvent(t)
+		e.BlockHash = blkHash
+		e.BlockNumber = blk.Head.Number
+		postTxEvent(event.Event_TxPoolPacked, e)
+	}
+}
@@ -0,0 +1,28 @@
+package txpool
+
+import (
+	"testing"
+
+	"github.com/iost-official/go-iost/account"
+	"github.com/iost-official/go-iost/core/event"
+	"github.com/iost-official/go-iost/crypto"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestTxEvent(t *testing.T) {
+	Convey("test TxEvent", t, func() {
+		acc, err := account.NewAccount(nil, crypto.Secp256k1)
+		So(err, ShouldBeNil)
+		e := NewTxEvent(genTx(acc, Expiration))
+		So(e.Publisher, ShouldEqual, acc.ID)
+		So(e.Contracts, ShouldResemble, []string{"contract1", "contract2"})
+
+		So(e.Match("", ""), ShouldBeTrue)
+		So(e.Match(acc.ID, "contract2"), ShouldBeTrue)
+		So(e.Match("", "contract3"), ShouldBeFalse)
+		So(e.Match("IOSTother", ""), ShouldBeFalse)
+
+		So(IsTxPoolTopic(event.Event_TxPoolExpired), ShouldBeTrue)
+		So(IsTxPoolTopic(event.Event_ContractUserEvent), ShouldBeFalse)
+	})
+}
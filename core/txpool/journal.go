@@ -0,0 +1,172 @@
+package txpool
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"io"
+	"os"
+	"sync"
+
+	"github.com/iost-official/go-iost/core/tx"
+)
+
+var (
+	errNoActiveJournal = errors.New("no active journal")
+	maxJournalRecord   = uint32(1 << 20)
+)
+
+// txJournal is an append-only file of the txs accepted by the pool.
+// Every record is a 4 bytes big endian length followed by the encoded tx.
+// The appended records are synced to disk in groups by sync, not one by one.
+type txJournal struct {
+	path   string
+	writer *os.File
+	dirty  bool
+	mu     sync.Mutex
+}
+
+func newTxJournal(path string) *txJournal {
+	return &txJournal{
+		path: path,
+	}
+}
+
+// load reads all the txs in the journal and passes them to add.
+func (j *txJournal) load(add func(*tx.Tx)) (int, error) {
+	f, err := os.Open(j.path)
+	if os.IsNotExist(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+
+	r := bufio.NewReader(f)
+	var cnt int
+	lenBuf := make([]byte, 4)
+	for {
+		if _, err := io.ReadFull(r, lenBuf); err != nil {
+			if err == io.EOF || err == io.ErrUnexpectedEOF {
+				return cnt, nil
+			}
+			return cnt, err
+		}
+		l := binary.BigEndian.Uint32(lenBuf)
+		if l > maxJournalRecord {
+			return cnt, errors.New("journal record is too large")
+		}
+		data := make([]byte, l)
+		if _, err := io.ReadFull(r, data); err != nil {
+			// the last record may be half-written when the node crashed
+			if err == io.ErrUnexpectedEOF {
+				return cnt, nil
+			}
+			return cnt, err
+		}
+		var t tx.Tx
+		if err := t.Decode(data); err != nil {
+			continue
+		}
+		add(&t)
+		cnt++
+	}
+}
+
+// insert appends a tx to the journal, it's on disk after the next sync.
+func (j *txJournal) insert(t *tx.Tx) error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if j.writer == nil {
+		return errNoActiveJournal
+	}
+	if err := writeJournalRecord(j.writer, t); err != nil {
+		return err
+	}
+	j.dirty = true
+	return nil
+}
+
+// sync flushes the records appended since the last sync to disk.
+func (j *txJournal) sync() error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if j.writer == nil || !j.dirty {
+		return nil
+	}
+	j.dirty = false
+	return j.writer.Sync()
+}
+
+// rotate regenerates the journal with the given txs, dropping all the others.
+func (j *txJournal) rotate(txs []*tx.Tx) error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if j.writer != nil {
+		if err := j.writer.Close(); err != nil {
+			return err
+		}
+		j.writer = nil
+		j.dirty = false
+	}
+	f, err := os.OpenFile(j.path+".new", os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
+	if err != nil {
+		return err
+	}
+	w := bufio.NewWriter(f)
+	for _, t := range txs {
+		if err := writeJournalRecord(w, t); err != nil {
+			f.Close()
+			return err
+		}
+	}
+	if err := w.Flush(); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	f.Close()
+
+	if err := os.Rename(j.path+".new", j.path); err != nil {
+		return err
+	}
+	sink, err := os.OpenFile(j.path, os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return err
+	}
+	j.writer = sink
+	return nil
+}
+
+// close flushes the journal to disk and closes the file.
+func (j *txJournal) close() error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if j.writer == nil {
+		return nil
+	}
+	err := j.writer.Sync()
+	if e := j.writer.Close(); err == nil {
+		err = e
+	}
+	j.writer = nil
+	j.dirty = false
+	return err
+}
+
+func writeJournalRecord(w io.Writer, t *tx.Tx) error {
+	data := t.Encode()
+	buf := make([]byte, 4+len(data))
+	binary.BigEndian.PutUint32(buf, uint32(len(data)))
+	copy(buf[4:], data)
+	_, err := w.Write(buf)
+	return err
+}
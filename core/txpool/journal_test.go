@@ -0,0 +1,47 @@
+package txpool
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/iost-official/go-iost/account"
+	"github.com/iost-official/go-iost/core/tx"
+	"github.com/iost-official/go-iost/crypto"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestTxJournal(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "TxPoolJournal")
+	Convey("test txJournal", t, func() {
+
+		acc, err := account.NewAccount(nil, crypto.Secp256k1)
+		So(err, ShouldBeNil)
+		t1 := genTx(acc, Expiration)
+		t2 := genTx(acc, Expiration)
+		t3 := genTx(acc, Expiration)
+
+		j := newTxJournal(path)
+		So(j.insert(t1), ShouldEqual, errNoActiveJournal)
+		So(j.rotate([]*tx.Tx{t1}), ShouldBeNil)
+		So(j.insert(t2), ShouldBeNil)
+		So(j.insert(t3), ShouldBeNil)
+		So(j.sync(), ShouldBeNil)
+		So(j.close(), ShouldBeNil)
+
+		var loaded []*tx.Tx
+		cnt, err := newTxJournal(path).load(func(t *tx.Tx) {
+			loaded = append(loaded, t)
+		})
+		So(err, ShouldBeNil)
+		So(cnt, ShouldEqual, 3)
+		So(string(loaded[0].Hash()), ShouldEqual, string(t1.Hash()))
+		So(string(loaded[2].Hash()), ShouldEqual, string(t3.Hash()))
+
+		j = newTxJournal(path)
+		So(j.rotate([]*tx.Tx{t2}), ShouldBeNil)
+		So(j.close(), ShouldBeNil)
+		cnt, err = j.load(func(t *tx.Tx) {})
+		So(err, ShouldBeNil)
+		So(cnt, ShouldEqual, 1)
+	})
+}
@@ -30,6 +30,11 @@ type TxPImpl struct {
 	blockList *sync.Map
 	// pendingTx *sync.Map
 	pendingTx *SortedTxMap
+	journal   *txJournal
+	// journalMu is held for reading while a tx is journaled and added, and for writing while the
+	// journal is rotated, so that every tx in pending is in the journal
+	journalMu sync.RWMutex
+	config    *common.TxPoolConfig
 
 	mu               sync.RWMutex
 	quitGenerateMode chan struct{}
@@ -38,9 +43,16 @@ type TxPImpl struct {
 
 // NewTxPoolImpl returns a default TxPImpl instance.
 func NewTxPoolImpl(global global.BaseVariable, blockCache blockcache.BlockCache, p2ps p2p.Service) (*TxPImpl, error) {
+	conf := global.Config()
+	var poolConf *common.TxPoolConfig
+	if conf != nil {
+		poolConf = conf.TxPool
+	}
+	config := newTxPoolConfig(poolConf)
 	p := &TxPImpl{
+		config:           config,
 		blockCache:       blockCache,
-		chTx:             make(chan *tx.Tx, 102400),
+		chTx:             make(chan *tx.Tx, config.ChanSize),
 		forkChain:        new(forkChain),
 		blockList:        new(sync.Map),
 		pendingTx:        NewSortedTxMap(),
@@ -54,6 +66,9 @@ func NewTxPoolImpl(global global.BaseVariable, blockCache blockcache.BlockCache,
 	if p.forkChain.NewHead == nil {
 		return nil, errors.New("failed to head")
 	}
+	if conf != nil && conf.DB != nil {
+		p.journal = newTxJournal(conf.DB.LdbPath + "TxPoolJournal")
+	}
 	close(p.quitGenerateMode)
 	return p, nil
 }
@@ -68,6 +83,11 @@ func (pool *TxPImpl) Start() error {
 func (pool *TxPImpl) Stop() {
 	ilog.Infof("TxPImpl Stop")
 	close(pool.quitCh)
+	if pool.journal != nil {
+		if err := pool.journal.close(); err != nil {
+			ilog.Errorf("failed to close txpool journal, err = %v", err)
+		}
+	}
 }
 
 func (pool *TxPImpl) loop() {
@@ -79,6 +99,7 @@ func (pool *TxPImpl) loop() {
 	}
 
 	pool.initBlockTx()
+	pool.loadJournal()
 
 	workerCnt := (runtime.NumCPU() + 1) / 2
 	if workerCnt == 0 {
@@ -92,6 +113,12 @@ func (pool *TxPImpl) loop() {
 	clearTx := time.NewTicker(clearInterval)
 	defer clearTx.Stop()
 
+	rejournal := time.NewTicker(rejournalInterval)
+	defer rejournal.Stop()
+
+	syncJournal := time.NewTicker(journalSyncInterval)
+	defer syncJournal.Stop()
+
 	for {
 		select {
 		case tr := <-pool.chTx:
@@ -110,6 +137,12 @@ func (pool *TxPImpl) loop() {
 
 			pool.mu.Unlock()
 
+		case <-rejournal.C:
+			pool.rotateJournal()
+
+		case <-syncJournal.C:
+			pool.syncJournal()
+
 		case <-pool.quitCh:
 			return
 		}
@@ -141,6 +174,8 @@ func (pool *TxPImpl) verifyWorkers(p2pCh chan p2p.IncomingMessage, tCn chan *tx.
 
 		if r := pool.verifyTx(&t); r == Success {
 			tCn <- &t
+		} else {
+			postTxRejected(&t, r)
 		}
 	}
 }
@@ -156,6 +191,7 @@ func (pool *TxPImpl) AddLinkedNode(linkedNode *blockcache.BlockCacheNode, headNo
 	if pool.addBlock(linkedNode.Block) != nil {
 		return errors.New("failed to add block")
 	}
+	postBlockPacked(linkedNode.Block)
 
 	tFort := pool.updateForkChain(headNode)
 	switch tFort {
@@ -180,6 +216,7 @@ func (pool *TxPImpl) AddTx(t *tx.Tx) TAddTx {
 	var r TAddTx
 
 	if r = pool.verifyTx(t); r != Success {
+		postTxRejected(t, r)
 		return r
 	}
 	if r = pool.addTx(t); r == Success {
@@ -223,6 +260,7 @@ func (pool *TxPImpl) PendingTxs(maxCnt int) (TxsList, *blockcache.BlockCacheNode
 	for ok && i < maxCnt {
 		if !pool.TxTimeOut(tx) {
 			pendingList = append(pendingList, tx)
+			i++
 		}
 		tx, ok = iter.Next()
 	}
@@ -346,10 +384,6 @@ func (pool *TxPImpl) initBlockTx() {
 }
 
 func (pool *TxPImpl) verifyTx(t *tx.Tx) TAddTx {
-	if pool.pendingTx.Size() > maxCacheTxs {
-		return CacheFullError
-	}
-
 	if t.GasPrice <= 0 {
 		return GasPriceError
 	}
@@ -468,7 +502,36 @@ func (pool *TxPImpl) clearBlock() {
 
 }
 
+// checkPolicy checks the tx against the admission limits of the pool.
+func (pool *TxPImpl) checkPolicy(t *tx.Tx) TAddTx {
+	if t.GasPrice < pool.config.MinGasPrice {
+		return GasPriceError
+	}
+	if len(t.Encode()) > pool.config.MaxTxSize {
+		return TxSizeError
+	}
+	if t.Expiration-time.Now().UnixNano() > pool.config.MaxExpiration*int64(time.Second) {
+		return ExpirationError
+	}
+	return Success
+}
+
 func (pool *TxPImpl) addTx(tx *tx.Tx) TAddTx {
+	r := pool.doAddTx(tx)
+	switch r {
+	case Success:
+		postTxAccepted(tx)
+	case DupError:
+	default:
+		postTxRejected(tx, r)
+	}
+	return r
+}
+
+func (pool *TxPImpl) doAddTx(tx *tx.Tx) TAddTx {
+	if r := pool.checkPolicy(tx); r != Success {
+		return r
+	}
 
 	h := tx.Hash()
 	if pool.existTxInChain(h, pool.forkChain.NewHead.Block) {
@@ -477,10 +540,89 @@ func (pool *TxPImpl) addTx(tx *tx.Tx) TAddTx {
 	if pool.existTxInPending(h) {
 		return DupError
 	}
-	pool.pendingTx.Add(tx)
+	if old := pool.pendingTx.GetReplaceable(tx); old != nil {
+		if tx.GasPrice*100 < old.GasPrice*(100+pool.config.PriceBumpPercent) {
+			return UnderpricedError
+		}
+		pool.pendingTx.Del(old.Hash())
+		postTxEvicted(old, "replaced")
+		pool.addPending(tx)
+		return Success
+	}
+	if pool.pendingTx.PublisherSize(tx) >= pool.config.MaxPerPublisher {
+		return PublisherLimitError
+	}
+	if pool.pendingTx.Size() >= pool.config.MaxSize {
+		lowest := pool.pendingTx.Lowest()
+		if lowest == nil || compareTx(tx, lowest) <= 0 {
+			return CacheFullError
+		}
+		pool.pendingTx.Del(lowest.Hash())
+		metricsEvictedTxCount.Add(1, nil)
+		postTxEvicted(lowest, "pool full")
+	}
+	pool.addPending(tx)
 	return Success
 }
 
+// addPending journals the tx before adding it to pending, so every accepted tx is in the journal.
+// The journal is synced to disk every journalSyncInterval.
+func (pool *TxPImpl) addPending(t *tx.Tx) {
+	pool.journalMu.RLock()
+	defer pool.journalMu.RUnlock()
+
+	if pool.journal != nil {
+		if err := pool.journal.insert(t); err != nil && err != errNoActiveJournal {
+			ilog.Errorf("failed to journal tx, err = %v", err)
+		}
+	}
+	pool.pendingTx.Add(t)
+}
+
+// loadJournal replays the journaled txs, skipping the expired and packed ones, then compacts the journal.
+func (pool *TxPImpl) loadJournal() {
+	if pool.journal == nil {
+		return
+	}
+	var added int
+	total, err := pool.journal.load(func(t *tx.Tx) {
+		if pool.verifyTx(t) != Success {
+			return
+		}
+		if ok, _ := pool.global.TxDB().HasTx(t.Hash()); ok {
+			return
+		}
+		if pool.addTx(t) == Success {
+			added++
+		}
+	})
+	if err != nil {
+		ilog.Errorf("failed to load txpool journal, err = %v", err)
+	}
+	ilog.Infof("loaded txpool journal, total: %v, added: %v", total, added)
+	pool.rotateJournal()
+}
+
+func (pool *TxPImpl) syncJournal() {
+	if pool.journal == nil {
+		return
+	}
+	if err := pool.journal.sync(); err != nil {
+		ilog.Errorf("failed to sync txpool journal, err = %v", err)
+	}
+}
+
+func (pool *TxPImpl) rotateJournal() {
+	if pool.journal == nil {
+		return
+	}
+	pool.journalMu.Lock()
+	defer pool.journalMu.Unlock()
+	if err := pool.journal.rotate(pool.pendingTx.List()); err != nil {
+		ilog.Errorf("failed to rotate txpool journal, err = %v", err)
+	}
+}
+
 func (pool *TxPImpl) existTxInPending(hash []byte) bool {
 
 	tx := pool.pendingTx.Get(hash)
@@ -515,6 +657,7 @@ func (pool *TxPImpl) clearTimeOutTx() {
 	for ok {
 		if pool.TxTimeOut(tx) {
 			pool.DelTx(tx.Hash())
+			postTxExpired(tx)
 		}
 		tx, ok = iter.Next()
 	}
@@ -94,6 +94,7 @@ func TestNewTxPImpl(t *testing.T) {
 		gbl.EXPECT().StateDB().AnyTimes().Return(statedb)
 		gbl.EXPECT().BlockChain().AnyTimes().Return(base)
 		gbl.EXPECT().Mode().AnyTimes().Return(global.ModeNormal)
+		gbl.EXPECT().Config().AnyTimes().Return(&common.Config{})
 
 		So(err, ShouldBeNil)
 		BlockCache, err := blockcache.NewBlockCache(gbl)
@@ -252,6 +253,7 @@ func TestNewTxPImplB(t *testing.T) {
 		gbl.EXPECT().StateDB().AnyTimes().Return(statedb)
 		gbl.EXPECT().BlockChain().AnyTimes().Return(base)
 		gbl.EXPECT().Mode().AnyTimes().Return(global.ModeNormal)
+		gbl.EXPECT().Config().AnyTimes().Return(&common.Config{})
 
 		So(err, ShouldBeNil)
 		BlockCache, err := blockcache.NewBlockCache(gbl)
@@ -451,6 +453,75 @@ func TestNewTxPImplB(t *testing.T) {
 			So(ok, ShouldBeFalse)
 
 		})
+		Convey("replace by fee", func() {
+			t1 := genTx(newAccount, Expiration)
+			So(txPool.AddTx(t1), ShouldEqual, Success)
+
+			t2 := genReplaceTx(newAccount, t1, t1.GasPrice+1)
+			So(txPool.AddTx(t2), ShouldEqual, UnderpricedError)
+			So(txPool.testPendingTxsNum(), ShouldEqual, 1)
+
+			t3 := genReplaceTx(newAccount, t1, t1.GasPrice*2)
+			So(txPool.AddTx(t3), ShouldEqual, Success)
+			So(txPool.testPendingTxsNum(), ShouldEqual, 1)
+			So(txPool.existTxInPending(t1.Hash()), ShouldBeFalse)
+			So(txPool.existTxInPending(t3.Hash()), ShouldBeTrue)
+		})
+		Convey("publisher limit", func() {
+			limit := txPool.config.MaxPerPublisher
+			txPool.config.MaxPerPublisher = 2
+			defer func() { txPool.config.MaxPerPublisher = limit }()
+
+			So(txPool.AddTx(genTx(newAccount, Expiration)), ShouldEqual, Success)
+			So(txPool.AddTx(genTx(newAccount, Expiration)), ShouldEqual, Success)
+			So(txPool.AddTx(genTx(newAccount, Expiration)), ShouldEqual, PublisherLimitError)
+			So(txPool.AddTx(genTx(accountList[1], Expiration)), ShouldEqual, Success)
+		})
+		Convey("admission policy", func() {
+			t1 := genReplaceTx(newAccount, genTx(newAccount, Expiration), 0)
+			So(txPool.addTx(t1), ShouldEqual, GasPriceError)
+
+			t2 := genTx(newAccount, int64(48*time.Hour))
+			So(txPool.AddTx(t2), ShouldEqual, ExpirationError)
+
+			size := txPool.config.MaxTxSize
+			txPool.config.MaxTxSize = 10
+			So(txPool.AddTx(genTx(newAccount, Expiration)), ShouldEqual, TxSizeError)
+			txPool.config.MaxTxSize = size
+		})
+		Convey("evict when full", func() {
+			maxSize := txPool.config.MaxSize
+			txPool.config.MaxSize = 2
+			defer func() { txPool.config.MaxSize = maxSize }()
+
+			t1 := genReplaceTx(newAccount, genTx(newAccount, Expiration), 50)
+			t2 := genTx(accountList[1], Expiration)
+			So(txPool.AddTx(t1), ShouldEqual, Success)
+			So(txPool.AddTx(t2), ShouldEqual, Success)
+
+			t3 := genReplaceTx(accountList[2], genTx(accountList[2], Expiration), 10)
+			So(txPool.AddTx(t3), ShouldEqual, CacheFullError)
+
+			t4 := genReplaceTx(accountList[2], genTx(accountList[2], Expiration), 200)
+			So(txPool.AddTx(t4), ShouldEqual, Success)
+			So(txPool.testPendingTxsNum(), ShouldEqual, 2)
+			So(txPool.existTxInPending(t1.Hash()), ShouldBeFalse)
+			So(txPool.existTxInPending(t4.Hash()), ShouldBeTrue)
+		})
+		Convey("pending order", func() {
+			t1 := genTx(newAccount, Expiration)
+			t2 := genReplaceTx(accountList[1], genTx(accountList[1], Expiration), 300)
+			t3 := genReplaceTx(accountList[2], genTx(accountList[2], Expiration), 200)
+			So(txPool.AddTx(t1), ShouldEqual, Success)
+			So(txPool.AddTx(t2), ShouldEqual, Success)
+			So(txPool.AddTx(t3), ShouldEqual, Success)
+
+			l, _, err := txPool.PendingTxs(2)
+			So(err, ShouldBeNil)
+			So(len(l), ShouldEqual, 2)
+			So(string(l[0].Hash()), ShouldEqual, string(t2.Hash()))
+			So(string(l[1].Hash()), ShouldEqual, string(t3.Hash()))
+		})
 		//
 		//Convey("concurrent", func() {
 		//	txCnt := 10
@@ -758,6 +829,23 @@ func genTx(a *account.Account, expirationIter int64) *tx.Tx {
 	return t1
 }
 
+func genReplaceTx(a *account.Account, old *tx.Tx, gasPrice int64) *tx.Tx {
+	t := tx.NewTx(old.Actions, old.Signers, old.GasLimit, gasPrice, old.Expiration)
+	t.Time = old.Time
+
+	sig, err := tx.SignTxContent(t, a)
+	if err != nil {
+		ilog.Debug("failed to SignTxContent")
+	}
+	t.Signs = append(t.Signs, sig)
+
+	t1, err := tx.SignTx(t, a)
+	if err != nil {
+		ilog.Debug("failed to SignTx")
+	}
+	return t1
+}
+
 func genTxMsg(a *account.Account, expirationIter int64) *p2p.IncomingMessage {
 	t := genTx(a, expirationIter)
 
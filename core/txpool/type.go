@@ -1,10 +1,13 @@
 package txpool
 
 import (
+	"bytes"
+	"strconv"
 	"sync"
 	"time"
 
 	"github.com/emirpasic/gods/trees/redblacktree"
+	"github.com/iost-official/go-iost/common"
 	"github.com/iost-official/go-iost/core/block"
 	"github.com/iost-official/go-iost/core/blockcache"
 	"github.com/iost-official/go-iost/core/tx"
@@ -12,14 +15,30 @@ import (
 )
 
 var (
-	clearInterval = 10 * time.Second
+	clearInterval     = 10 * time.Second
+	rejournalInterval = time.Minute
+	// journalSyncInterval is the max time an accepted tx stays in the journal before it's synced to disk
+	journalSyncInterval = time.Second
 	// Expiration is the transaction expiration
-	Expiration  = int64(90 * time.Second)
-	filterTime  = int64(90 * time.Second)
-	maxCacheTxs = 30000
+	Expiration = int64(90 * time.Second)
+	filterTime = int64(90 * time.Second)
+
+	// DefaultTxPoolConfig provides the values of the unset fields in common.TxPoolConfig.
+	// MaxExpiration is in seconds, PriceBumpPercent is the min gas price increase for
+	// a tx to replace a pending one.
+	DefaultTxPoolConfig = common.TxPoolConfig{
+		ChanSize:         102400,
+		MaxSize:          30000,
+		MaxPerPublisher:  512,
+		MinGasPrice:      1,
+		MaxTxSize:        1 << 20,
+		MaxExpiration:    24 * 60 * 60,
+		PriceBumpPercent: 10,
+	}
 
 	metricsReceivedTxCount = metrics.NewCounter("iost_tx_received_count", []string{"from"})
 	metricsTxPoolSize      = metrics.NewGauge("iost_txpool_size", nil)
+	metricsEvictedTxCount  = metrics.NewCounter("iost_txpool_evicted_count", nil)
 )
 
 // FRet find the return value of the tx
@@ -59,14 +78,79 @@ const (
 	GasPriceError
 	// CacheFullError ...
 	CacheFullError
+	// PublisherLimitError ...
+	PublisherLimitError
+	// UnderpricedError ...
+	UnderpricedError
+	// TxSizeError ...
+	TxSizeError
+	// ExpirationError ...
+	ExpirationError
 )
 
+// String returns the name of the return value.
+func (r TAddTx) String() string {
+	switch r {
+	case Success:
+		return "Success"
+	case TimeError:
+		return "TimeError"
+	case VerifyError:
+		return "VerifyError"
+	case DupError:
+		return "DupError"
+	case GasPriceError:
+		return "GasPriceError"
+	case CacheFullError:
+		return "CacheFullError"
+	case PublisherLimitError:
+		return "PublisherLimitError"
+	case UnderpricedError:
+		return "UnderpricedError"
+	case TxSizeError:
+		return "TxSizeError"
+	case ExpirationError:
+		return "ExpirationError"
+	default:
+		return ""
+	}
+}
+
 type forkChain struct {
 	NewHead *blockcache.BlockCacheNode
 	OldHead *blockcache.BlockCacheNode
 	ForkBCN *blockcache.BlockCacheNode
 }
 
+func newTxPoolConfig(c *common.TxPoolConfig) *common.TxPoolConfig {
+	conf := DefaultTxPoolConfig
+	if c == nil {
+		return &conf
+	}
+	if c.ChanSize > 0 {
+		conf.ChanSize = c.ChanSize
+	}
+	if c.MaxSize > 0 {
+		conf.MaxSize = c.MaxSize
+	}
+	if c.MaxPerPublisher > 0 {
+		conf.MaxPerPublisher = c.MaxPerPublisher
+	}
+	if c.MinGasPrice > 0 {
+		conf.MinGasPrice = c.MinGasPrice
+	}
+	if c.MaxTxSize > 0 {
+		conf.MaxTxSize = c.MaxTxSize
+	}
+	if c.MaxExpiration > 0 {
+		conf.MaxExpiration = c.MaxExpiration
+	}
+	if c.PriceBumpPercent > 0 {
+		conf.PriceBumpPercent = c.PriceBumpPercent
+	}
+	return &conf
+}
+
 // TxsList tx sort
 type TxsList []*tx.Tx
 
@@ -75,16 +159,7 @@ func (s TxsList) Len() int { return len(s) }
 
 // Less ...
 func (s TxsList) Less(i, j int) bool {
-	if s[i].GasPrice > s[j].GasPrice {
-		return true
-	}
-
-	if s[i].GasPrice == s[j].GasPrice {
-		if s[i].Time < s[j].Time {
-			return true
-		}
-	}
-	return false
+	return compareTx(s[i], s[j]) > 0
 }
 
 // Swap ...
@@ -135,26 +210,56 @@ func (b *blockTx) existTx(hash []byte) bool {
 
 // SortedTxMap is a red black tree of tx.
 type SortedTxMap struct {
-	tree  *redblacktree.Tree
-	txMap map[string]*tx.Tx
-	rw    *sync.RWMutex
+	tree       *redblacktree.Tree
+	txMap      map[string]*tx.Tx
+	replaceMap map[string]*tx.Tx
+	pubCnt     map[string]int
+	rw         *sync.RWMutex
 }
 
+// compareTx orders txs by priority: higher gas price first, then earlier time, then hash.
 func compareTx(a, b interface{}) int {
 	txa := a.(*tx.Tx)
 	txb := b.(*tx.Tx)
-	if txa.GasPrice == txb.GasPrice {
-		return int(txb.Time - txa.Time)
+	switch {
+	case txa.GasPrice > txb.GasPrice:
+		return 1
+	case txa.GasPrice < txb.GasPrice:
+		return -1
+	case txa.Time < txb.Time:
+		return 1
+	case txa.Time > txb.Time:
+		return -1
+	}
+	return bytes.Compare(txb.Hash(), txa.Hash())
+}
+
+func publisherOf(t *tx.Tx) string {
+	if t.Publisher == nil {
+		return ""
+	}
+	return string(t.Publisher.Pubkey)
+}
+
+// replaceKey identifies the txs which can replace each other: same publisher, time and actions.
+func replaceKey(t *tx.Tx) string {
+	var buf bytes.Buffer
+	buf.WriteString(publisherOf(t))
+	buf.WriteString(strconv.FormatInt(t.Time, 10))
+	for _, a := range t.Actions {
+		buf.Write(a.Encode())
 	}
-	return int(txa.GasPrice - txb.GasPrice)
+	return string(common.Sha3(buf.Bytes()))
 }
 
 // NewSortedTxMap returns a new SortedTxMap instance.
 func NewSortedTxMap() *SortedTxMap {
 	return &SortedTxMap{
-		tree:  redblacktree.NewWith(compareTx),
-		txMap: make(map[string]*tx.Tx),
-		rw:    new(sync.RWMutex),
+		tree:       redblacktree.NewWith(compareTx),
+		txMap:      make(map[string]*tx.Tx),
+		replaceMap: make(map[string]*tx.Tx),
+		pubCnt:     make(map[string]int),
+		rw:         new(sync.RWMutex),
 	}
 }
 
@@ -165,12 +270,44 @@ func (st *SortedTxMap) Get(hash []byte) *tx.Tx {
 	return st.txMap[string(hash)]
 }
 
+// Lowest returns the tx with the lowest priority.
+func (st *SortedTxMap) Lowest() *tx.Tx {
+	st.rw.RLock()
+	defer st.rw.RUnlock()
+
+	node := st.tree.Left()
+	if node == nil {
+		return nil
+	}
+	return node.Key.(*tx.Tx)
+}
+
+// GetReplaceable returns the pending tx which t can replace.
+func (st *SortedTxMap) GetReplaceable(t *tx.Tx) *tx.Tx {
+	st.rw.RLock()
+	defer st.rw.RUnlock()
+	return st.replaceMap[replaceKey(t)]
+}
+
+// PublisherSize returns the number of pending txs of the publisher of t.
+func (st *SortedTxMap) PublisherSize(t *tx.Tx) int {
+	st.rw.RLock()
+	defer st.rw.RUnlock()
+	return st.pubCnt[publisherOf(t)]
+}
+
 // Add adds a tx in SortedTxMap.
 func (st *SortedTxMap) Add(tx *tx.Tx) {
 	st.rw.Lock()
+	defer st.rw.Unlock()
+
+	if _, ok := st.txMap[string(tx.Hash())]; ok {
+		return
+	}
 	st.tree.Put(tx, true)
 	st.txMap[string(tx.Hash())] = tx
-	st.rw.Unlock()
+	st.replaceMap[replaceKey(tx)] = tx
+	st.pubCnt[publisherOf(tx)]++
 }
 
 // Del deletes a tx in SortedTxMap.
@@ -184,6 +321,28 @@ func (st *SortedTxMap) Del(hash []byte) {
 	}
 	st.tree.Remove(tx)
 	delete(st.txMap, string(hash))
+	rk := replaceKey(tx)
+	if st.replaceMap[rk] == tx {
+		delete(st.replaceMap, rk)
+	}
+	pub := publisherOf(tx)
+	st.pubCnt[pub]--
+	if st.pubCnt[pub] <= 0 {
+		delete(st.pubCnt, pub)
+	}
+}
+
+// List returns all the txs in SortedTxMap, ordered by priority.
+func (st *SortedTxMap) List() []*tx.Tx {
+	st.rw.RLock()
+	defer st.rw.RUnlock()
+
+	keys := st.tree.Keys()
+	ret := make([]*tx.Tx, 0, len(keys))
+	for i := len(keys) - 1; i >= 0; i-- {
+		ret = append(ret, keys[i].(*tx.Tx))
+	}
+	return ret
 }
 
 // Size returns the size of SortedTxMap.
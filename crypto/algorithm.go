@@ -10,6 +10,8 @@ type AlgorithmBackend interface {
 	Verify(message []byte, pubkey []byte, sig []byte) bool
 	GetPubkey(seckey []byte) []byte
 	GenSeckey() []byte
+	VRFProve(input []byte, seckey []byte) (output []byte, proof []byte)
+	VRFVerify(input []byte, pubkey []byte, proof []byte) ([]byte, bool)
 }
 
 // Algorithm is the crypto algorithm of signature
@@ -22,6 +24,18 @@ const (
 	Ed25519
 )
 
+// NewAlgorithm returns the algorithm of the name, ok is false if the name is unknown
+func NewAlgorithm(name string) (a Algorithm, ok bool) {
+	switch name {
+	case "secp256k1":
+		return Secp256k1, true
+	case "ed25519":
+		return Ed25519, true
+	default:
+		return 0, false
+	}
+}
+
 func (a Algorithm) getBackend() AlgorithmBackend {
 	switch a {
 	case Secp256k1:
@@ -43,6 +57,17 @@ func (a Algorithm) Verify(message []byte, pubkey []byte, sig []byte) bool {
 	return a.getBackend().Verify(message, pubkey, sig)
 }
 
+// VRFProve returns the verifiable random output of the input and its proof with seckey,
+// the output is unique for the key pair and the input. Only ed25519 has the vrf, it's empty for the other algorithms.
+func (a Algorithm) VRFProve(input []byte, seckey []byte) (output []byte, proof []byte) {
+	return a.getBackend().VRFProve(input, seckey)
+}
+
+// VRFVerify verifies the proof of the input with pubkey, and returns the output
+func (a Algorithm) VRFVerify(input []byte, pubkey []byte, proof []byte) ([]byte, bool) {
+	return a.getBackend().VRFVerify(input, pubkey, proof)
+}
+
 // GetPubkey will get the public key of the secret key
 func (a Algorithm) GetPubkey(seckey []byte) []byte {
 	return a.getBackend().GetPubkey(seckey)
@@ -17,9 +17,25 @@ func (b *Ed25519) Sign(message []byte, seckey []byte) []byte {
 
 // Verify will verify the message with pubkey and sig by ed25519
 func (b *Ed25519) Verify(message []byte, pubkey []byte, sig []byte) bool {
+	if len(pubkey) != ed25519.PublicKeySize {
+		return false
+	}
 	return ed25519.Verify(pubkey, message, sig)
 }
 
+// VRFProve returns the vrf output and proof of the input by ed25519
+func (b *Ed25519) VRFProve(input []byte, seckey []byte) (output []byte, proof []byte) {
+	if len(seckey) != ed25519.PrivateKeySize {
+		return nil, nil
+	}
+	return ed25519VRFProve(seckey, input)
+}
+
+// VRFVerify verifies the vrf proof of the input with pubkey by ed25519, and returns the output
+func (b *Ed25519) VRFVerify(input []byte, pubkey []byte, proof []byte) ([]byte, bool) {
+	return ed25519VRFVerify(pubkey, input, proof)
+}
+
 // GetPubkey will get the public key of the secret key by ed25519
 func (b *Ed25519) GetPubkey(seckey []byte) []byte {
 	pubkey, ok := ed25519.PrivateKey(seckey).Public().(ed25519.PublicKey)
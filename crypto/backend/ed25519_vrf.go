@@ -0,0 +1,30 @@
+package backend
+
+import (
+	"github.com/oasisprotocol/curve25519-voi/primitives/ed25519"
+	"github.com/oasisprotocol/curve25519-voi/primitives/ed25519/extra/ecvrf"
+)
+
+// The vrf of ed25519 is the ECVRF-EDWARDS25519-SHA512-ELL2 of RFC 9381, it's computed in constant
+// time on the secret values. The proof is 80 bytes and the output is 64 bytes.
+
+// ed25519VRFProve returns the output and the proof of the input alpha with the ed25519 seckey,
+// which is the seed followed by the pubkey.
+func ed25519VRFProve(seckey, alpha []byte) (beta []byte, proof []byte) {
+	sk := ed25519.NewKeyFromSeed(seckey[:ed25519.SeedSize])
+	proof = ecvrf.Prove(sk, alpha)
+	beta, err := ecvrf.ProofToHash(proof)
+	if err != nil {
+		return nil, nil
+	}
+	return beta, proof
+}
+
+// ed25519VRFVerify verifies the proof of the input alpha with the pubkey, and returns the output.
+func ed25519VRFVerify(pubkey, alpha, proof []byte) ([]byte, bool) {
+	if len(pubkey) != ed25519.PublicKeySize {
+		return nil, false
+	}
+	ok, beta := ecvrf.Verify(ed25519.PublicKey(pubkey), proof, alpha)
+	return beta, ok
+}
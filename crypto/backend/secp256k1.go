@@ -25,6 +25,16 @@ func (b *Secp256k1) Verify(message []byte, pubkey []byte, sig []byte) bool {
 	return secp256k1.VerifySignature(pubkey, message, sig)
 }
 
+// VRFProve returns nothing, secp256k1 has no standardized vrf computed in constant time
+func (b *Secp256k1) VRFProve(input []byte, seckey []byte) (output []byte, proof []byte) {
+	return nil, nil
+}
+
+// VRFVerify verifies no proof, secp256k1 has no standardized vrf computed in constant time
+func (b *Secp256k1) VRFVerify(input []byte, pubkey []byte, proof []byte) ([]byte, bool) {
+	return nil, false
+}
+
 // GetPubkey will get the public key of the secret key by secp256k1
 func (b *Secp256k1) GetPubkey(seckey []byte) []byte {
 	x, y := secp256k1.S256().ScalarBaseMult(seckey)
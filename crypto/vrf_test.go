@@ -0,0 +1,108 @@
+package crypto
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+)
+
+func TestVRF(t *testing.T) {
+	for _, algo := range []Algorithm{Ed25519} {
+		seckey := algo.GenSeckey()
+		pubkey := algo.GetPubkey(seckey)
+		input := []byte("parent randomness")
+
+		output, proof := algo.VRFProve(input, seckey)
+		if len(output) == 0 || len(proof) == 0 {
+			t.Fatalf("%v: empty vrf output", algo)
+		}
+		o, p := algo.VRFProve(input, seckey)
+		if !bytes.Equal(o, output) || !bytes.Equal(p, proof) {
+			t.Errorf("%v: vrf isn't deterministic", algo)
+		}
+		if o, _ := algo.VRFProve([]byte("other"), seckey); bytes.Equal(o, output) {
+			t.Errorf("%v: outputs of different inputs are the same", algo)
+		}
+
+		verified, ok := algo.VRFVerify(input, pubkey, proof)
+		if !ok || !bytes.Equal(verified, output) {
+			t.Fatalf("%v: valid proof not verified", algo)
+		}
+		if _, ok := algo.VRFVerify([]byte("other"), pubkey, proof); ok {
+			t.Errorf("%v: proof verified with another input", algo)
+		}
+		if _, ok := algo.VRFVerify(input, algo.GetPubkey(algo.GenSeckey()), proof); ok {
+			t.Errorf("%v: proof verified with another pubkey", algo)
+		}
+		for i := range proof {
+			bad := append([]byte{}, proof...)
+			bad[i] ^= 1
+			if _, ok := algo.VRFVerify(input, pubkey, bad); ok {
+				t.Errorf("%v: proof with byte %v modified is verified", algo, i)
+			}
+		}
+		if _, ok := algo.VRFVerify(input, pubkey, proof[1:]); ok {
+			t.Errorf("%v: truncated proof is verified", algo)
+		}
+	}
+}
+
+func TestVRF_Secp256k1(t *testing.T) {
+	seckey := Secp256k1.GenSeckey()
+	output, proof := Secp256k1.VRFProve([]byte("parent randomness"), seckey)
+	if len(output) != 0 || len(proof) != 0 {
+		t.Fatal("secp256k1 shouldn't prove vrf")
+	}
+	if _, ok := Secp256k1.VRFVerify([]byte("parent randomness"), Secp256k1.GetPubkey(seckey), proof); ok {
+		t.Fatal("secp256k1 shouldn't verify vrf")
+	}
+}
+
+func TestVRF_Ed25519Vectors(t *testing.T) {
+	// the vectors of ECVRF-EDWARDS25519-SHA512-ELL2 in RFC 9381 appendix B.3
+	for i, v := range []struct {
+		seed, pubkey, alpha, proof, output string
+	}{
+		{
+			seed:   "9d61b19deffd5a60ba844af492ec2cc44449c5697b326919703bac031cae7f60",
+			pubkey: "d75a980182b10ab7d54bfed3c964073a0ee172f3daa62325af021a68f707511a",
+			alpha:  "",
+			proof:  "7d9c633ffeee27349264cf5c667579fc583b4bda63ab71d001f89c10003ab46f14adf9a3cd8b8412d9038531e865c341cafa73589b023d14311c331a9ad15ff2fb37831e00f0acaa6d73bc9997b06501",
+			output: "9d574bf9b8302ec0fc1e21c3ec5368269527b87b462ce36dab2d14ccf80c53cccf6758f058c5b1c856b116388152bbe509ee3b9ecfe63d93c3b4346c1fbc6c54",
+		},
+		{
+			seed:   "4ccd089b28ff96da9db6c346ec114e0f5b8a319f35aba624da8cf6ed4fb8a6fb",
+			pubkey: "3d4017c3e843895a92b70aa74d1b7ebc9c982ccf2ec4968cc0cd55f12af4660c",
+			alpha:  "72",
+			proof:  "47b327393ff2dd81336f8a2ef10339112401253b3c714eeda879f12c509072ef055b48372bb82efbdce8e10c8cb9a2f9d60e93908f93df1623ad78a86a028d6bc064dbfc75a6a57379ef855dc6733801",
+			output: "38561d6b77b71d30eb97a062168ae12b667ce5c28caccdf76bc88e093e4635987cd96814ce55b4689b3dd2947f80e59aac7b7675f8083865b46c89b2ce9cc735",
+		},
+		{
+			seed:   "c5aa8df43f9f837bedb7442f31dcb7b166d38535076f094b85ce3a2e0b4458f7",
+			pubkey: "fc51cd8e6218a1a38da47ed00230f0580816ed13ba3303ac5deb911548908025",
+			alpha:  "af82",
+			proof:  "926e895d308f5e328e7aa159c06eddbe56d06846abf5d98c2512235eaa57fdce35b46edfc655bc828d44ad09d1150f31374e7ef73027e14760d42e77341fe05467bb286cc2c9d7fde29120a0b2320d04",
+			output: "121b7f9b9aaaa29099fc04a94ba52784d44eac976dd1a3cca458733be5cd090a7b5fbd148444f17f8daf1fb55cb04b1ae85a626e30a54b4b0f8abf4a43314a58",
+		},
+	} {
+		unhex := func(s string) []byte {
+			b, err := hex.DecodeString(s)
+			if err != nil {
+				t.Fatal(err)
+			}
+			return b
+		}
+		pubkey, alpha := unhex(v.pubkey), unhex(v.alpha)
+		seckey := append(unhex(v.seed), pubkey...)
+		if !bytes.Equal(Ed25519.GetPubkey(seckey), pubkey) {
+			t.Fatalf("vector %v: wrong pubkey", i)
+		}
+		output, proof := Ed25519.VRFProve(alpha, seckey)
+		if !bytes.Equal(proof, unhex(v.proof)) || !bytes.Equal(output, unhex(v.output)) {
+			t.Errorf("vector %v: proof %x, output %x", i, proof, output)
+		}
+		if output, ok := Ed25519.VRFVerify(alpha, pubkey, unhex(v.proof)); !ok || !bytes.Equal(output, unhex(v.output)) {
+			t.Errorf("vector %v: proof isn't verified", i)
+		}
+	}
+}
@@ -0,0 +1,281 @@
+package db
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"sync"
+
+	"github.com/iost-official/go-iost/db/kv"
+	"github.com/iost-official/go-iost/db/mvcc"
+)
+
+// error of history
+var (
+	ErrStateNotKept = errors.New("state of the tag isn't kept")
+	ErrReadOnly     = errors.New("past state is read-only")
+)
+
+// HistoryDB is the mvccdb which keeps the flushed states of the latest tags.
+type HistoryDB interface {
+	KeepHistory(n int64)
+	StateAt(t string) (MVCCDB, error)
+}
+
+// The history is kept under the keys starting with the separator, which are never the keys of tables.
+// A flush records the values of the keys before it, so a past state is the current state with the values
+// recorded by the later flushes.
+var (
+	tagKey          = []byte(string(SEPARATOR) + "tag")
+	historyTagKey   = []byte(string(SEPARATOR) + "htag")                  // tag of the last flush recorded
+	historyFirstKey = []byte(string(SEPARATOR) + "hfirst")                // sequence of the first flush recorded
+	historyLastKey  = []byte(string(SEPARATOR) + "hlast")                 // sequence of the last flush recorded
+	historyPrefix   = []byte(string(SEPARATOR) + "h" + string(SEPARATOR)) // key, sequence -> value before the flush
+	changesPrefix   = []byte(string(SEPARATOR) + "c" + string(SEPARATOR)) // sequence -> tag, keys changed by the flush
+	sequencePrefix  = []byte(string(SEPARATOR) + "s" + string(SEPARATOR)) // tag -> sequence
+)
+
+var errStopRange = errors.New("stop range")
+
+func seqBytes(seq int64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, uint64(seq))
+	return b
+}
+
+func bytesSeq(b []byte) int64 {
+	if len(b) != 8 {
+		return 0
+	}
+	return int64(binary.BigEndian.Uint64(b))
+}
+
+func join(a []byte, b []byte) []byte {
+	return append(append(make([]byte, 0, len(a)+len(b)), a...), b...)
+}
+
+// KeepHistory makes the flushes keep the states of the latest n tags, no state is kept if n is 0.
+// It must be called before the mvccdb is forked.
+func (m *CacheMVCCDB) KeepHistory(n int64) {
+	m.keep = n
+}
+
+// recordHistory writes the values before the flush of the items into the batch, and prunes the flushes out of
+// the kept ones. It's called in the batch of the flush, before the items are written.
+func (m *CacheMVCCDB) recordHistory(t string, items []*Item) error {
+	last, err := m.storage.Get(historyTagKey)
+	if err != nil {
+		return err
+	}
+	prev, err := m.storage.Get(tagKey)
+	if err != nil {
+		return err
+	}
+	if m.keep <= 0 || !bytes.Equal(last, prev) {
+		// the history missing the flushes in between is dropped
+		if err := m.clearHistory(len(last) > 0); err != nil {
+			return err
+		}
+		if m.keep <= 0 {
+			return nil
+		}
+	}
+	seq := int64(1)
+	if len(last) > 0 && bytes.Equal(last, prev) {
+		b, err := m.storage.Get(historyLastKey)
+		if err != nil {
+			return err
+		}
+		seq = bytesSeq(b) + 1
+	}
+	changes := appendEntry(nil, []byte(t), nil)
+	for _, item := range items {
+		k := []byte(item.table + string(SEPARATOR) + item.key)
+		has, err := m.storage.Has(k)
+		if err != nil {
+			return err
+		}
+		v := []byte{0}
+		if has {
+			old, err := m.storage.Get(k)
+			if err != nil {
+				return err
+			}
+			v = join([]byte{1}, old)
+		}
+		if err := m.storage.Put(join(join(historyPrefix, k), seqBytes(seq)), v); err != nil {
+			return err
+		}
+		changes = appendEntry(changes, k, nil)
+	}
+	if err := m.storage.Put(join(changesPrefix, seqBytes(seq)), changes); err != nil {
+		return err
+	}
+	if err := m.storage.Put(join(sequencePrefix, []byte(t)), seqBytes(seq)); err != nil {
+		return err
+	}
+	first := seq
+	if seq > 1 {
+		b, err := m.storage.Get(historyFirstKey)
+		if err != nil {
+			return err
+		}
+		first = bytesSeq(b)
+	}
+	for ; first <= seq-m.keep; first++ {
+		if err := m.pruneHistory(first); err != nil {
+			return err
+		}
+	}
+	if err := m.storage.Put(historyFirstKey, seqBytes(first)); err != nil {
+		return err
+	}
+	if err := m.storage.Put(historyLastKey, seqBytes(seq)); err != nil {
+		return err
+	}
+	return m.storage.Put(historyTagKey, []byte(t))
+}
+
+func (m *CacheMVCCDB) pruneHistory(seq int64) error {
+	ck := join(changesPrefix, seqBytes(seq))
+	changes, err := m.storage.Get(ck)
+	if err != nil {
+		return err
+	}
+	keys, _, err := DecodeChunk(changes)
+	if err != nil {
+		return err
+	}
+	if len(keys) > 0 {
+		if err := m.storage.Delete(join(sequencePrefix, keys[0])); err != nil {
+			return err
+		}
+		for _, k := range keys[1:] {
+			if err := m.storage.Delete(join(join(historyPrefix, k), seqBytes(seq))); err != nil {
+				return err
+			}
+		}
+	}
+	return m.storage.Delete(ck)
+}
+
+func (m *CacheMVCCDB) clearHistory(exists bool) error {
+	if !exists {
+		return nil
+	}
+	for _, prefix := range [][]byte{historyPrefix, changesPrefix, sequencePrefix} {
+		keys, err := m.storage.Keys(prefix)
+		if err != nil {
+			return err
+		}
+		for _, k := range keys {
+			if err := m.storage.Delete(k); err != nil {
+				return err
+			}
+		}
+	}
+	for _, k := range [][]byte{historyTagKey, historyFirstKey, historyLastKey} {
+		if err := m.storage.Delete(k); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// StateAt returns the read-only mvccdb of the flushed state of the tag, which is the current flushed state or
+// one of the kept ones. It can be forked and written without flushing, and must be closed after use.
+func (m *CacheMVCCDB) StateAt(t string) (MVCCDB, error) {
+	m.flushmu.RLock()
+	defer m.flushmu.RUnlock()
+
+	cur, err := m.storage.Get(tagKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get from storage: %v", err)
+	}
+	seq := int64(math.MaxInt64)
+	if string(cur) != t {
+		last, err := m.storage.Get(historyTagKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get from storage: %v", err)
+		}
+		b, err := m.storage.Get(join(sequencePrefix, []byte(t)))
+		if err != nil {
+			return nil, fmt.Errorf("failed to get from storage: %v", err)
+		}
+		if !bytes.Equal(last, cur) || len(b) != 8 {
+			return nil, ErrStateNotKept
+		}
+		seq = bytesSeq(b)
+	}
+	view, err := m.storage.View()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get view of storage: %v", err)
+	}
+	head := NewCommit(mvcc.TrieCache)
+	cm := NewCommitManager()
+	cm.AddTag(head, t)
+	cm.Add(head)
+	return &CacheMVCCDB{
+		head:    head,
+		stage:   head.Fork(),
+		reader:  &pastState{view: view, seq: seq},
+		cm:      cm,
+		flushmu: new(sync.RWMutex),
+	}, nil
+}
+
+// pastState reads the flushed state after the flush of the sequence from a view of the storage.
+type pastState struct {
+	view kv.View
+	seq  int64
+}
+
+func (p *pastState) get(key []byte) ([]byte, bool, error) {
+	var value []byte
+	found := false
+	prefix := join(historyPrefix, key)
+	err := p.view.Range(prefix, func(k []byte, v []byte) error {
+		// the longer keys are the history of the keys prefixed with the key
+		if len(k) != len(prefix)+8 || bytesSeq(k[len(prefix):]) <= p.seq || len(v) == 0 {
+			return nil
+		}
+		found = v[0] == 1
+		value = append([]byte{}, v[1:]...)
+		return errStopRange
+	})
+	if err == errStopRange {
+		return value, found, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	err = p.view.Range(key, func(k []byte, v []byte) error {
+		// the key itself is the first one prefixed with it
+		if bytes.Equal(k, key) {
+			found = true
+			value = append([]byte{}, v...)
+		}
+		return errStopRange
+	})
+	if err != nil && err != errStopRange {
+		return nil, false, err
+	}
+	return value, found, nil
+}
+
+// Get returns the value of the key, it's empty if the key doesn't exist
+func (p *pastState) Get(key []byte) ([]byte, error) {
+	v, _, err := p.get(key)
+	if v == nil {
+		v = []byte{}
+	}
+	return v, err
+}
+
+// Has returns whether the key exists
+func (p *pastState) Has(key []byte) (bool, error) {
+	_, found, err := p.get(key)
+	return found, err
+}
@@ -0,0 +1,107 @@
+package db
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func flushBlock(t *testing.T, m *CacheMVCCDB, tag string, f func()) {
+	f()
+	m.Commit()
+	m.Tag(tag)
+	require.Nil(t, m.Flush(tag))
+}
+
+func TestCacheMVCCDB_StateAt(t *testing.T) {
+	defer os.RemoveAll("mvccdb_history")
+
+	m, err := NewCacheMVCCDB("mvccdb_history", 0)
+	require.Nil(t, err)
+	defer m.Close()
+	m.KeepHistory(2)
+
+	flushBlock(t, m, "block1", func() {
+		m.Put("state", "a", "a1")
+		m.Put("state", "ab", "ab1")
+	})
+	flushBlock(t, m, "block2", func() {
+		m.Put("state", "a", "a2")
+		m.Del("state", "ab")
+		m.Put("state", "c", "c2")
+	})
+	flushBlock(t, m, "block3", func() {
+		m.Put("state", "a", "a3")
+	})
+
+	// block1 is out of the kept states
+	_, err = m.StateAt("block1")
+	assert.Equal(t, ErrStateNotKept, err)
+
+	s, err := m.StateAt("block2")
+	require.Nil(t, err)
+	for k, v := range map[string]string{"a": "a2", "ab": "", "c": "c2"} {
+		value, err := s.Get("state", k)
+		require.Nil(t, err)
+		assert.Equal(t, v, value, k)
+	}
+	has, err := s.Has("state", "ab")
+	require.Nil(t, err)
+	assert.False(t, has)
+
+	// the view isn't changed by the later flushes, and the past state can be written without flushing
+	flushBlock(t, m, "block4", func() {
+		m.Put("state", "c", "c4")
+	})
+	value, err := s.Get("state", "c")
+	require.Nil(t, err)
+	assert.Equal(t, "c2", value)
+	fork := s.Fork()
+	fork.Put("state", "c", "changed")
+	fork.Commit()
+	fork.Tag("changed")
+	assert.Equal(t, ErrReadOnly, fork.Flush("changed"))
+	require.Nil(t, s.Close())
+
+	s, err = m.StateAt("block3")
+	require.Nil(t, err)
+	value, err = s.Get("state", "a")
+	require.Nil(t, err)
+	assert.Equal(t, "a3", value)
+	value, err = s.Get("state", "c")
+	require.Nil(t, err)
+	assert.Equal(t, "c2", value)
+	require.Nil(t, s.Close())
+
+	s, err = m.StateAt("block4")
+	require.Nil(t, err)
+	value, err = s.Get("state", "c")
+	require.Nil(t, err)
+	assert.Equal(t, "c4", value)
+	require.Nil(t, s.Close())
+
+	// the history isn't a part of the snapshot
+	snapshot, err := m.Snapshot()
+	require.Nil(t, err)
+	for _, c := range snapshot.Chunks {
+		keys, _, err := DecodeChunk(c)
+		require.Nil(t, err)
+		for _, k := range keys {
+			assert.NotEqual(t, byte(SEPARATOR), k[0], fmt.Sprintf("%s", k))
+		}
+	}
+
+	// the history is dropped once a flush isn't recorded
+	m.KeepHistory(0)
+	flushBlock(t, m, "block5", func() {
+		m.Put("state", "a", "a5")
+	})
+	_, err = m.StateAt("block4")
+	assert.Equal(t, ErrStateNotKept, err)
+	keys, err := m.storage.Keys(historyPrefix)
+	require.Nil(t, err)
+	assert.Empty(t, keys)
+}
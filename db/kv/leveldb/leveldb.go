@@ -97,6 +97,46 @@ func (d *DB) CommitBatch() error {
 	return nil
 }
 
+// RollbackBatch will drop the writes of the batch transaction
+func (d *DB) RollbackBatch() error {
+	if d.batch == nil {
+		return fmt.Errorf("no batch write to rollback")
+	}
+	d.batch = nil
+	return nil
+}
+
+// View is a read-only view of the database at the time it's taken
+type View struct {
+	snapshot *leveldb.Snapshot
+}
+
+// View returns the view of the database, the writes after it aren't seen in the view
+func (d *DB) View() (*View, error) {
+	snapshot, err := d.db.GetSnapshot()
+	if err != nil {
+		return nil, err
+	}
+	return &View{snapshot: snapshot}, nil
+}
+
+// Range calls f with the key-value pairs prefixed with prefix in the order of keys, it stops at the first error of f
+func (v *View) Range(prefix []byte, f func(key []byte, value []byte) error) error {
+	iter := v.snapshot.NewIterator(util.BytesPrefix(prefix), nil)
+	defer iter.Release()
+	for iter.Next() {
+		if err := f(iter.Key(), iter.Value()); err != nil {
+			return err
+		}
+	}
+	return iter.Error()
+}
+
+// Release releases the view
+func (v *View) Release() {
+	v.snapshot.Release()
+}
+
 // Close will close the database
 func (d *DB) Close() error {
 	return d.db.Close()
@@ -243,6 +243,82 @@ func (d *DB) CommitBatch() error {
 	return nil
 }
 
+// RollbackBatch will drop the writes of the batch transaction
+func (d *DB) RollbackBatch() error {
+	if d.cbatch == nil {
+		return fmt.Errorf("no batch write to rollback")
+	}
+	C.rocksdb_writebatch_destroy(d.cbatch)
+	d.cbatch = nil
+	return nil
+}
+
+// View is a read-only view of the database at the time it's taken
+type View struct {
+	cdb       *C.rocksdb_t
+	csnapshot *C.rocksdb_snapshot_t
+}
+
+// View returns the view of the database, the writes after it aren't seen in the view
+func (d *DB) View() (*View, error) {
+	return &View{
+		cdb:       d.cdb,
+		csnapshot: C.rocksdb_create_snapshot(d.cdb),
+	}, nil
+}
+
+// Range calls f with the key-value pairs prefixed with prefix in the order of keys, it stops at the first error of f
+func (v *View) Range(prefix []byte, f func(key []byte, value []byte) error) error {
+	var croptions *C.rocksdb_readoptions_t = C.rocksdb_readoptions_create()
+	defer C.rocksdb_readoptions_destroy(croptions)
+	C.rocksdb_readoptions_set_snapshot(croptions, v.csnapshot)
+
+	lower, upper := bytesPrefix(prefix)
+	if len(lower) != 0 {
+		var clower *C.char = C.CString(string(lower))
+		defer C.free(unsafe.Pointer(clower))
+		var clowerlen C.size_t = C.size_t(len(lower))
+		C.rocksdb_readoptions_set_iterate_lower_bound(croptions, clower, clowerlen)
+	}
+	if len(upper) != 0 {
+		var cupper *C.char = C.CString(string(upper))
+		defer C.free(unsafe.Pointer(cupper))
+		var cupperlen C.size_t = C.size_t(len(upper))
+		C.rocksdb_readoptions_set_iterate_upper_bound(croptions, cupper, cupperlen)
+	}
+
+	var iter *C.rocksdb_iterator_t = C.rocksdb_create_iterator(v.cdb, croptions)
+	defer C.rocksdb_iter_destroy(iter)
+
+	for C.rocksdb_iter_seek_to_first(iter); C.rocksdb_iter_valid(iter) != 0; C.rocksdb_iter_next(iter) {
+		var ckeylen, cvaluelen C.size_t
+		// the key and the value are owned by the iterator
+		var ckey *C.char = C.rocksdb_iter_key(iter, &ckeylen)
+		var cvalue *C.char = C.rocksdb_iter_value(iter, &cvaluelen)
+		key := C.GoBytes(unsafe.Pointer(ckey), C.int(ckeylen))
+		value := C.GoBytes(unsafe.Pointer(cvalue), C.int(cvaluelen))
+		if err := f(key, value); err != nil {
+			return err
+		}
+	}
+
+	var cerr *C.char
+	defer C.free(unsafe.Pointer(cerr))
+	C.rocksdb_iter_get_error(iter, &cerr)
+
+	err := C.GoString(cerr)
+
+	if err != "" {
+		return fmt.Errorf("failed to range by rocksdb: %v", err)
+	}
+	return nil
+}
+
+// Release releases the view
+func (v *View) Release() {
+	C.rocksdb_release_snapshot(v.cdb, v.csnapshot)
+}
+
 // Close will close the database
 func (d *DB) Close() error {
 	C.rocksdb_close(d.cdb)
@@ -1,6 +1,8 @@
 package kv
 
 import (
+	"fmt"
+
 	"github.com/iost-official/go-iost/db/kv/leveldb"
 	"github.com/iost-official/go-iost/db/kv/rocksdb"
 )
@@ -24,9 +26,16 @@ type StorageBackend interface {
 	Keys(prefix []byte) ([][]byte, error)
 	BeginBatch() error
 	CommitBatch() error
+	RollbackBatch() error
 	Close() error
 }
 
+// View is a read-only view of the storage at the time it's taken
+type View interface {
+	Range(prefix []byte, f func(key []byte, value []byte) error) error
+	Release()
+}
+
 // Storage is a kv database
 type Storage struct {
 	StorageBackend
@@ -55,3 +64,23 @@ func NewStorage(path string, t StorageType) (*Storage, error) {
 		return &Storage{StorageBackend: sb}, nil
 	}
 }
+
+// View returns the view of the storage, the writes after it aren't seen in the view
+func (s *Storage) View() (View, error) {
+	switch sb := s.StorageBackend.(type) {
+	case *leveldb.DB:
+		v, err := sb.View()
+		if err != nil {
+			return nil, err
+		}
+		return v, nil
+	case *rocksdb.DB:
+		v, err := sb.View()
+		if err != nil {
+			return nil, err
+		}
+		return v, nil
+	default:
+		return nil, fmt.Errorf("storage %T doesn't support view", sb)
+	}
+}
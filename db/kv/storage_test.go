@@ -161,6 +161,54 @@ func (suite *StorageTestSuite) TestBatch() {
 	suite.Equal([]byte("value06"), value)
 }
 
+func (suite *StorageTestSuite) TestRollbackBatch() {
+	err := suite.storage.RollbackBatch()
+	suite.NotNil(err)
+
+	err = suite.storage.BeginBatch()
+	suite.Nil(err)
+	err = suite.storage.Delete([]byte("key04"))
+	suite.Nil(err)
+	err = suite.storage.Put([]byte("key06"), []byte("value06"))
+	suite.Nil(err)
+	err = suite.storage.RollbackBatch()
+	suite.Nil(err)
+
+	value, err := suite.storage.Get([]byte("key04"))
+	suite.Nil(err)
+	suite.Equal([]byte("value04"), value)
+	value, err = suite.storage.Get([]byte("key06"))
+	suite.Nil(err)
+	suite.Equal([]byte{}, value)
+
+	err = suite.storage.BeginBatch()
+	suite.Nil(err)
+	err = suite.storage.CommitBatch()
+	suite.Nil(err)
+}
+
+func (suite *StorageTestSuite) TestView() {
+	view, err := suite.storage.View()
+	suite.Require().Nil(err)
+	defer view.Release()
+
+	err = suite.storage.Delete([]byte("key04"))
+	suite.Nil(err)
+	err = suite.storage.Put([]byte("key06"), []byte("value06"))
+	suite.Nil(err)
+
+	keys := make([]string, 0)
+	values := make([]string, 0)
+	err = view.Range([]byte("key"), func(key []byte, value []byte) error {
+		keys = append(keys, string(key))
+		values = append(values, string(value))
+		return nil
+	})
+	suite.Nil(err)
+	suite.Equal([]string{"key01", "key02", "key03", "key04", "key05"}, keys)
+	suite.Equal([]string{"value01", "value02", "value03", "value04", "value05"}, values)
+}
+
 func (suite *StorageTestSuite) TestRecover() {
 	var value []byte
 	var err error
@@ -148,7 +148,16 @@ type CacheMVCCDB struct {
 	head    *Commit
 	stage   *Commit
 	storage *kv.Storage
+	reader  stateReader
 	cm      *CommitManager
+	flushmu *sync.RWMutex
+	keep    int64
+}
+
+// stateReader reads the flushed state, which is the storage or a past state
+type stateReader interface {
+	Get(key []byte) ([]byte, error)
+	Has(key []byte) (bool, error)
 }
 
 // NewCacheMVCCDB returns new CacheMVCCDB
@@ -171,7 +180,9 @@ func NewCacheMVCCDB(path string, cacheType mvcc.CacheType) (*CacheMVCCDB, error)
 		head:    head,
 		stage:   stage,
 		storage: storage,
+		reader:  storage,
 		cm:      cm,
+		flushmu: new(sync.RWMutex),
 	}
 	return mvccdb, nil
 }
@@ -196,7 +207,7 @@ func (m *CacheMVCCDB) Get(table string, key string) (string, error) {
 	k := []byte(table + string(SEPARATOR) + key)
 	v := m.stage.Get(k)
 	if v == nil {
-		v, err := m.storage.Get(k)
+		v, err := m.reader.Get(k)
 		if err != nil {
 			return "", fmt.Errorf("failed to get from storage: %v", err)
 		}
@@ -252,7 +263,7 @@ func (m *CacheMVCCDB) Has(table string, key string) (bool, error) {
 	k := []byte(table + string(SEPARATOR) + key)
 	v := m.stage.Get(k)
 	if v == nil {
-		return m.storage.Has(k)
+		return m.reader.Has(k)
 	}
 	i, ok := v.(*Item)
 	if !ok {
@@ -329,7 +340,10 @@ func (m *CacheMVCCDB) Fork() MVCCDB {
 		head:    m.head,
 		stage:   m.head.Fork(),
 		storage: m.storage,
+		reader:  m.reader,
 		cm:      m.cm,
+		flushmu: m.flushmu,
+		keep:    m.keep,
 	}
 	return mvccdb
 }
@@ -340,18 +354,31 @@ func (m *CacheMVCCDB) Flush(t string) error {
 	if commit == nil {
 		return fmt.Errorf("not found tag: %v", t)
 	}
-	if err := m.storage.BeginBatch(); err != nil {
-		return err
-	}
-	err := m.storage.Put([]byte(string(SEPARATOR)+"tag"), []byte(t))
-	if err != nil {
-		return err
+	if _, ok := m.reader.(*pastState); ok {
+		return ErrReadOnly
 	}
+	items := make([]*Item, 0)
 	for _, v := range commit.All([]byte("")) {
 		item, ok := v.(*Item)
 		if !ok {
 			return fmt.Errorf("can't assert Item type")
 		}
+		items = append(items, item)
+	}
+	m.flushmu.Lock()
+	defer m.flushmu.Unlock()
+	if err := m.storage.BeginBatch(); err != nil {
+		return err
+	}
+	if err := m.recordHistory(t, items); err != nil {
+		m.storage.RollbackBatch()
+		return err
+	}
+	err := m.storage.Put(tagKey, []byte(t))
+	if err != nil {
+		return err
+	}
+	for _, item := range items {
 		if item.deleted {
 			err := m.storage.Delete([]byte(item.table + string(SEPARATOR) + item.key))
 			if err != nil {
@@ -371,7 +398,11 @@ func (m *CacheMVCCDB) Flush(t string) error {
 	return nil
 }
 
-// Close will close the mvccdb
+// Close will close the mvccdb, or release the view of a past state
 func (m *CacheMVCCDB) Close() error {
+	if p, ok := m.reader.(*pastState); ok {
+		p.view.Release()
+		return nil
+	}
 	return m.storage.Close()
 }
@@ -0,0 +1,204 @@
+package db
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/iost-official/go-iost/common"
+	"github.com/iost-official/go-iost/db/kv"
+	"github.com/iost-official/go-iost/ilog"
+)
+
+// SnapshotChunkSize is the max size of a snapshot chunk, unless a single key-value pair is larger
+var SnapshotChunkSize = 1 << 20
+
+// error of snapshot
+var (
+	ErrInvalidChunk = errors.New("invalid snapshot chunk")
+	ErrNotEmpty     = errors.New("restore snapshot into non-empty db")
+)
+
+// Snapshotter is the mvccdb which can dump and restore its flushed state
+type Snapshotter interface {
+	View() (*StateView, error)
+	Restore(s *Snapshot) error
+}
+
+// Snapshot is the flushed state of mvccdb with its tag. The key-value pairs are split into chunks
+// in the order of keys, so the snapshots of the same state are the same.
+type Snapshot struct {
+	Tag    string
+	Chunks [][]byte
+}
+
+// StateView is the flushed state of mvccdb at the time the view is taken, the later flushes don't change it.
+type StateView struct {
+	Tag  string
+	view kv.View
+}
+
+// Chunks splits the key-value pairs into the chunks of the snapshot, and calls f with each chunk in order.
+func (v *StateView) Chunks(f func(chunk []byte) error) error {
+	chunk := make([]byte, 0)
+	err := v.view.Range([]byte(""), func(k []byte, val []byte) error {
+		// the tag and the history of the node aren't a part of the state
+		if len(k) > 0 && k[0] == SEPARATOR {
+			return nil
+		}
+		if len(chunk) > 0 && len(chunk)+len(k)+len(val)+2*binary.MaxVarintLen64 > SnapshotChunkSize {
+			if err := f(chunk); err != nil {
+				return err
+			}
+			chunk = make([]byte, 0)
+		}
+		chunk = appendEntry(chunk, k, val)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if len(chunk) > 0 {
+		return f(chunk)
+	}
+	return nil
+}
+
+// Release releases the view
+func (v *StateView) Release() {
+	v.view.Release()
+}
+
+// Hashes returns the hashes of the chunks
+func (s *Snapshot) Hashes() [][]byte {
+	hashes := make([][]byte, len(s.Chunks))
+	for i, c := range s.Chunks {
+		hashes[i] = common.Sha3(c)
+	}
+	return hashes
+}
+
+// SnapshotRoot returns the hash of the chunk hashes, which identifies the content of a snapshot
+func SnapshotRoot(hashes [][]byte) []byte {
+	return common.Sha3(bytes.Join(hashes, nil))
+}
+
+func appendEntry(chunk []byte, k []byte, v []byte) []byte {
+	var buf [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(buf[:], uint64(len(k)))
+	chunk = append(chunk, buf[:n]...)
+	chunk = append(chunk, k...)
+	n = binary.PutUvarint(buf[:], uint64(len(v)))
+	chunk = append(chunk, buf[:n]...)
+	return append(chunk, v...)
+}
+
+func nextField(chunk []byte) ([]byte, []byte, error) {
+	l, n := binary.Uvarint(chunk)
+	if n <= 0 || uint64(len(chunk)-n) < l {
+		return nil, nil, ErrInvalidChunk
+	}
+	return chunk[n : n+int(l)], chunk[n+int(l):], nil
+}
+
+// DecodeChunk returns the keys and the values in the chunk
+func DecodeChunk(chunk []byte) ([][]byte, [][]byte, error) {
+	keys := make([][]byte, 0)
+	values := make([][]byte, 0)
+	for len(chunk) > 0 {
+		k, rest, err := nextField(chunk)
+		if err != nil {
+			return nil, nil, err
+		}
+		v, rest, err := nextField(rest)
+		if err != nil {
+			return nil, nil, err
+		}
+		keys = append(keys, k)
+		values = append(values, v)
+		chunk = rest
+	}
+	return keys, values, nil
+}
+
+// View returns the view of the flushed state of mvccdb, it only blocks Flush while the view is taken.
+func (m *CacheMVCCDB) View() (*StateView, error) {
+	m.flushmu.RLock()
+	defer m.flushmu.RUnlock()
+
+	tag, err := m.storage.Get([]byte(string(SEPARATOR) + "tag"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get from storage: %v", err)
+	}
+	view, err := m.storage.View()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get view of storage: %v", err)
+	}
+	return &StateView{Tag: string(tag), view: view}, nil
+}
+
+// Snapshot returns the flushed state of mvccdb in memory.
+func (m *CacheMVCCDB) Snapshot() (*Snapshot, error) {
+	v, err := m.View()
+	if err != nil {
+		return nil, err
+	}
+	defer v.Release()
+	s := &Snapshot{
+		Tag:    v.Tag,
+		Chunks: make([][]byte, 0),
+	}
+	err = v.Chunks(func(chunk []byte) error {
+		s.Chunks = append(s.Chunks, chunk)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Restore writes the snapshot into the empty mvccdb and tags the current state with the tag of the snapshot,
+// nothing is written if it fails
+func (m *CacheMVCCDB) Restore(s *Snapshot) error {
+	m.flushmu.Lock()
+	defer m.flushmu.Unlock()
+
+	keys, err := m.storage.Keys([]byte(""))
+	if err != nil {
+		return fmt.Errorf("failed to get keys from storage: %v", err)
+	}
+	if len(keys) > 0 {
+		return ErrNotEmpty
+	}
+	if err := m.storage.BeginBatch(); err != nil {
+		return err
+	}
+	if err := m.restore(s); err != nil {
+		if rerr := m.storage.RollbackBatch(); rerr != nil {
+			ilog.Errorf("rollback restore failed. err=%v", rerr)
+		}
+		return err
+	}
+	m.cm.AddTag(m.head, s.Tag)
+	return nil
+}
+
+func (m *CacheMVCCDB) restore(s *Snapshot) error {
+	for _, c := range s.Chunks {
+		ks, vs, err := DecodeChunk(c)
+		if err != nil {
+			return err
+		}
+		for i := range ks {
+			if err := m.storage.Put(ks[i], vs[i]); err != nil {
+				return err
+			}
+		}
+	}
+	if err := m.storage.Put([]byte(string(SEPARATOR)+"tag"), []byte(s.Tag)); err != nil {
+		return err
+	}
+	return m.storage.CommitBatch()
+}
@@ -0,0 +1,85 @@
+package db
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/iost-official/go-iost/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCacheMVCCDB_Snapshot(t *testing.T) {
+	defer func(size int) {
+		SnapshotChunkSize = size
+		os.RemoveAll("mvccdb_src")
+		os.RemoveAll("mvccdb_dst")
+		os.RemoveAll("mvccdb_bad")
+	}(SnapshotChunkSize)
+	SnapshotChunkSize = 100
+
+	src, err := NewCacheMVCCDB("mvccdb_src", 0)
+	require.Nil(t, err)
+	defer src.Close()
+	for i := 0; i < 50; i++ {
+		src.Put("state", fmt.Sprintf("key%02d", i), fmt.Sprintf("value%02d", i))
+	}
+	src.Del("state", "key07")
+	src.Commit()
+	src.Tag("block1")
+	require.Nil(t, src.Flush("block1"))
+	src.Put("state", "key08", "unflushed")
+	src.Commit()
+	src.Tag("block2")
+
+	s, err := src.Snapshot()
+	require.Nil(t, err)
+	assert.Equal(t, "block1", s.Tag)
+	assert.True(t, len(s.Chunks) > 1)
+	s2, err := src.Snapshot()
+	require.Nil(t, err)
+	assert.Equal(t, SnapshotRoot(s.Hashes()), SnapshotRoot(s2.Hashes()))
+
+	// the view isn't changed by the later flushes
+	v, err := src.View()
+	require.Nil(t, err)
+	require.Nil(t, src.Flush("block2"))
+	hashes := make([][]byte, 0)
+	require.Nil(t, v.Chunks(func(chunk []byte) error {
+		hashes = append(hashes, common.Sha3(chunk))
+		return nil
+	}))
+	v.Release()
+	assert.Equal(t, "block1", v.Tag)
+	assert.Equal(t, SnapshotRoot(s.Hashes()), SnapshotRoot(hashes))
+
+	// nothing is written by a failed restore, so it can be tried again
+	bad, err := NewCacheMVCCDB("mvccdb_bad", 0)
+	require.Nil(t, err)
+	defer bad.Close()
+	chunks := append([][]byte{}, s.Chunks...)
+	chunks[len(chunks)-1] = chunks[len(chunks)-1][:len(chunks[len(chunks)-1])-1]
+	assert.Equal(t, ErrInvalidChunk, bad.Restore(&Snapshot{Tag: s.Tag, Chunks: chunks}))
+	require.Nil(t, bad.Restore(s))
+
+	dst, err := NewCacheMVCCDB("mvccdb_dst", 0)
+	require.Nil(t, err)
+	defer dst.Close()
+	require.Nil(t, dst.Restore(s))
+	assert.Equal(t, ErrNotEmpty, dst.Restore(s))
+	assert.Equal(t, "block1", dst.CurrentTag())
+	require.True(t, dst.Checkout("block1"))
+	for i := 0; i < 50; i++ {
+		v, err := dst.Get("state", fmt.Sprintf("key%02d", i))
+		require.Nil(t, err)
+		if i == 7 {
+			assert.Equal(t, "", v)
+		} else {
+			assert.Equal(t, fmt.Sprintf("value%02d", i), v)
+		}
+	}
+
+	_, _, err = DecodeChunk(s.Chunks[0][:len(s.Chunks[0])-1])
+	assert.Equal(t, ErrInvalidChunk, err)
+}
@@ -0,0 +1,236 @@
+// Copyright © 2018 NAME HERE <EMAIL ADDRESS>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package iwallet
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/iost-official/go-iost/vm/testkit"
+	"github.com/spf13/cobra"
+)
+
+var contractCmd = &cobra.Command{
+	Use:   "contract",
+	Short: "Develop contracts",
+	Long:  `Develop contracts`,
+}
+
+var testRun string
+
+var contractTestCmd = &cobra.Command{
+	Use:   "test [files or dirs]",
+	Short: "Run the tests of contracts in memory",
+	Long: `Run the tests of contracts in memory, without a node
+	A .js test file registers the tests by test(name, fn, options), node runs them and each test runs on a new chain.
+	A .json test file is a list of steps of each test. The files ending with .test.js and .test.json are run in the dirs.
+	example:iwallet contract test ./token.test.js
+	const assert = require("assert");
+	test("transfer", function (kit) {
+		const alice = kit.newAccount("alice", 1000000), bob = kit.newAccount("bob", 1000000);
+		const id = kit.deploy("./token.js", "alice");   // the path is relative to the test file, the abi is token.js.abi
+		const r = kit.call(id, "transfer", [alice, bob, 10], "alice");
+		assert.ok(r.succeeded, r.message);              // r has return, gas, receipts and events too
+		kit.advance(1, 3);                              // 1 block and 3 seconds later
+		assert.strictEqual(kit.mapGet(id, "balances", bob), 10);
+		assert.ok(kit.balance("alice") < 1000000);      // alice paid the gas
+	}, {forks: {BeaconHeight: -1}});                    // all the forks take effect unless the heights are given
+	example:iwallet contract test ./token.test.json
+	{
+		"contract": "./token.js",
+		"accounts": {"alice": 1000000, "bob": 1000000},
+		"tests": [{
+			"name": "transfer",
+			"steps": [
+				{"call": "transfer", "args": ["$alice", "$bob", 10], "signers": ["alice"], "expect": {"return": [], "max_gas": 10000}},
+				{"advance": {"blocks": 1, "seconds": 3}},
+				{"storage": {"total": 100}, "map": {"balances": {"$bob": 10}}, "balance": {"alice": 900000}}
+			]
+		}]
+	}
+	"$contract" is the contract id and "$<name>" is the id of the account, the deployer account is created if it isn't in the accounts.
+	`,
+	Args: cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		files, err := testFiles(args)
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+		failed := 0
+		for _, f := range files {
+			failed += runTestFile(f)
+		}
+		if failed > 0 {
+			fmt.Printf("FAIL\t%v failed\n", failed)
+			os.Exit(1)
+		}
+		fmt.Println("PASS")
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(contractCmd)
+	contractCmd.AddCommand(contractTestCmd)
+
+	contractTestCmd.Flags().StringVarP(&testRun, "run", "", "", "run only the tests whose names contain the string")
+}
+
+func isTestFile(path string) bool {
+	return strings.HasSuffix(path, ".test.js") || strings.HasSuffix(path, ".test.json")
+}
+
+// testFiles returns the files of args, and the test files in the dirs of args.
+func testFiles(args []string) ([]string, error) {
+	files := make([]string, 0)
+	for _, arg := range args {
+		fi, err := os.Stat(arg)
+		if err != nil {
+			return nil, err
+		}
+		if !fi.IsDir() {
+			files = append(files, arg)
+			continue
+		}
+		err = filepath.Walk(arg, func(path string, info os.FileInfo, err error) error {
+			if err == nil && !info.IsDir() && isTestFile(path) {
+				files = append(files, path)
+			}
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return files, nil
+}
+
+// loadScenario reads the json test file.
+func loadScenario(path string) (*testkit.Scenario, error) {
+	data, err := readFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return testkit.ParseScenario(data)
+}
+
+// loadContract reads the code of the contract and its abi, which is at the path of the code with .abi if abiPath
+// is empty. The abi is generated if it doesn't exist.
+func loadContract(codePath, abiPath string) (string, string, error) {
+	code, err := readFile(codePath)
+	if err != nil {
+		return "", "", err
+	}
+	if abiPath == "" {
+		abiPath = codePath + ".abi"
+		if _, err := os.Stat(abiPath); os.IsNotExist(err) {
+			if abiPath = generateABI(codePath); abiPath == "" {
+				return "", "", fmt.Errorf("failed to gen abi of %v", codePath)
+			}
+		}
+	}
+	abi, err := readFile(abiPath)
+	if err != nil {
+		return "", "", err
+	}
+	return string(code), string(abi), nil
+}
+
+// loadScenarioContract reads the code and the abi of the scenario, the paths are relative to the test file.
+func loadScenarioContract(s *testkit.Scenario, path string) (string, string, error) {
+	if s.Contract == "" {
+		return "", "", fmt.Errorf("contract not given")
+	}
+	dir := filepath.Dir(path)
+	abiPath := ""
+	if s.ABI != "" {
+		abiPath = filepath.Join(dir, s.ABI)
+	}
+	return loadContract(filepath.Join(dir, s.Contract), abiPath)
+}
+
+func printResult(r *testkit.TestResult) bool {
+	if r.Err != nil {
+		fmt.Printf("--- FAIL: %v (gas %v)\n\t%v\n", r.Name, r.Gas, r.Err)
+		return false
+	}
+	fmt.Printf("--- PASS: %v (gas %v)\n", r.Name, r.Gas)
+	return true
+}
+
+// runTestFile runs the tests in the file, and returns the number of the failed ones.
+func runTestFile(path string) int {
+	var failed int
+	var err error
+	if strings.HasSuffix(path, ".js") {
+		failed, err = runJSTestFile(path)
+	} else {
+		failed, err = runJSONTestFile(path)
+	}
+	if err != nil {
+		fmt.Printf("--- FAIL: %v\n\t%v\n", path, err)
+		failed++
+	}
+	if failed > 0 {
+		fmt.Printf("FAIL\t%v\n", path)
+	} else {
+		fmt.Printf("ok\t%v\n", path)
+	}
+	return failed
+}
+
+// runJSTestFile runs the js tests in the file by node.
+func runJSTestFile(path string) (int, error) {
+	failed := 0
+	s := &testkit.Server{
+		Load: loadContract,
+		Report: func(r *testkit.TestResult) {
+			if !printResult(r) {
+				failed++
+			}
+		},
+	}
+	err := testkit.RunJS(path, testRun, s, os.Stdout, os.Stderr)
+	return failed, err
+}
+
+// runJSONTestFile runs the steps of the tests in the json file.
+func runJSONTestFile(path string) (int, error) {
+	s, err := loadScenario(path)
+	if err != nil {
+		return 0, err
+	}
+	code, abi, err := loadScenarioContract(s, path)
+	if err != nil {
+		return 0, err
+	}
+	tests := make([]*testkit.Test, 0, len(s.Tests))
+	for _, t := range s.Tests {
+		if strings.Contains(t.Name, testRun) {
+			tests = append(tests, t)
+		}
+	}
+	s.Tests = tests
+
+	failed := 0
+	for _, r := range testkit.Run(s, code, abi) {
+		if !printResult(r) {
+			failed++
+		}
+	}
+	return failed, nil
+}
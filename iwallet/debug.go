@@ -0,0 +1,78 @@
+package iwallet
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+
+	"github.com/spf13/cobra"
+)
+
+var debugServer string
+var debugFormat string
+
+// debugCmd represents the debug command
+var debugCmd = &cobra.Command{
+	Use:   "debug",
+	Short: "Dump the block cache or the blockchain from the debug server of a node",
+	Long:  `Dump the block cache or the blockchain from the debug server of a node, in json, dot or text format`,
+}
+
+var debugBlockCacheCmd = &cobra.Command{
+	Use:   "blockcache",
+	Short: "Dump the fork tree of the block cache",
+	Long:  `Dump the fork tree of the block cache`,
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		b, err := getDebugInfo("/debug/blockcache/", nil)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(string(b))
+	},
+}
+
+var debugBlockChainCmd = &cobra.Command{
+	Use:   "blockchain start end",
+	Short: "Dump the blocks of the blockchain in [start, end]",
+	Long:  `Dump the blocks of the blockchain in [start, end]`,
+	Args:  cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		b, err := getDebugInfo("/debug/blockchain/", url.Values{"range": {args[0] + "-" + args[1]}})
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(string(b))
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(debugCmd)
+	debugCmd.AddCommand(debugBlockCacheCmd)
+	debugCmd.AddCommand(debugBlockChainCmd)
+
+	debugCmd.PersistentFlags().StringVarP(&debugServer, "debug-server", "", "localhost:30003", "Set debug server of the node")
+	debugCmd.PersistentFlags().StringVarP(&debugFormat, "format", "f", "json", "Set output format: json, dot or text")
+}
+
+func getDebugInfo(path string, query url.Values) ([]byte, error) {
+	if query == nil {
+		query = url.Values{}
+	}
+	if debugFormat != "text" {
+		query.Set("format", debugFormat)
+	}
+	u := url.URL{Scheme: "http", Host: debugServer, Path: path, RawQuery: query.Encode()}
+	resp, err := http.Get(u.String())
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("debug server returns %v", resp.Status)
+	}
+	return ioutil.ReadAll(resp.Body)
+}
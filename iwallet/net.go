@@ -16,7 +16,11 @@ package iwallet
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"os"
+	"time"
+
 	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/iost-official/go-iost/rpc"
 	"github.com/spf13/cobra"
@@ -39,8 +43,46 @@ var netCmd = &cobra.Command{
 	},
 }
 
+var syncWait bool
+var syncTimeout time.Duration
+
+var netSyncCmd = &cobra.Command{
+	Use:   "sync",
+	Short: "Get the progress of syncing blocks",
+	Long:  `Get the progress of syncing blocks, or wait until the node catches up with its peers`,
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		var deadline <-chan time.Time
+		if syncTimeout > 0 {
+			deadline = time.After(syncTimeout)
+		}
+		for {
+			status, err := GetSyncStatus()
+			if err != nil {
+				fmt.Println(err)
+				os.Exit(1)
+			}
+			b, _ := json.Marshal(status)
+			fmt.Println(string(b))
+			if !syncWait || status.Done {
+				return
+			}
+			select {
+			case <-time.After(3 * time.Second):
+			case <-deadline:
+				fmt.Println("timeout waiting for sync")
+				os.Exit(1)
+			}
+		}
+	},
+}
+
 func init() {
 	rootCmd.AddCommand(netCmd)
+	netCmd.AddCommand(netSyncCmd)
+
+	netSyncCmd.Flags().BoolVarP(&syncWait, "wait", "w", false, "wait until the node catches up with its peers")
+	netSyncCmd.Flags().DurationVarP(&syncTimeout, "timeout", "", 0, "exit with 1 if the node doesn't catch up in time when waiting, 0 means no limit")
 
 	// Here you will define your flags and configuration settings.
 
@@ -67,3 +109,14 @@ func GetNetID() (string, error) {
 
 	return value.ID, nil
 }
+
+// GetSyncStatus gets the progress of syncing blocks from the node.
+func GetSyncStatus() (*rpc.SyncStatusRes, error) {
+	conn, err := grpc.Dial(server, grpc.WithInsecure())
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	client := rpc.NewApisClient(conn)
+	return client.GetSyncStatus(context.Background(), &empty.Empty{})
+}
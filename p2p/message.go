@@ -52,6 +52,13 @@ const (
 	SyncBlockResponse
 	SyncHeight
 	PublishTxRequest
+	DoubleSignEvidence
+	SnapshotInfoRequest
+	SnapshotInfoResponse
+	SnapshotChunkRequest
+	SnapshotChunkResponse
+	SyncBlockHeaderRequest
+	SyncBlockHeaderResponse
 
 	UrgentMessage = 1
 	NormalMessage = 2
@@ -81,6 +88,20 @@ func (m MessageType) String() string {
 		return "NewBlockHash"
 	case NewBlockRequest:
 		return "NewBlockRequest"
+	case DoubleSignEvidence:
+		return "DoubleSignEvidence"
+	case SnapshotInfoRequest:
+		return "SnapshotInfoRequest"
+	case SnapshotInfoResponse:
+		return "SnapshotInfoResponse"
+	case SnapshotChunkRequest:
+		return "SnapshotChunkRequest"
+	case SnapshotChunkResponse:
+		return "SnapshotChunkResponse"
+	case SyncBlockHeaderRequest:
+		return "SyncBlockHeaderRequest"
+	case SyncBlockHeaderResponse:
+		return "SyncBlockHeaderResponse"
 	default:
 		return "unknown_type:" + strconv.Itoa(int(m))
 	}
@@ -154,7 +175,8 @@ func (m *p2pMessage) data() ([]byte, error) {
 
 func (m *p2pMessage) needDedup() bool {
 	return m.messageType() == NewBlock ||
-		m.messageType() == PublishTxRequest || m.messageType() == NewBlockHash
+		m.messageType() == PublishTxRequest || m.messageType() == NewBlockHash ||
+		m.messageType() == DoubleSignEvidence
 }
 
 func newP2PMessage(chainID uint32, messageType MessageType, version uint16, reserved uint32, data []byte) *p2pMessage {
@@ -1,9 +1,13 @@
 package rpc
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"net"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -12,6 +16,9 @@ import (
 
 	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/iost-official/go-iost/common"
+	"github.com/iost-official/go-iost/consensus"
+	"github.com/iost-official/go-iost/consensus/synchronizer"
+	"github.com/iost-official/go-iost/consensus/verifier"
 	"github.com/iost-official/go-iost/core/block"
 	"github.com/iost-official/go-iost/core/blockcache"
 	"github.com/iost-official/go-iost/core/event"
@@ -21,6 +28,7 @@ import (
 	"github.com/iost-official/go-iost/db"
 	"github.com/iost-official/go-iost/ilog"
 	"github.com/iost-official/go-iost/p2p"
+	"github.com/iost-official/go-iost/vm"
 	"github.com/iost-official/go-iost/vm/database"
 )
 
@@ -31,25 +39,36 @@ type GRPCServer struct {
 	bc         blockcache.BlockCache
 	p2pService p2p.Service
 	txdb       global.TxDB
+	edb        global.EvidenceDB
 	txpool     txpool.TxPool
 	bchain     block.Chain
 	forkDB     db.MVCCDB
 	visitor    *database.Visitor
+	slots      SlotCounter
 	port       int
+	history    int64
+}
+
+// SlotCounter gives the produced and missed slots of witnesses, it's implemented by the consensus.
+type SlotCounter interface {
+	SlotCounts() map[string][]*consensus.SlotCount
 }
 
 // NewRPCServer create GRPC rpc server
-func NewRPCServer(tp txpool.TxPool, bcache blockcache.BlockCache, _global global.BaseVariable, p2pService p2p.Service) *GRPCServer {
+func NewRPCServer(tp txpool.TxPool, bcache blockcache.BlockCache, _global global.BaseVariable, p2pService p2p.Service, slots SlotCounter) *GRPCServer {
 	forkDb := _global.StateDB().Fork()
 	return &GRPCServer{
 		txdb:       _global.TxDB(),
+		edb:        _global.EvidenceDB(),
 		p2pService: p2pService,
 		txpool:     tp,
 		bchain:     _global.BlockChain(),
 		bc:         bcache,
 		forkDB:     forkDb,
 		visitor:    database.NewVisitor(0, forkDb),
+		slots:      slots,
 		port:       _global.Config().RPC.GRPCPort,
+		history:    _global.Config().DB.HistoryLength,
 	}
 }
 
@@ -195,7 +214,10 @@ func (s *GRPCServer) GetBlockByNum(ctx context.Context, blkNumReq *BlockByNumReq
 	num := blkNumReq.Num
 	complete := blkNumReq.Complete
 
-	blk, _ := s.bchain.GetBlockByNumber(num)
+	blk, err := s.bchain.GetBlockByNumber(num)
+	if err == block.ErrPruned {
+		return nil, fmt.Errorf("block %v is pruned, the chain starts from block %v", num, s.bchain.Start())
+	}
 	if blk == nil {
 		blk, _ = s.bc.GetBlockByNumber(num)
 	}
@@ -283,6 +305,14 @@ func (s *GRPCServer) SendRawTx(ctx context.Context, rawTx *RawTxReq) (*SendRawTx
 		return nil, fmt.Errorf("tx err:%v", "GasPriceError")
 	case txpool.CacheFullError:
 		return nil, fmt.Errorf("tx err:%v", "CacheFullError")
+	case txpool.PublisherLimitError:
+		return nil, fmt.Errorf("tx err:%v", "PublisherLimitError")
+	case txpool.UnderpricedError:
+		return nil, fmt.Errorf("tx err:%v", "UnderpricedError")
+	case txpool.TxSizeError:
+		return nil, fmt.Errorf("tx err:%v", "TxSizeError")
+	case txpool.ExpirationError:
+		return nil, fmt.Errorf("tx err:%v", "ExpirationError")
 	default:
 	}
 	res := SendRawTxRes{}
@@ -290,15 +320,270 @@ func (s *GRPCServer) SendRawTx(ctx context.Context, rawTx *RawTxReq) (*SendRawTx
 	return &res, nil
 }
 
-// EstimateGas estimate gas used by transaction
+// EstimateGas executes the tx on a fork of the head state without sending it, and returns its
+// gas usage and the trace of the execution if required
 func (s *GRPCServer) EstimateGas(ctx context.Context, rawTx *RawTxReq) (*GasRes, error) {
-	return nil, nil
+	if rawTx == nil {
+		return nil, fmt.Errorf("argument cannot be nil pointer")
+	}
+	var trx tx.Tx
+	err := trx.Decode(rawTx.Data)
+	if err != nil {
+		return nil, err
+	}
+	head := s.bc.Head().Block
+	forkDB := s.forkDB.Fork()
+	if !forkDB.Checkout(string(head.HeadHash())) {
+		return nil, fmt.Errorf("state of block %v not found", head.Head.Number)
+	}
+	blkHead := &block.BlockHead{
+		ParentHash: head.HeadHash(),
+		Number:     head.Head.Number + 1,
+		Witness:    head.Head.Witness,
+		Time:       time.Now().Unix() / common.SlotLength,
+	}
+	receipt, trace, err := execTx(vm.NewEngine(blkHead, forkDB), &trx, rawTx.Trace)
+	if err != nil {
+		return nil, err
+	}
+	return &GasRes{
+		Gas:          uint64(receipt.GasUsage),
+		TxReceiptRaw: receipt.ToTxReceiptRaw(),
+		Trace:        trace,
+	}, nil
+}
+
+// ErrTracePruned is returned by TraceTx for the txs whose blocks are confirmed before the states kept by the state db.
+var ErrTracePruned = errors.New("tx can't be traced, the state before its block is pruned")
+
+// TraceTx re-executes the tx on a fork of the state before its block and traces the execution. The state db keeps
+// the states of the blocks in the block cache, and the states of the latest confirmed blocks given by the history
+// length of the db config, so the txs of the earlier blocks can't be traced.
+func (s *GRPCServer) TraceTx(ctx context.Context, hash *HashReq) (*TraceRes, error) {
+	if hash == nil {
+		return nil, fmt.Errorf("argument cannot be nil pointer")
+	}
+	txHash := common.Base58Decode(hash.Hash)
+
+	var forkDB db.MVCCDB
+	blk, index := s.unconfirmedTx(txHash)
+	if blk != nil {
+		forkDB = s.forkDB.Fork()
+		if !forkDB.Checkout(string(blk.Head.ParentHash)) {
+			return nil, fmt.Errorf("state before block %v is pruned", blk.Head.Number)
+		}
+	} else {
+		if ok, _ := s.txdb.HasTx(txHash); !ok {
+			return nil, fmt.Errorf("tx %v not found", hash.Hash)
+		}
+		blk, index = s.confirmedTx(txHash)
+		history, ok := s.forkDB.(db.HistoryDB)
+		if blk == nil || !ok {
+			return nil, ErrTracePruned
+		}
+		state, err := history.StateAt(string(blk.Head.ParentHash))
+		if err == db.ErrStateNotKept {
+			return nil, ErrTracePruned
+		}
+		if err != nil {
+			return nil, err
+		}
+		defer state.Close()
+		forkDB = state
+	}
+	vm.RecordRandom(blk.Head, forkDB)
+	engine := vm.NewEngine(blk.Head, forkDB)
+	for _, t := range blk.Txs[:index] {
+		if _, err := engine.Exec(t, verifier.TxExecTimeLimit); err != nil {
+			return nil, err
+		}
+	}
+	receipt, trace, err := execTx(engine, blk.Txs[index], true)
+	if err != nil {
+		return nil, err
+	}
+	return &TraceRes{
+		TxReceiptRaw: receipt.ToTxReceiptRaw(),
+		Trace:        trace,
+	}, nil
+}
+
+// unconfirmedTx returns the block in the block cache containing the tx and the index of the tx in it
+func (s *GRPCServer) unconfirmedTx(txHash []byte) (*block.Block, int) {
+	for node := s.bc.Head(); node != nil && node.Block != nil; node = node.Parent {
+		if i := txIndex(node.Block, txHash); i >= 0 {
+			return node.Block, i
+		}
+	}
+	return nil, -1
+}
+
+// confirmedTx returns the confirmed block containing the tx and the index of the tx in it, only the blocks whose
+// parent states are kept are searched
+func (s *GRPCServer) confirmedTx(txHash []byte) (*block.Block, int) {
+	top := s.bchain.Length() - 1
+	for n := top; n > 0 && n >= top-s.history+2; n-- {
+		blk, err := s.bchain.GetBlockByNumber(n)
+		if err != nil {
+			return nil, -1
+		}
+		if i := txIndex(blk, txHash); i >= 0 {
+			return blk, i
+		}
+	}
+	return nil, -1
+}
+
+func txIndex(blk *block.Block, txHash []byte) int {
+	for i, t := range blk.Txs {
+		if bytes.Equal(t.Hash(), txHash) {
+			return i
+		}
+	}
+	return -1
+}
+
+func execTx(engine vm.Engine, trx *tx.Tx, trace bool) (*tx.TxReceipt, string, error) {
+	if !trace {
+		receipt, err := engine.Exec(trx, verifier.TxExecTimeLimit)
+		return receipt, "", err
+	}
+	receipt, tracer, err := engine.Trace(trx, verifier.TxExecTimeLimit)
+	if err != nil {
+		return nil, "", err
+	}
+	j, err := tracer.JSON()
+	return receipt, j, err
+}
+
+// GetEvidence get the double-sign evidence of witnesses
+func (s *GRPCServer) GetEvidence(ctx context.Context, empty *empty.Empty) (*EvidenceRes, error) {
+	list, err := s.edb.List()
+	if err != nil {
+		return nil, err
+	}
+	res := &EvidenceRes{}
+	for _, e := range list {
+		hashA, err := e.HeadA.Hash()
+		if err != nil {
+			return nil, err
+		}
+		hashB, err := e.HeadB.Hash()
+		if err != nil {
+			return nil, err
+		}
+		res.Evidences = append(res.Evidences, &EvidenceInfo{
+			Witness:  e.Witness(),
+			Slot:     e.Slot(),
+			HashA:    common.Base58Encode(hashA),
+			HashB:    common.Base58Encode(hashB),
+			Evidence: e,
+		})
+	}
+	return res, nil
+}
+
+// GetWitnessStat get the produced and missed slots of witnesses
+func (s *GRPCServer) GetWitnessStat(ctx context.Context, empty *empty.Empty) (*WitnessStatRes, error) {
+	res := &WitnessStatRes{}
+	for witness, counts := range s.slots.SlotCounts() {
+		stat := &WitnessStat{Witness: witness}
+		for _, c := range counts {
+			stat.Counts = append(stat.Counts, &SlotCount{
+				Window:   c.Window,
+				Produced: c.Produced,
+				Missed:   c.Missed,
+			})
+		}
+		res.Stats = append(res.Stats, stat)
+	}
+	sort.Slice(res.Stats, func(i, j int) bool {
+		return res.Stats[i].Witness < res.Stats[j].Witness
+	})
+	return res, nil
+}
+
+// GetSyncStatus get the progress of syncing blocks from the peers
+func (s *GRPCServer) GetSyncStatus(ctx context.Context, empty *empty.Empty) (*SyncStatusRes, error) {
+	status := synchronizer.Status()
+	return &SyncStatusRes{
+		Syncing:          status.Syncing,
+		CurrentHeight:    status.CurrentHeight,
+		HighestHeight:    status.HighestHeight,
+		BlocksPerSecond:  status.BlocksPerSecond,
+		RemainingSeconds: status.RemainingSeconds,
+		Missions:         status.Missions,
+		Peers:            status.Peers,
+		Done:             status.Done(),
+	}, nil
+}
+
+// MaxEventBlockRange is the maximum number of blocks queried by GetEvents at once
+var MaxEventBlockRange int64 = 1000
+
+// GetEvents get the contract events in the blocks matching the filter
+func (s *GRPCServer) GetEvents(ctx context.Context, req *EventsReq) (*EventsRes, error) {
+	if req == nil {
+		return nil, fmt.Errorf("argument cannot be nil pointer")
+	}
+	to := req.ToBlock
+	if to == -1 {
+		to = s.bc.Head().Number
+	}
+	if req.FromBlock < 0 || req.FromBlock > to {
+		return nil, fmt.Errorf("invalid block range [%v, %v]", req.FromBlock, to)
+	}
+	if to-req.FromBlock >= MaxEventBlockRange {
+		return nil, fmt.Errorf("block range exceeds %v", MaxEventBlockRange)
+	}
+	filter := &tx.EventFilter{
+		Contract: req.Contract,
+		Name:     req.EventName,
+		Topics:   req.EventTopics,
+	}
+	res := &EventsRes{Events: make([]*ContractEvent, 0)}
+	for num := req.FromBlock; num <= to; num++ {
+		blk, err := s.bchain.GetBlockByNumber(num)
+		if err == block.ErrPruned {
+			return nil, fmt.Errorf("block %v is pruned, the chain starts from block %v", num, s.bchain.Start())
+		}
+		if blk == nil {
+			blk, _ = s.bc.GetBlockByNumber(num)
+		}
+		if blk == nil {
+			break
+		}
+		for _, e := range blk.Events() {
+			if filter.Match(e) {
+				res.Events = append(res.Events, toContractEvent(e))
+			}
+		}
+	}
+	return res, nil
+}
+
+func toContractEvent(e *tx.Event) *ContractEvent {
+	return &ContractEvent{
+		Contract:    e.Contract,
+		Name:        e.Name,
+		Topics:      e.Topics,
+		Data:        e.Data,
+		TxHash:      e.TxHash,
+		BlockHash:   e.BlockHash,
+		BlockNumber: e.BlockNumber,
+	}
 }
 
 // Subscribe used for event
 func (s *GRPCServer) Subscribe(req *SubscribeReq, res Apis_SubscribeServer) error {
 	ec := event.GetEventCollectorInstance()
 	sub := event.NewSubscription(100, req.Topics)
+	filterTx := req.Publisher != "" || req.Contract != ""
+	filter := &tx.EventFilter{
+		Contract: req.Contract,
+		Name:     req.EventName,
+		Topics:   req.EventTopics,
+	}
 	ec.Subscribe(sub)
 	defer ec.Unsubscribe(sub)
 
@@ -310,6 +595,18 @@ forloop:
 			ilog.Debugf("timeup in subscribe send")
 			break forloop
 		case ev := <-sub.ReadChan():
+			if filterTx && txpool.IsTxPoolTopic(ev.Topic) {
+				var te txpool.TxEvent
+				if err := json.Unmarshal([]byte(ev.Data), &te); err != nil || !te.Match(req.Publisher, req.Contract) {
+					continue
+				}
+			}
+			if ev.Topic == event.Event_ContractEvent {
+				var e tx.Event
+				if err := json.Unmarshal([]byte(ev.Data), &e); err != nil || !filter.Match(&e) {
+					continue
+				}
+			}
 			err := res.Send(&SubscribeRes{Ev: ev})
 			if err != nil {
 				return err
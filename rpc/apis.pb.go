@@ -17,6 +17,8 @@ import (
 	grpc "google.golang.org/grpc"
 )
 
+import encoding_binary "encoding/binary"
+
 import io "io"
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -41,7 +43,7 @@ func (m *HashReq) Reset()         { *m = HashReq{} }
 func (m *HashReq) String() string { return proto.CompactTextString(m) }
 func (*HashReq) ProtoMessage()    {}
 func (*HashReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_apis_94efd193c39410bc, []int{0}
+	return fileDescriptor_apis_844e2e6382aee696, []int{0}
 }
 func (m *HashReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -77,9 +79,9 @@ func (m *HashReq) GetHash() string {
 	return ""
 }
 
-// complete means return the whole block or just blockhead+txhash_list
 type BlockByHashReq struct {
-	Hash                 string   `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	// complete means return the whole block or just blockhead+txhash_list
 	Complete             bool     `protobuf:"varint,2,opt,name=complete,proto3" json:"complete,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -90,7 +92,7 @@ func (m *BlockByHashReq) Reset()         { *m = BlockByHashReq{} }
 func (m *BlockByHashReq) String() string { return proto.CompactTextString(m) }
 func (*BlockByHashReq) ProtoMessage()    {}
 func (*BlockByHashReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_apis_94efd193c39410bc, []int{1}
+	return fileDescriptor_apis_844e2e6382aee696, []int{1}
 }
 func (m *BlockByHashReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -134,7 +136,8 @@ func (m *BlockByHashReq) GetComplete() bool {
 }
 
 type BlockByNumReq struct {
-	Num                  int64    `protobuf:"varint,1,opt,name=num,proto3" json:"num,omitempty"`
+	Num int64 `protobuf:"varint,1,opt,name=num,proto3" json:"num,omitempty"`
+	// complete means return the whole block or just blockhead+txhash_list
 	Complete             bool     `protobuf:"varint,2,opt,name=complete,proto3" json:"complete,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -145,7 +148,7 @@ func (m *BlockByNumReq) Reset()         { *m = BlockByNumReq{} }
 func (m *BlockByNumReq) String() string { return proto.CompactTextString(m) }
 func (*BlockByNumReq) ProtoMessage()    {}
 func (*BlockByNumReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_apis_94efd193c39410bc, []int{2}
+	return fileDescriptor_apis_844e2e6382aee696, []int{2}
 }
 func (m *BlockByNumReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -189,7 +192,8 @@ func (m *BlockByNumReq) GetComplete() bool {
 }
 
 type GetBalanceReq struct {
-	ID                   string   `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	ID string `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	// useLongestChain means whether geting the balance also from pending blocks(in the longest chain)
 	UseLongestChain      bool     `protobuf:"varint,2,opt,name=useLongestChain,proto3" json:"useLongestChain,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -200,7 +204,7 @@ func (m *GetBalanceReq) Reset()         { *m = GetBalanceReq{} }
 func (m *GetBalanceReq) String() string { return proto.CompactTextString(m) }
 func (*GetBalanceReq) ProtoMessage()    {}
 func (*GetBalanceReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_apis_94efd193c39410bc, []int{3}
+	return fileDescriptor_apis_844e2e6382aee696, []int{3}
 }
 func (m *GetBalanceReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -244,7 +248,8 @@ func (m *GetBalanceReq) GetUseLongestChain() bool {
 }
 
 type GetStateReq struct {
-	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// get the value from StateDB,field is needed if StateDB[key] is a map.(we get StateDB[key][field] in this case)
 	Field                string   `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -255,7 +260,7 @@ func (m *GetStateReq) Reset()         { *m = GetStateReq{} }
 func (m *GetStateReq) String() string { return proto.CompactTextString(m) }
 func (*GetStateReq) ProtoMessage()    {}
 func (*GetStateReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_apis_94efd193c39410bc, []int{4}
+	return fileDescriptor_apis_844e2e6382aee696, []int{4}
 }
 func (m *GetStateReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -298,9 +303,11 @@ func (m *GetStateReq) GetField() string {
 	return ""
 }
 
-// the rawdata of a tx
 type RawTxReq struct {
-	Data                 []byte   `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	// the rawdata of a tx
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	// trace the execution of the tx, only used by EstimateGas
+	Trace                bool     `protobuf:"varint,2,opt,name=trace,proto3" json:"trace,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -310,7 +317,7 @@ func (m *RawTxReq) Reset()         { *m = RawTxReq{} }
 func (m *RawTxReq) String() string { return proto.CompactTextString(m) }
 func (*RawTxReq) ProtoMessage()    {}
 func (*RawTxReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_apis_94efd193c39410bc, []int{5}
+	return fileDescriptor_apis_844e2e6382aee696, []int{5}
 }
 func (m *RawTxReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -346,18 +353,33 @@ func (m *RawTxReq) GetData() []byte {
 	return nil
 }
 
+func (m *RawTxReq) GetTrace() bool {
+	if m != nil {
+		return m.Trace
+	}
+	return false
+}
+
 type SubscribeReq struct {
-	Topics               []event.Event_Topic `protobuf:"varint,1,rep,packed,name=topics,enum=event.Event_Topic" json:"topics,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_unrecognized     []byte              `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
+	Topics []event.Event_Topic `protobuf:"varint,1,rep,packed,name=topics,enum=event.Event_Topic" json:"topics,omitempty"`
+	// only receive the txpool events of txs published by this account ID, empty means no limit
+	Publisher string `protobuf:"bytes,2,opt,name=publisher,proto3" json:"publisher,omitempty"`
+	// only receive the txpool events of txs calling this contract and the contract events of this contract, empty means no limit
+	Contract string `protobuf:"bytes,3,opt,name=contract,proto3" json:"contract,omitempty"`
+	// only receive the contract events with this name, empty means no limit
+	EventName string `protobuf:"bytes,4,opt,name=eventName,proto3" json:"eventName,omitempty"`
+	// only receive the contract events whose indexed topics match these by position, empty topic matches any value
+	EventTopics          []string `protobuf:"bytes,5,rep,name=eventTopics" json:"eventTopics,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *SubscribeReq) Reset()         { *m = SubscribeReq{} }
 func (m *SubscribeReq) String() string { return proto.CompactTextString(m) }
 func (*SubscribeReq) ProtoMessage()    {}
 func (*SubscribeReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_apis_94efd193c39410bc, []int{6}
+	return fileDescriptor_apis_844e2e6382aee696, []int{6}
 }
 func (m *SubscribeReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -393,7 +415,262 @@ func (m *SubscribeReq) GetTopics() []event.Event_Topic {
 	return nil
 }
 
+func (m *SubscribeReq) GetPublisher() string {
+	if m != nil {
+		return m.Publisher
+	}
+	return ""
+}
+
+func (m *SubscribeReq) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *SubscribeReq) GetEventName() string {
+	if m != nil {
+		return m.EventName
+	}
+	return ""
+}
+
+func (m *SubscribeReq) GetEventTopics() []string {
+	if m != nil {
+		return m.EventTopics
+	}
+	return nil
+}
+
+type EventsReq struct {
+	// the first block to query
+	FromBlock int64 `protobuf:"varint,1,opt,name=fromBlock,proto3" json:"fromBlock,omitempty"`
+	// the last block to query, -1 means the head block
+	ToBlock int64 `protobuf:"varint,2,opt,name=toBlock,proto3" json:"toBlock,omitempty"`
+	// empty means any contract
+	Contract string `protobuf:"bytes,3,opt,name=contract,proto3" json:"contract,omitempty"`
+	// empty means any event name
+	EventName string `protobuf:"bytes,4,opt,name=eventName,proto3" json:"eventName,omitempty"`
+	// the indexed topics to match by position, empty topic matches any value
+	EventTopics          []string `protobuf:"bytes,5,rep,name=eventTopics" json:"eventTopics,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EventsReq) Reset()         { *m = EventsReq{} }
+func (m *EventsReq) String() string { return proto.CompactTextString(m) }
+func (*EventsReq) ProtoMessage()    {}
+func (*EventsReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_apis_844e2e6382aee696, []int{7}
+}
+func (m *EventsReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventsReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventsReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *EventsReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventsReq.Merge(dst, src)
+}
+func (m *EventsReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventsReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventsReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventsReq proto.InternalMessageInfo
+
+func (m *EventsReq) GetFromBlock() int64 {
+	if m != nil {
+		return m.FromBlock
+	}
+	return 0
+}
+
+func (m *EventsReq) GetToBlock() int64 {
+	if m != nil {
+		return m.ToBlock
+	}
+	return 0
+}
+
+func (m *EventsReq) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *EventsReq) GetEventName() string {
+	if m != nil {
+		return m.EventName
+	}
+	return ""
+}
+
+func (m *EventsReq) GetEventTopics() []string {
+	if m != nil {
+		return m.EventTopics
+	}
+	return nil
+}
+
+type ContractEvent struct {
+	Contract             string   `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Topics               []string `protobuf:"bytes,3,rep,name=topics" json:"topics,omitempty"`
+	Data                 string   `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	TxHash               string   `protobuf:"bytes,5,opt,name=txHash,proto3" json:"txHash,omitempty"`
+	BlockHash            string   `protobuf:"bytes,6,opt,name=blockHash,proto3" json:"blockHash,omitempty"`
+	BlockNumber          int64    `protobuf:"varint,7,opt,name=blockNumber,proto3" json:"blockNumber,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ContractEvent) Reset()         { *m = ContractEvent{} }
+func (m *ContractEvent) String() string { return proto.CompactTextString(m) }
+func (*ContractEvent) ProtoMessage()    {}
+func (*ContractEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_apis_844e2e6382aee696, []int{8}
+}
+func (m *ContractEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *ContractEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractEvent.Merge(dst, src)
+}
+func (m *ContractEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractEvent proto.InternalMessageInfo
+
+func (m *ContractEvent) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *ContractEvent) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ContractEvent) GetTopics() []string {
+	if m != nil {
+		return m.Topics
+	}
+	return nil
+}
+
+func (m *ContractEvent) GetData() string {
+	if m != nil {
+		return m.Data
+	}
+	return ""
+}
+
+func (m *ContractEvent) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func (m *ContractEvent) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
+	}
+	return ""
+}
+
+func (m *ContractEvent) GetBlockNumber() int64 {
+	if m != nil {
+		return m.BlockNumber
+	}
+	return 0
+}
+
+type EventsRes struct {
+	Events               []*ContractEvent `protobuf:"bytes,1,rep,name=events" json:"events,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *EventsRes) Reset()         { *m = EventsRes{} }
+func (m *EventsRes) String() string { return proto.CompactTextString(m) }
+func (*EventsRes) ProtoMessage()    {}
+func (*EventsRes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_apis_844e2e6382aee696, []int{9}
+}
+func (m *EventsRes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventsRes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventsRes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *EventsRes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventsRes.Merge(dst, src)
+}
+func (m *EventsRes) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventsRes) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventsRes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventsRes proto.InternalMessageInfo
+
+func (m *EventsRes) GetEvents() []*ContractEvent {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
 type HeightRes struct {
+	// the height of the blockchain
 	Height               int64    `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -404,7 +681,7 @@ func (m *HeightRes) Reset()         { *m = HeightRes{} }
 func (m *HeightRes) String() string { return proto.CompactTextString(m) }
 func (*HeightRes) ProtoMessage()    {}
 func (*HeightRes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_apis_94efd193c39410bc, []int{7}
+	return fileDescriptor_apis_844e2e6382aee696, []int{10}
 }
 func (m *HeightRes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -441,6 +718,7 @@ func (m *HeightRes) GetHeight() int64 {
 }
 
 type GetBalanceRes struct {
+	// the queried balance
 	Balance              int64    `protobuf:"varint,1,opt,name=balance,proto3" json:"balance,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -451,7 +729,7 @@ func (m *GetBalanceRes) Reset()         { *m = GetBalanceRes{} }
 func (m *GetBalanceRes) String() string { return proto.CompactTextString(m) }
 func (*GetBalanceRes) ProtoMessage()    {}
 func (*GetBalanceRes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_apis_94efd193c39410bc, []int{8}
+	return fileDescriptor_apis_844e2e6382aee696, []int{11}
 }
 func (m *GetBalanceRes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -498,7 +776,7 @@ func (m *GetNetIDRes) Reset()         { *m = GetNetIDRes{} }
 func (m *GetNetIDRes) String() string { return proto.CompactTextString(m) }
 func (*GetNetIDRes) ProtoMessage()    {}
 func (*GetNetIDRes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_apis_94efd193c39410bc, []int{9}
+	return fileDescriptor_apis_844e2e6382aee696, []int{12}
 }
 func (m *GetNetIDRes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -545,7 +823,7 @@ func (m *GetStateRes) Reset()         { *m = GetStateRes{} }
 func (m *GetStateRes) String() string { return proto.CompactTextString(m) }
 func (*GetStateRes) ProtoMessage()    {}
 func (*GetStateRes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_apis_94efd193c39410bc, []int{10}
+	return fileDescriptor_apis_844e2e6382aee696, []int{13}
 }
 func (m *GetStateRes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -582,6 +860,7 @@ func (m *GetStateRes) GetValue() string {
 }
 
 type SendRawTxRes struct {
+	// the hash of the received transaction
 	Hash                 string   `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -592,7 +871,7 @@ func (m *SendRawTxRes) Reset()         { *m = SendRawTxRes{} }
 func (m *SendRawTxRes) String() string { return proto.CompactTextString(m) }
 func (*SendRawTxRes) ProtoMessage()    {}
 func (*SendRawTxRes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_apis_94efd193c39410bc, []int{11}
+	return fileDescriptor_apis_844e2e6382aee696, []int{14}
 }
 func (m *SendRawTxRes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -629,7 +908,10 @@ func (m *SendRawTxRes) GetHash() string {
 }
 
 type GasRes struct {
-	Gas                  uint64   `protobuf:"varint,1,opt,name=gas,proto3" json:"gas,omitempty"`
+	Gas          uint64           `protobuf:"varint,1,opt,name=gas,proto3" json:"gas,omitempty"`
+	TxReceiptRaw *tx.TxReceiptRaw `protobuf:"bytes,2,opt,name=txReceiptRaw" json:"txReceiptRaw,omitempty"`
+	// the call tree and storage accesses in json if traced
+	Trace                string   `protobuf:"bytes,3,opt,name=trace,proto3" json:"trace,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -639,7 +921,7 @@ func (m *GasRes) Reset()         { *m = GasRes{} }
 func (m *GasRes) String() string { return proto.CompactTextString(m) }
 func (*GasRes) ProtoMessage()    {}
 func (*GasRes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_apis_94efd193c39410bc, []int{12}
+	return fileDescriptor_apis_844e2e6382aee696, []int{15}
 }
 func (m *GasRes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -675,7 +957,78 @@ func (m *GasRes) GetGas() uint64 {
 	return 0
 }
 
+func (m *GasRes) GetTxReceiptRaw() *tx.TxReceiptRaw {
+	if m != nil {
+		return m.TxReceiptRaw
+	}
+	return nil
+}
+
+func (m *GasRes) GetTrace() string {
+	if m != nil {
+		return m.Trace
+	}
+	return ""
+}
+
+type TraceRes struct {
+	TxReceiptRaw *tx.TxReceiptRaw `protobuf:"bytes,1,opt,name=txReceiptRaw" json:"txReceiptRaw,omitempty"`
+	// the call tree and storage accesses in json
+	Trace                string   `protobuf:"bytes,2,opt,name=trace,proto3" json:"trace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TraceRes) Reset()         { *m = TraceRes{} }
+func (m *TraceRes) String() string { return proto.CompactTextString(m) }
+func (*TraceRes) ProtoMessage()    {}
+func (*TraceRes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_apis_844e2e6382aee696, []int{16}
+}
+func (m *TraceRes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TraceRes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TraceRes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *TraceRes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TraceRes.Merge(dst, src)
+}
+func (m *TraceRes) XXX_Size() int {
+	return m.Size()
+}
+func (m *TraceRes) XXX_DiscardUnknown() {
+	xxx_messageInfo_TraceRes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TraceRes proto.InternalMessageInfo
+
+func (m *TraceRes) GetTxReceiptRaw() *tx.TxReceiptRaw {
+	if m != nil {
+		return m.TxReceiptRaw
+	}
+	return nil
+}
+
+func (m *TraceRes) GetTrace() string {
+	if m != nil {
+		return m.Trace
+	}
+	return ""
+}
+
 type TxRes struct {
+	// the queried transaction
 	TxRaw                *tx.TxRaw `protobuf:"bytes,1,opt,name=txRaw" json:"txRaw,omitempty"`
 	Hash                 []byte    `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
@@ -687,7 +1040,7 @@ func (m *TxRes) Reset()         { *m = TxRes{} }
 func (m *TxRes) String() string { return proto.CompactTextString(m) }
 func (*TxRes) ProtoMessage()    {}
 func (*TxRes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_apis_94efd193c39410bc, []int{13}
+	return fileDescriptor_apis_844e2e6382aee696, []int{17}
 }
 func (m *TxRes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -742,7 +1095,7 @@ func (m *TxReceiptRes) Reset()         { *m = TxReceiptRes{} }
 func (m *TxReceiptRes) String() string { return proto.CompactTextString(m) }
 func (*TxReceiptRes) ProtoMessage()    {}
 func (*TxReceiptRes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_apis_94efd193c39410bc, []int{14}
+	return fileDescriptor_apis_844e2e6382aee696, []int{18}
 }
 func (m *TxReceiptRes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -801,7 +1154,7 @@ func (m *BlockInfo) Reset()         { *m = BlockInfo{} }
 func (m *BlockInfo) String() string { return proto.CompactTextString(m) }
 func (*BlockInfo) ProtoMessage()    {}
 func (*BlockInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_apis_94efd193c39410bc, []int{15}
+	return fileDescriptor_apis_844e2e6382aee696, []int{19}
 }
 func (m *BlockInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -883,7 +1236,7 @@ func (m *SubscribeRes) Reset()         { *m = SubscribeRes{} }
 func (m *SubscribeRes) String() string { return proto.CompactTextString(m) }
 func (*SubscribeRes) ProtoMessage()    {}
 func (*SubscribeRes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_apis_94efd193c39410bc, []int{16}
+	return fileDescriptor_apis_844e2e6382aee696, []int{20}
 }
 func (m *SubscribeRes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -919,37 +1272,448 @@ func (m *SubscribeRes) GetEv() *event.Event {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*HashReq)(nil), "rpc.HashReq")
-	proto.RegisterType((*BlockByHashReq)(nil), "rpc.BlockByHashReq")
-	proto.RegisterType((*BlockByNumReq)(nil), "rpc.BlockByNumReq")
-	proto.RegisterType((*GetBalanceReq)(nil), "rpc.GetBalanceReq")
-	proto.RegisterType((*GetStateReq)(nil), "rpc.GetStateReq")
-	proto.RegisterType((*RawTxReq)(nil), "rpc.RawTxReq")
-	proto.RegisterType((*SubscribeReq)(nil), "rpc.SubscribeReq")
-	proto.RegisterType((*HeightRes)(nil), "rpc.HeightRes")
-	proto.RegisterType((*GetBalanceRes)(nil), "rpc.GetBalanceRes")
-	proto.RegisterType((*GetNetIDRes)(nil), "rpc.GetNetIDRes")
-	proto.RegisterType((*GetStateRes)(nil), "rpc.GetStateRes")
-	proto.RegisterType((*SendRawTxRes)(nil), "rpc.SendRawTxRes")
-	proto.RegisterType((*GasRes)(nil), "rpc.GasRes")
-	proto.RegisterType((*TxRes)(nil), "rpc.txRes")
-	proto.RegisterType((*TxReceiptRes)(nil), "rpc.txReceiptRes")
-	proto.RegisterType((*BlockInfo)(nil), "rpc.BlockInfo")
-	proto.RegisterType((*SubscribeRes)(nil), "rpc.SubscribeRes")
+type EvidenceInfo struct {
+	// the witness who signed two blocks in the same slot
+	Witness string `protobuf:"bytes,1,opt,name=witness,proto3" json:"witness,omitempty"`
+	Slot    int64  `protobuf:"varint,2,opt,name=slot,proto3" json:"slot,omitempty"`
+	// the hashes of the two blocks
+	HashA                string          `protobuf:"bytes,3,opt,name=hashA,proto3" json:"hashA,omitempty"`
+	HashB                string          `protobuf:"bytes,4,opt,name=hashB,proto3" json:"hashB,omitempty"`
+	Evidence             *block.Evidence `protobuf:"bytes,5,opt,name=evidence" json:"evidence,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *EvidenceInfo) Reset()         { *m = EvidenceInfo{} }
+func (m *EvidenceInfo) String() string { return proto.CompactTextString(m) }
+func (*EvidenceInfo) ProtoMessage()    {}
+func (*EvidenceInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_apis_844e2e6382aee696, []int{21}
+}
+func (m *EvidenceInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EvidenceInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EvidenceInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *EvidenceInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvidenceInfo.Merge(dst, src)
+}
+func (m *EvidenceInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *EvidenceInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvidenceInfo.DiscardUnknown(m)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
+var xxx_messageInfo_EvidenceInfo proto.InternalMessageInfo
+
+func (m *EvidenceInfo) GetWitness() string {
+	if m != nil {
+		return m.Witness
+	}
+	return ""
+}
+
+func (m *EvidenceInfo) GetSlot() int64 {
+	if m != nil {
+		return m.Slot
+	}
+	return 0
+}
+
+func (m *EvidenceInfo) GetHashA() string {
+	if m != nil {
+		return m.HashA
+	}
+	return ""
+}
+
+func (m *EvidenceInfo) GetHashB() string {
+	if m != nil {
+		return m.HashB
+	}
+	return ""
+}
+
+func (m *EvidenceInfo) GetEvidence() *block.Evidence {
+	if m != nil {
+		return m.Evidence
+	}
+	return nil
+}
+
+type EvidenceRes struct {
+	Evidences            []*EvidenceInfo `protobuf:"bytes,1,rep,name=evidences" json:"evidences,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *EvidenceRes) Reset()         { *m = EvidenceRes{} }
+func (m *EvidenceRes) String() string { return proto.CompactTextString(m) }
+func (*EvidenceRes) ProtoMessage()    {}
+func (*EvidenceRes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_apis_844e2e6382aee696, []int{22}
+}
+func (m *EvidenceRes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EvidenceRes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EvidenceRes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *EvidenceRes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvidenceRes.Merge(dst, src)
+}
+func (m *EvidenceRes) XXX_Size() int {
+	return m.Size()
+}
+func (m *EvidenceRes) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvidenceRes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EvidenceRes proto.InternalMessageInfo
+
+func (m *EvidenceRes) GetEvidences() []*EvidenceInfo {
+	if m != nil {
+		return m.Evidences
+	}
+	return nil
+}
+
+type SlotCount struct {
+	// the size of the sliding window in slots
+	Window               int64    `protobuf:"varint,1,opt,name=window,proto3" json:"window,omitempty"`
+	Produced             int64    `protobuf:"varint,2,opt,name=produced,proto3" json:"produced,omitempty"`
+	Missed               int64    `protobuf:"varint,3,opt,name=missed,proto3" json:"missed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SlotCount) Reset()         { *m = SlotCount{} }
+func (m *SlotCount) String() string { return proto.CompactTextString(m) }
+func (*SlotCount) ProtoMessage()    {}
+func (*SlotCount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_apis_844e2e6382aee696, []int{23}
+}
+func (m *SlotCount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SlotCount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SlotCount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *SlotCount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlotCount.Merge(dst, src)
+}
+func (m *SlotCount) XXX_Size() int {
+	return m.Size()
+}
+func (m *SlotCount) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlotCount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SlotCount proto.InternalMessageInfo
+
+func (m *SlotCount) GetWindow() int64 {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+func (m *SlotCount) GetProduced() int64 {
+	if m != nil {
+		return m.Produced
+	}
+	return 0
+}
+
+func (m *SlotCount) GetMissed() int64 {
+	if m != nil {
+		return m.Missed
+	}
+	return 0
+}
+
+type WitnessStat struct {
+	Witness              string       `protobuf:"bytes,1,opt,name=witness,proto3" json:"witness,omitempty"`
+	Counts               []*SlotCount `protobuf:"bytes,2,rep,name=counts" json:"counts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *WitnessStat) Reset()         { *m = WitnessStat{} }
+func (m *WitnessStat) String() string { return proto.CompactTextString(m) }
+func (*WitnessStat) ProtoMessage()    {}
+func (*WitnessStat) Descriptor() ([]byte, []int) {
+	return fileDescriptor_apis_844e2e6382aee696, []int{24}
+}
+func (m *WitnessStat) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WitnessStat) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WitnessStat.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *WitnessStat) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WitnessStat.Merge(dst, src)
+}
+func (m *WitnessStat) XXX_Size() int {
+	return m.Size()
+}
+func (m *WitnessStat) XXX_DiscardUnknown() {
+	xxx_messageInfo_WitnessStat.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WitnessStat proto.InternalMessageInfo
+
+func (m *WitnessStat) GetWitness() string {
+	if m != nil {
+		return m.Witness
+	}
+	return ""
+}
+
+func (m *WitnessStat) GetCounts() []*SlotCount {
+	if m != nil {
+		return m.Counts
+	}
+	return nil
+}
+
+type WitnessStatRes struct {
+	Stats                []*WitnessStat `protobuf:"bytes,1,rep,name=stats" json:"stats,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *WitnessStatRes) Reset()         { *m = WitnessStatRes{} }
+func (m *WitnessStatRes) String() string { return proto.CompactTextString(m) }
+func (*WitnessStatRes) ProtoMessage()    {}
+func (*WitnessStatRes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_apis_844e2e6382aee696, []int{25}
+}
+func (m *WitnessStatRes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WitnessStatRes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WitnessStatRes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *WitnessStatRes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WitnessStatRes.Merge(dst, src)
+}
+func (m *WitnessStatRes) XXX_Size() int {
+	return m.Size()
+}
+func (m *WitnessStatRes) XXX_DiscardUnknown() {
+	xxx_messageInfo_WitnessStatRes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WitnessStatRes proto.InternalMessageInfo
+
+func (m *WitnessStatRes) GetStats() []*WitnessStat {
+	if m != nil {
+		return m.Stats
+	}
+	return nil
+}
+
+type SyncStatusRes struct {
+	// whether the node is in sync mode
+	Syncing       bool  `protobuf:"varint,1,opt,name=syncing,proto3" json:"syncing,omitempty"`
+	CurrentHeight int64 `protobuf:"varint,2,opt,name=currentHeight,proto3" json:"currentHeight,omitempty"`
+	// the highest height of the peers
+	HighestHeight   int64   `protobuf:"varint,3,opt,name=highestHeight,proto3" json:"highestHeight,omitempty"`
+	BlocksPerSecond float64 `protobuf:"fixed64,4,opt,name=blocksPerSecond,proto3" json:"blocksPerSecond,omitempty"`
+	// the estimated seconds to catch up with the peers, -1 if unknown
+	RemainingSeconds int64 `protobuf:"varint,5,opt,name=remainingSeconds,proto3" json:"remainingSeconds,omitempty"`
+	// the number of blocks waiting to be downloaded or downloading
+	Missions int64 `protobuf:"varint,6,opt,name=missions,proto3" json:"missions,omitempty"`
+	Peers    int64 `protobuf:"varint,7,opt,name=peers,proto3" json:"peers,omitempty"`
+	// whether the node has caught up with the peers
+	Done                 bool     `protobuf:"varint,8,opt,name=done,proto3" json:"done,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SyncStatusRes) Reset()         { *m = SyncStatusRes{} }
+func (m *SyncStatusRes) String() string { return proto.CompactTextString(m) }
+func (*SyncStatusRes) ProtoMessage()    {}
+func (*SyncStatusRes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_apis_844e2e6382aee696, []int{26}
+}
+func (m *SyncStatusRes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SyncStatusRes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SyncStatusRes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *SyncStatusRes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncStatusRes.Merge(dst, src)
+}
+func (m *SyncStatusRes) XXX_Size() int {
+	return m.Size()
+}
+func (m *SyncStatusRes) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncStatusRes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncStatusRes proto.InternalMessageInfo
+
+func (m *SyncStatusRes) GetSyncing() bool {
+	if m != nil {
+		return m.Syncing
+	}
+	return false
+}
+
+func (m *SyncStatusRes) GetCurrentHeight() int64 {
+	if m != nil {
+		return m.CurrentHeight
+	}
+	return 0
+}
+
+func (m *SyncStatusRes) GetHighestHeight() int64 {
+	if m != nil {
+		return m.HighestHeight
+	}
+	return 0
+}
+
+func (m *SyncStatusRes) GetBlocksPerSecond() float64 {
+	if m != nil {
+		return m.BlocksPerSecond
+	}
+	return 0
+}
+
+func (m *SyncStatusRes) GetRemainingSeconds() int64 {
+	if m != nil {
+		return m.RemainingSeconds
+	}
+	return 0
+}
+
+func (m *SyncStatusRes) GetMissions() int64 {
+	if m != nil {
+		return m.Missions
+	}
+	return 0
+}
+
+func (m *SyncStatusRes) GetPeers() int64 {
+	if m != nil {
+		return m.Peers
+	}
+	return 0
+}
+
+func (m *SyncStatusRes) GetDone() bool {
+	if m != nil {
+		return m.Done
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*HashReq)(nil), "rpc.HashReq")
+	proto.RegisterType((*BlockByHashReq)(nil), "rpc.BlockByHashReq")
+	proto.RegisterType((*BlockByNumReq)(nil), "rpc.BlockByNumReq")
+	proto.RegisterType((*GetBalanceReq)(nil), "rpc.GetBalanceReq")
+	proto.RegisterType((*GetStateReq)(nil), "rpc.GetStateReq")
+	proto.RegisterType((*RawTxReq)(nil), "rpc.RawTxReq")
+	proto.RegisterType((*SubscribeReq)(nil), "rpc.SubscribeReq")
+	proto.RegisterType((*EventsReq)(nil), "rpc.EventsReq")
+	proto.RegisterType((*ContractEvent)(nil), "rpc.ContractEvent")
+	proto.RegisterType((*EventsRes)(nil), "rpc.EventsRes")
+	proto.RegisterType((*HeightRes)(nil), "rpc.HeightRes")
+	proto.RegisterType((*GetBalanceRes)(nil), "rpc.GetBalanceRes")
+	proto.RegisterType((*GetNetIDRes)(nil), "rpc.GetNetIDRes")
+	proto.RegisterType((*GetStateRes)(nil), "rpc.GetStateRes")
+	proto.RegisterType((*SendRawTxRes)(nil), "rpc.SendRawTxRes")
+	proto.RegisterType((*GasRes)(nil), "rpc.GasRes")
+	proto.RegisterType((*TraceRes)(nil), "rpc.TraceRes")
+	proto.RegisterType((*TxRes)(nil), "rpc.txRes")
+	proto.RegisterType((*TxReceiptRes)(nil), "rpc.txReceiptRes")
+	proto.RegisterType((*BlockInfo)(nil), "rpc.BlockInfo")
+	proto.RegisterType((*SubscribeRes)(nil), "rpc.SubscribeRes")
+	proto.RegisterType((*EvidenceInfo)(nil), "rpc.EvidenceInfo")
+	proto.RegisterType((*EvidenceRes)(nil), "rpc.EvidenceRes")
+	proto.RegisterType((*SlotCount)(nil), "rpc.SlotCount")
+	proto.RegisterType((*WitnessStat)(nil), "rpc.WitnessStat")
+	proto.RegisterType((*WitnessStatRes)(nil), "rpc.WitnessStatRes")
+	proto.RegisterType((*SyncStatusRes)(nil), "rpc.SyncStatusRes")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
 const _ = grpc.SupportPackageIsVersion4
 
-// ApisClient is the client API for Apis service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+// Client API for Apis service
+
 type ApisClient interface {
 	// get the current height of the blockchain
 	GetHeight(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*HeightRes, error)
@@ -971,8 +1735,18 @@ type ApisClient interface {
 	GetState(ctx context.Context, in *GetStateReq, opts ...grpc.CallOption) (*GetStateRes, error)
 	// receive encoded tx
 	SendRawTx(ctx context.Context, in *RawTxReq, opts ...grpc.CallOption) (*SendRawTxRes, error)
-	// not supported yet
+	// execute the encoded tx on the head state without sending it
 	EstimateGas(ctx context.Context, in *RawTxReq, opts ...grpc.CallOption) (*GasRes, error)
+	// re-execute a tx on a fork of the state before its block and trace its execution, the txs of the confirmed blocks out of the state history of the node can't be traced
+	TraceTx(ctx context.Context, in *HashReq, opts ...grpc.CallOption) (*TraceRes, error)
+	// get the double-sign evidence of witnesses
+	GetEvidence(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*EvidenceRes, error)
+	// get the produced and missed slots of witnesses
+	GetWitnessStat(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*WitnessStatRes, error)
+	// get the progress of syncing blocks from the peers
+	GetSyncStatus(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*SyncStatusRes, error)
+	// get the contract events in the blocks matching the filter
+	GetEvents(ctx context.Context, in *EventsReq, opts ...grpc.CallOption) (*EventsRes, error)
 	// subscribe an event
 	Subscribe(ctx context.Context, in *SubscribeReq, opts ...grpc.CallOption) (Apis_SubscribeClient, error)
 }
@@ -1084,22 +1858,67 @@ func (c *apisClient) EstimateGas(ctx context.Context, in *RawTxReq, opts ...grpc
 	return out, nil
 }
 
-func (c *apisClient) Subscribe(ctx context.Context, in *SubscribeReq, opts ...grpc.CallOption) (Apis_SubscribeClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Apis_serviceDesc.Streams[0], "/rpc.Apis/Subscribe", opts...)
+func (c *apisClient) TraceTx(ctx context.Context, in *HashReq, opts ...grpc.CallOption) (*TraceRes, error) {
+	out := new(TraceRes)
+	err := c.cc.Invoke(ctx, "/rpc.Apis/TraceTx", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &apisSubscribeClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
+	return out, nil
+}
+
+func (c *apisClient) GetEvidence(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*EvidenceRes, error) {
+	out := new(EvidenceRes)
+	err := c.cc.Invoke(ctx, "/rpc.Apis/GetEvidence", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	return x, nil
+	return out, nil
 }
 
-type Apis_SubscribeClient interface {
+func (c *apisClient) GetWitnessStat(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*WitnessStatRes, error) {
+	out := new(WitnessStatRes)
+	err := c.cc.Invoke(ctx, "/rpc.Apis/GetWitnessStat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apisClient) GetSyncStatus(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*SyncStatusRes, error) {
+	out := new(SyncStatusRes)
+	err := c.cc.Invoke(ctx, "/rpc.Apis/GetSyncStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apisClient) GetEvents(ctx context.Context, in *EventsReq, opts ...grpc.CallOption) (*EventsRes, error) {
+	out := new(EventsRes)
+	err := c.cc.Invoke(ctx, "/rpc.Apis/GetEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apisClient) Subscribe(ctx context.Context, in *SubscribeReq, opts ...grpc.CallOption) (Apis_SubscribeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Apis_serviceDesc.Streams[0], "/rpc.Apis/Subscribe", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &apisSubscribeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Apis_SubscribeClient interface {
 	Recv() (*SubscribeRes, error)
 	grpc.ClientStream
 }
@@ -1116,7 +1935,8 @@ func (x *apisSubscribeClient) Recv() (*SubscribeRes, error) {
 	return m, nil
 }
 
-// ApisServer is the server API for Apis service.
+// Server API for Apis service
+
 type ApisServer interface {
 	// get the current height of the blockchain
 	GetHeight(context.Context, *empty.Empty) (*HeightRes, error)
@@ -1138,8 +1958,18 @@ type ApisServer interface {
 	GetState(context.Context, *GetStateReq) (*GetStateRes, error)
 	// receive encoded tx
 	SendRawTx(context.Context, *RawTxReq) (*SendRawTxRes, error)
-	// not supported yet
+	// execute the encoded tx on the head state without sending it
 	EstimateGas(context.Context, *RawTxReq) (*GasRes, error)
+	// re-execute a tx on a fork of the state before its block and trace its execution, the txs of the confirmed blocks out of the state history of the node can't be traced
+	TraceTx(context.Context, *HashReq) (*TraceRes, error)
+	// get the double-sign evidence of witnesses
+	GetEvidence(context.Context, *empty.Empty) (*EvidenceRes, error)
+	// get the produced and missed slots of witnesses
+	GetWitnessStat(context.Context, *empty.Empty) (*WitnessStatRes, error)
+	// get the progress of syncing blocks from the peers
+	GetSyncStatus(context.Context, *empty.Empty) (*SyncStatusRes, error)
+	// get the contract events in the blocks matching the filter
+	GetEvents(context.Context, *EventsReq) (*EventsRes, error)
 	// subscribe an event
 	Subscribe(*SubscribeReq, Apis_SubscribeServer) error
 }
@@ -1346,6 +2176,96 @@ func _Apis_EstimateGas_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Apis_TraceTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HashReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApisServer).TraceTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpc.Apis/TraceTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApisServer).TraceTx(ctx, req.(*HashReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Apis_GetEvidence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApisServer).GetEvidence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpc.Apis/GetEvidence",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApisServer).GetEvidence(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Apis_GetWitnessStat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApisServer).GetWitnessStat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpc.Apis/GetWitnessStat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApisServer).GetWitnessStat(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Apis_GetSyncStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApisServer).GetSyncStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpc.Apis/GetSyncStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApisServer).GetSyncStatus(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Apis_GetEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EventsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApisServer).GetEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpc.Apis/GetEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApisServer).GetEvents(ctx, req.(*EventsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Apis_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(SubscribeReq)
 	if err := stream.RecvMsg(m); err != nil {
@@ -1415,6 +2335,26 @@ var _Apis_serviceDesc = grpc.ServiceDesc{
 			MethodName: "EstimateGas",
 			Handler:    _Apis_EstimateGas_Handler,
 		},
+		{
+			MethodName: "TraceTx",
+			Handler:    _Apis_TraceTx_Handler,
+		},
+		{
+			MethodName: "GetEvidence",
+			Handler:    _Apis_GetEvidence_Handler,
+		},
+		{
+			MethodName: "GetWitnessStat",
+			Handler:    _Apis_GetWitnessStat_Handler,
+		},
+		{
+			MethodName: "GetSyncStatus",
+			Handler:    _Apis_GetSyncStatus_Handler,
+		},
+		{
+			MethodName: "GetEvents",
+			Handler:    _Apis_GetEvents_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -1617,6 +2557,16 @@ func (m *RawTxReq) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintApis(dAtA, i, uint64(len(m.Data)))
 		i += copy(dAtA[i:], m.Data)
 	}
+	if m.Trace {
+		dAtA[i] = 0x10
+		i++
+		if m.Trace {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
@@ -1655,6 +2605,201 @@ func (m *SubscribeReq) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintApis(dAtA, i, uint64(j1))
 		i += copy(dAtA[i:], dAtA2[:j1])
 	}
+	if len(m.Publisher) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintApis(dAtA, i, uint64(len(m.Publisher)))
+		i += copy(dAtA[i:], m.Publisher)
+	}
+	if len(m.Contract) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintApis(dAtA, i, uint64(len(m.Contract)))
+		i += copy(dAtA[i:], m.Contract)
+	}
+	if len(m.EventName) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintApis(dAtA, i, uint64(len(m.EventName)))
+		i += copy(dAtA[i:], m.EventName)
+	}
+	if len(m.EventTopics) > 0 {
+		for _, s := range m.EventTopics {
+			dAtA[i] = 0x2a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *EventsReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventsReq) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.FromBlock != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintApis(dAtA, i, uint64(m.FromBlock))
+	}
+	if m.ToBlock != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintApis(dAtA, i, uint64(m.ToBlock))
+	}
+	if len(m.Contract) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintApis(dAtA, i, uint64(len(m.Contract)))
+		i += copy(dAtA[i:], m.Contract)
+	}
+	if len(m.EventName) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintApis(dAtA, i, uint64(len(m.EventName)))
+		i += copy(dAtA[i:], m.EventName)
+	}
+	if len(m.EventTopics) > 0 {
+		for _, s := range m.EventTopics {
+			dAtA[i] = 0x2a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ContractEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractEvent) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Contract) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApis(dAtA, i, uint64(len(m.Contract)))
+		i += copy(dAtA[i:], m.Contract)
+	}
+	if len(m.Name) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintApis(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if len(m.Topics) > 0 {
+		for _, s := range m.Topics {
+			dAtA[i] = 0x1a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.Data) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintApis(dAtA, i, uint64(len(m.Data)))
+		i += copy(dAtA[i:], m.Data)
+	}
+	if len(m.TxHash) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintApis(dAtA, i, uint64(len(m.TxHash)))
+		i += copy(dAtA[i:], m.TxHash)
+	}
+	if len(m.BlockHash) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintApis(dAtA, i, uint64(len(m.BlockHash)))
+		i += copy(dAtA[i:], m.BlockHash)
+	}
+	if m.BlockNumber != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintApis(dAtA, i, uint64(m.BlockNumber))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *EventsRes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventsRes) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Events) > 0 {
+		for _, msg := range m.Events {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintApis(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
@@ -1814,13 +2959,29 @@ func (m *GasRes) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintApis(dAtA, i, uint64(m.Gas))
 	}
+	if m.TxReceiptRaw != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintApis(dAtA, i, uint64(m.TxReceiptRaw.Size()))
+		n3, err := m.TxReceiptRaw.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
+	}
+	if len(m.Trace) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintApis(dAtA, i, uint64(len(m.Trace)))
+		i += copy(dAtA[i:], m.Trace)
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	return i, nil
 }
 
-func (m *TxRes) Marshal() (dAtA []byte, err error) {
+func (m *TraceRes) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -1830,20 +2991,57 @@ func (m *TxRes) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TxRes) MarshalTo(dAtA []byte) (int, error) {
+func (m *TraceRes) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.TxRaw != nil {
+	if m.TxReceiptRaw != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApis(dAtA, i, uint64(m.TxReceiptRaw.Size()))
+		n4, err := m.TxReceiptRaw.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
+	}
+	if len(m.Trace) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintApis(dAtA, i, uint64(len(m.Trace)))
+		i += copy(dAtA[i:], m.Trace)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *TxRes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxRes) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.TxRaw != nil {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintApis(dAtA, i, uint64(m.TxRaw.Size()))
-		n3, err := m.TxRaw.MarshalTo(dAtA[i:])
+		n5, err := m.TxRaw.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n3
+		i += n5
 	}
 	if len(m.Hash) > 0 {
 		dAtA[i] = 0x12
@@ -1876,11 +3074,11 @@ func (m *TxReceiptRes) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintApis(dAtA, i, uint64(m.TxReceiptRaw.Size()))
-		n4, err := m.TxReceiptRaw.MarshalTo(dAtA[i:])
+		n6, err := m.TxReceiptRaw.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n4
+		i += n6
 	}
 	if len(m.Hash) > 0 {
 		dAtA[i] = 0x12
@@ -1913,11 +3111,11 @@ func (m *BlockInfo) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintApis(dAtA, i, uint64(m.Head.Size()))
-		n5, err := m.Head.MarshalTo(dAtA[i:])
+		n7, err := m.Head.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n5
+		i += n7
 	}
 	if len(m.Hash) > 0 {
 		dAtA[i] = 0x12
@@ -1990,11 +3188,278 @@ func (m *SubscribeRes) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintApis(dAtA, i, uint64(m.Ev.Size()))
-		n6, err := m.Ev.MarshalTo(dAtA[i:])
+		n8, err := m.Ev.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n6
+		i += n8
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *EvidenceInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EvidenceInfo) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Witness) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApis(dAtA, i, uint64(len(m.Witness)))
+		i += copy(dAtA[i:], m.Witness)
+	}
+	if m.Slot != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintApis(dAtA, i, uint64(m.Slot))
+	}
+	if len(m.HashA) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintApis(dAtA, i, uint64(len(m.HashA)))
+		i += copy(dAtA[i:], m.HashA)
+	}
+	if len(m.HashB) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintApis(dAtA, i, uint64(len(m.HashB)))
+		i += copy(dAtA[i:], m.HashB)
+	}
+	if m.Evidence != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintApis(dAtA, i, uint64(m.Evidence.Size()))
+		n9, err := m.Evidence.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n9
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *EvidenceRes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EvidenceRes) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Evidences) > 0 {
+		for _, msg := range m.Evidences {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintApis(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *SlotCount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SlotCount) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Window != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintApis(dAtA, i, uint64(m.Window))
+	}
+	if m.Produced != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintApis(dAtA, i, uint64(m.Produced))
+	}
+	if m.Missed != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintApis(dAtA, i, uint64(m.Missed))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *WitnessStat) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WitnessStat) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Witness) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApis(dAtA, i, uint64(len(m.Witness)))
+		i += copy(dAtA[i:], m.Witness)
+	}
+	if len(m.Counts) > 0 {
+		for _, msg := range m.Counts {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintApis(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *WitnessStatRes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WitnessStatRes) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Stats) > 0 {
+		for _, msg := range m.Stats {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintApis(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *SyncStatusRes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncStatusRes) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Syncing {
+		dAtA[i] = 0x8
+		i++
+		if m.Syncing {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.CurrentHeight != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintApis(dAtA, i, uint64(m.CurrentHeight))
+	}
+	if m.HighestHeight != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintApis(dAtA, i, uint64(m.HighestHeight))
+	}
+	if m.BlocksPerSecond != 0 {
+		dAtA[i] = 0x21
+		i++
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.BlocksPerSecond))))
+		i += 8
+	}
+	if m.RemainingSeconds != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintApis(dAtA, i, uint64(m.RemainingSeconds))
+	}
+	if m.Missions != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintApis(dAtA, i, uint64(m.Missions))
+	}
+	if m.Peers != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintApis(dAtA, i, uint64(m.Peers))
+	}
+	if m.Done {
+		dAtA[i] = 0x40
+		i++
+		if m.Done {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -2113,6 +3578,9 @@ func (m *RawTxReq) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovApis(uint64(l))
 	}
+	if m.Trace {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2132,25 +3600,134 @@ func (m *SubscribeReq) Size() (n int) {
 		}
 		n += 1 + sovApis(uint64(l)) + l
 	}
+	l = len(m.Publisher)
+	if l > 0 {
+		n += 1 + l + sovApis(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovApis(uint64(l))
+	}
+	l = len(m.EventName)
+	if l > 0 {
+		n += 1 + l + sovApis(uint64(l))
+	}
+	if len(m.EventTopics) > 0 {
+		for _, s := range m.EventTopics {
+			l = len(s)
+			n += 1 + l + sovApis(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *HeightRes) Size() (n int) {
+func (m *EventsReq) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Height != 0 {
-		n += 1 + sovApis(uint64(m.Height))
+	if m.FromBlock != 0 {
+		n += 1 + sovApis(uint64(m.FromBlock))
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.ToBlock != 0 {
+		n += 1 + sovApis(uint64(m.ToBlock))
 	}
-	return n
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovApis(uint64(l))
+	}
+	l = len(m.EventName)
+	if l > 0 {
+		n += 1 + l + sovApis(uint64(l))
+	}
+	if len(m.EventTopics) > 0 {
+		for _, s := range m.EventTopics {
+			l = len(s)
+			n += 1 + l + sovApis(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ContractEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovApis(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovApis(uint64(l))
+	}
+	if len(m.Topics) > 0 {
+		for _, s := range m.Topics {
+			l = len(s)
+			n += 1 + l + sovApis(uint64(l))
+		}
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovApis(uint64(l))
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovApis(uint64(l))
+	}
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovApis(uint64(l))
+	}
+	if m.BlockNumber != 0 {
+		n += 1 + sovApis(uint64(m.BlockNumber))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *EventsRes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Events) > 0 {
+		for _, e := range m.Events {
+			l = e.Size()
+			n += 1 + l + sovApis(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *HeightRes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovApis(uint64(m.Height))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
 }
 
 func (m *GetBalanceRes) Size() (n int) {
@@ -2225,6 +3802,34 @@ func (m *GasRes) Size() (n int) {
 	if m.Gas != 0 {
 		n += 1 + sovApis(uint64(m.Gas))
 	}
+	if m.TxReceiptRaw != nil {
+		l = m.TxReceiptRaw.Size()
+		n += 1 + l + sovApis(uint64(l))
+	}
+	l = len(m.Trace)
+	if l > 0 {
+		n += 1 + l + sovApis(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TraceRes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TxReceiptRaw != nil {
+		l = m.TxReceiptRaw.Size()
+		n += 1 + l + sovApis(uint64(l))
+	}
+	l = len(m.Trace)
+	if l > 0 {
+		n += 1 + l + sovApis(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2328,23 +3933,1621 @@ func (m *SubscribeRes) Size() (n int) {
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
-	return n
-}
+	return n
+}
+
+func (m *EvidenceInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Witness)
+	if l > 0 {
+		n += 1 + l + sovApis(uint64(l))
+	}
+	if m.Slot != 0 {
+		n += 1 + sovApis(uint64(m.Slot))
+	}
+	l = len(m.HashA)
+	if l > 0 {
+		n += 1 + l + sovApis(uint64(l))
+	}
+	l = len(m.HashB)
+	if l > 0 {
+		n += 1 + l + sovApis(uint64(l))
+	}
+	if m.Evidence != nil {
+		l = m.Evidence.Size()
+		n += 1 + l + sovApis(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *EvidenceRes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Evidences) > 0 {
+		for _, e := range m.Evidences {
+			l = e.Size()
+			n += 1 + l + sovApis(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SlotCount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Window != 0 {
+		n += 1 + sovApis(uint64(m.Window))
+	}
+	if m.Produced != 0 {
+		n += 1 + sovApis(uint64(m.Produced))
+	}
+	if m.Missed != 0 {
+		n += 1 + sovApis(uint64(m.Missed))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WitnessStat) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Witness)
+	if l > 0 {
+		n += 1 + l + sovApis(uint64(l))
+	}
+	if len(m.Counts) > 0 {
+		for _, e := range m.Counts {
+			l = e.Size()
+			n += 1 + l + sovApis(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WitnessStatRes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Stats) > 0 {
+		for _, e := range m.Stats {
+			l = e.Size()
+			n += 1 + l + sovApis(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SyncStatusRes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Syncing {
+		n += 2
+	}
+	if m.CurrentHeight != 0 {
+		n += 1 + sovApis(uint64(m.CurrentHeight))
+	}
+	if m.HighestHeight != 0 {
+		n += 1 + sovApis(uint64(m.HighestHeight))
+	}
+	if m.BlocksPerSecond != 0 {
+		n += 9
+	}
+	if m.RemainingSeconds != 0 {
+		n += 1 + sovApis(uint64(m.RemainingSeconds))
+	}
+	if m.Missions != 0 {
+		n += 1 + sovApis(uint64(m.Missions))
+	}
+	if m.Peers != 0 {
+		n += 1 + sovApis(uint64(m.Peers))
+	}
+	if m.Done {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovApis(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozApis(x uint64) (n int) {
+	return sovApis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *HashReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HashReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HashReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockByHashReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockByHashReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockByHashReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Complete", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Complete = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockByNumReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockByNumReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockByNumReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Num", wireType)
+			}
+			m.Num = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Num |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Complete", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Complete = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetBalanceReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetBalanceReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetBalanceReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UseLongestChain", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UseLongestChain = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetStateReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetStateReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetStateReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Field = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RawTxReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RawTxReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RawTxReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trace", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Trace = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubscribeReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubscribeReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubscribeReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v event.Event_Topic
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowApis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (event.Event_Topic(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Topics = append(m.Topics, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowApis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthApis
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v event.Event_Topic
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (event.Event_Topic(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Topics = append(m.Topics, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Topics", wireType)
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Publisher", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Publisher = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EventName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventTopics", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EventTopics = append(m.EventTopics, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventsReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventsReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventsReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromBlock", wireType)
+			}
+			m.FromBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromBlock |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToBlock", wireType)
+			}
+			m.ToBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToBlock |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EventName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventTopics", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EventTopics = append(m.EventTopics, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Topics", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Topics = append(m.Topics, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
+			}
+			m.BlockNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockNumber |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventsRes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventsRes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventsRes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Events = append(m.Events, &ContractEvent{})
+			if err := m.Events[len(m.Events)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HeightRes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HeightRes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HeightRes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetBalanceRes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetBalanceRes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetBalanceRes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			m.Balance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Balance |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
 
-func sovApis(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-func sozApis(x uint64) (n int) {
-	return sovApis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *HashReq) Unmarshal(dAtA []byte) error {
+func (m *GetNetIDRes) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2367,15 +5570,15 @@ func (m *HashReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HashReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetNetIDRes: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HashReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetNetIDRes: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2400,7 +5603,7 @@ func (m *HashReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
+			m.ID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2424,7 +5627,7 @@ func (m *HashReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BlockByHashReq) Unmarshal(dAtA []byte) error {
+func (m *GetStateRes) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2447,15 +5650,15 @@ func (m *BlockByHashReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BlockByHashReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetStateRes: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BlockByHashReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetStateRes: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2480,13 +5683,64 @@ func (m *BlockByHashReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Complete", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApis(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var v int
+			if skippy < 0 {
+				return ErrInvalidLengthApis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SendRawTxRes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SendRawTxRes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SendRawTxRes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApis
@@ -2496,12 +5750,21 @@ func (m *BlockByHashReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Complete = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApis(dAtA[iNdEx:])
@@ -2524,7 +5787,7 @@ func (m *BlockByHashReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BlockByNumReq) Unmarshal(dAtA []byte) error {
+func (m *GasRes) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2547,17 +5810,17 @@ func (m *BlockByNumReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BlockByNumReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: GasRes: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BlockByNumReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GasRes: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Num", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
 			}
-			m.Num = 0
+			m.Gas = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApis
@@ -2567,16 +5830,16 @@ func (m *BlockByNumReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Num |= (int64(b) & 0x7F) << shift
+				m.Gas |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Complete", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxReceiptRaw", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApis
@@ -2586,12 +5849,54 @@ func (m *BlockByNumReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Complete = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthApis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TxReceiptRaw == nil {
+				m.TxReceiptRaw = &tx.TxReceiptRaw{}
+			}
+			if err := m.TxReceiptRaw.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Trace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApis(dAtA[iNdEx:])
@@ -2614,7 +5919,7 @@ func (m *BlockByNumReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetBalanceReq) Unmarshal(dAtA []byte) error {
+func (m *TraceRes) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2637,17 +5942,17 @@ func (m *GetBalanceReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetBalanceReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: TraceRes: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetBalanceReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TraceRes: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxReceiptRaw", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApis
@@ -2657,26 +5962,30 @@ func (m *GetBalanceReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
+			if m.TxReceiptRaw == nil {
+				m.TxReceiptRaw = &tx.TxReceiptRaw{}
+			}
+			if err := m.TxReceiptRaw.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UseLongestChain", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trace", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApis
@@ -2686,12 +5995,21 @@ func (m *GetBalanceReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.UseLongestChain = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Trace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApis(dAtA[iNdEx:])
@@ -2714,7 +6032,7 @@ func (m *GetBalanceReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetStateReq) Unmarshal(dAtA []byte) error {
+func (m *TxRes) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2737,17 +6055,17 @@ func (m *GetStateReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetStateReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: txRes: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetStateReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: txRes: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxRaw", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApis
@@ -2757,26 +6075,30 @@ func (m *GetStateReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			if m.TxRaw == nil {
+				m.TxRaw = &tx.TxRaw{}
+			}
+			if err := m.TxRaw.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApis
@@ -2786,20 +6108,22 @@ func (m *GetStateReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			}
+			if byteLen < 0 {
 				return ErrInvalidLengthApis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Field = string(dAtA[iNdEx:postIndex])
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2823,7 +6147,7 @@ func (m *GetStateReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RawTxReq) Unmarshal(dAtA []byte) error {
+func (m *TxReceiptRes) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2846,15 +6170,48 @@ func (m *RawTxReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RawTxReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: txReceiptRes: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RawTxReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: txReceiptRes: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxReceiptRaw", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TxReceiptRaw == nil {
+				m.TxReceiptRaw = &tx.TxReceiptRaw{}
+			}
+			if err := m.TxReceiptRaw.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2878,9 +6235,9 @@ func (m *RawTxReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -2905,7 +6262,7 @@ func (m *RawTxReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SubscribeReq) Unmarshal(dAtA []byte) error {
+func (m *BlockInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2928,134 +6285,172 @@ func (m *SubscribeReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SubscribeReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: BlockInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SubscribeReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BlockInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType == 0 {
-				var v event.Event_Topic
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowApis
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= (event.Event_Topic(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Head", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApis
 				}
-				m.Topics = append(m.Topics, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowApis
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= (int(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthApis
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex > l {
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Head == nil {
+				m.Head = &block.BlockHead{}
+			}
+			if err := m.Head.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApis
+				}
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				if elementCount != 0 && len(m.Topics) == 0 {
-					m.Topics = make([]event.Event_Topic, 0, elementCount)
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				for iNdEx < postIndex {
-					var v event.Event_Topic
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowApis
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= (event.Event_Topic(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Topics = append(m.Topics, v)
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Topics", wireType)
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApis(dAtA[iNdEx:])
-			if err != nil {
+			if msglen < 0 {
+				return ErrInvalidLengthApis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Txs = append(m.Txs, &tx.TxRaw{})
+			if err := m.Txs[len(m.Txs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txhash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
 				return ErrInvalidLengthApis
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *HeightRes) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApis
+			m.Txhash = append(m.Txhash, make([]byte, postIndex-iNdEx))
+			copy(m.Txhash[len(m.Txhash)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receipts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
+			if msglen < 0 {
+				return ErrInvalidLengthApis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Receipts = append(m.Receipts, &tx.TxReceiptRaw{})
+			if err := m.Receipts[len(m.Receipts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: HeightRes: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HeightRes: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiptHash", wireType)
 			}
-			m.Height = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApis
@@ -3065,11 +6460,21 @@ func (m *HeightRes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= (int64(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReceiptHash = append(m.ReceiptHash, make([]byte, postIndex-iNdEx))
+			copy(m.ReceiptHash[len(m.ReceiptHash)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApis(dAtA[iNdEx:])
@@ -3092,7 +6497,7 @@ func (m *HeightRes) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetBalanceRes) Unmarshal(dAtA []byte) error {
+func (m *SubscribeRes) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3115,17 +6520,17 @@ func (m *GetBalanceRes) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetBalanceRes: wiretype end group for non-group")
+			return fmt.Errorf("proto: SubscribeRes: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetBalanceRes: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SubscribeRes: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ev", wireType)
 			}
-			m.Balance = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApis
@@ -3135,11 +6540,25 @@ func (m *GetBalanceRes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Balance |= (int64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthApis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Ev == nil {
+				m.Ev = &event.Event{}
+			}
+			if err := m.Ev.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApis(dAtA[iNdEx:])
@@ -3162,7 +6581,7 @@ func (m *GetBalanceRes) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetNetIDRes) Unmarshal(dAtA []byte) error {
+func (m *EvidenceInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3185,15 +6604,15 @@ func (m *GetNetIDRes) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetNetIDRes: wiretype end group for non-group")
+			return fmt.Errorf("proto: EvidenceInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetNetIDRes: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EvidenceInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Witness", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3218,62 +6637,59 @@ func (m *GetNetIDRes) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
+			m.Witness = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApis(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthApis
+			m.Slot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slot |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HashA", wireType)
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GetStateRes) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApis
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApis
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetStateRes: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetStateRes: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.HashA = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HashB", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3298,7 +6714,40 @@ func (m *GetStateRes) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.HashB = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Evidence", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Evidence == nil {
+				m.Evidence = &block.Evidence{}
+			}
+			if err := m.Evidence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3322,7 +6771,7 @@ func (m *GetStateRes) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SendRawTxRes) Unmarshal(dAtA []byte) error {
+func (m *EvidenceRes) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3345,17 +6794,17 @@ func (m *SendRawTxRes) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SendRawTxRes: wiretype end group for non-group")
+			return fmt.Errorf("proto: EvidenceRes: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SendRawTxRes: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EvidenceRes: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Evidences", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApis
@@ -3365,20 +6814,22 @@ func (m *SendRawTxRes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
+			m.Evidences = append(m.Evidences, &EvidenceInfo{})
+			if err := m.Evidences[len(m.Evidences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3402,7 +6853,7 @@ func (m *SendRawTxRes) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GasRes) Unmarshal(dAtA []byte) error {
+func (m *SlotCount) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3425,17 +6876,55 @@ func (m *GasRes) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GasRes: wiretype end group for non-group")
+			return fmt.Errorf("proto: SlotCount: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GasRes: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SlotCount: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Window |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Produced", wireType)
+			}
+			m.Produced = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Produced |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Missed", wireType)
 			}
-			m.Gas = 0
+			m.Missed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApis
@@ -3445,7 +6934,7 @@ func (m *GasRes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Gas |= (uint64(b) & 0x7F) << shift
+				m.Missed |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3472,7 +6961,7 @@ func (m *GasRes) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TxRes) Unmarshal(dAtA []byte) error {
+func (m *WitnessStat) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3495,17 +6984,17 @@ func (m *TxRes) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: txRes: wiretype end group for non-group")
+			return fmt.Errorf("proto: WitnessStat: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: txRes: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WitnessStat: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxRaw", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Witness", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApis
@@ -3515,30 +7004,26 @@ func (m *TxRes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TxRaw == nil {
-				m.TxRaw = &tx.TxRaw{}
-			}
-			if err := m.TxRaw.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Witness = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Counts", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApis
@@ -3548,21 +7033,21 @@ func (m *TxRes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApis
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
-			if m.Hash == nil {
-				m.Hash = []byte{}
+			m.Counts = append(m.Counts, &SlotCount{})
+			if err := m.Counts[len(m.Counts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -3587,7 +7072,7 @@ func (m *TxRes) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TxReceiptRes) Unmarshal(dAtA []byte) error {
+func (m *WitnessStatRes) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3610,15 +7095,15 @@ func (m *TxReceiptRes) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: txReceiptRes: wiretype end group for non-group")
+			return fmt.Errorf("proto: WitnessStatRes: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: txReceiptRes: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WitnessStatRes: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxReceiptRaw", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3642,44 +7127,11 @@ func (m *TxReceiptRes) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TxReceiptRaw == nil {
-				m.TxReceiptRaw = &tx.TxReceiptRaw{}
-			}
-			if err := m.TxReceiptRaw.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Stats = append(m.Stats, &WitnessStat{})
+			if err := m.Stats[len(m.Stats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthApis
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
-			if m.Hash == nil {
-				m.Hash = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApis(dAtA[iNdEx:])
@@ -3702,7 +7154,7 @@ func (m *TxReceiptRes) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BlockInfo) Unmarshal(dAtA []byte) error {
+func (m *SyncStatusRes) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3725,17 +7177,17 @@ func (m *BlockInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BlockInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: SyncStatusRes: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BlockInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SyncStatusRes: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Head", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Syncing", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApis
@@ -3745,30 +7197,17 @@ func (m *BlockInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthApis
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Head == nil {
-				m.Head = &block.BlockHead{}
-			}
-			if err := m.Head.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Syncing = bool(v != 0)
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentHeight", wireType)
 			}
-			var byteLen int
+			m.CurrentHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApis
@@ -3778,28 +7217,16 @@ func (m *BlockInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				m.CurrentHeight |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthApis
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
-			if m.Hash == nil {
-				m.Hash = []byte{}
-			}
-			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HighestHeight", wireType)
 			}
-			var msglen int
+			m.HighestHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApis
@@ -3809,28 +7236,27 @@ func (m *BlockInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.HighestHeight |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthApis
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlocksPerSecond", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			var v uint64
+			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Txs = append(m.Txs, &tx.TxRaw{})
-			if err := m.Txs[len(m.Txs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Txhash", wireType)
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.BlocksPerSecond = float64(math.Float64frombits(v))
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingSeconds", wireType)
 			}
-			var byteLen int
+			m.RemainingSeconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApis
@@ -3840,26 +7266,16 @@ func (m *BlockInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				m.RemainingSeconds |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthApis
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Txhash = append(m.Txhash, make([]byte, postIndex-iNdEx))
-			copy(m.Txhash[len(m.Txhash)-1], dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Receipts", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Missions", wireType)
 			}
-			var msglen int
+			m.Missions = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApis
@@ -3869,28 +7285,16 @@ func (m *BlockInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.Missions |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthApis
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Receipts = append(m.Receipts, &tx.TxReceiptRaw{})
-			if err := m.Receipts[len(m.Receipts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReceiptHash", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Peers", wireType)
 			}
-			var byteLen int
+			m.Peers = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApis
@@ -3900,77 +7304,16 @@ func (m *BlockInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				m.Peers |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthApis
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ReceiptHash = append(m.ReceiptHash, make([]byte, postIndex-iNdEx))
-			copy(m.ReceiptHash[len(m.ReceiptHash)-1], dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApis(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthApis
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SubscribeRes) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApis
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SubscribeRes: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SubscribeRes: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ev", wireType)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Done", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApis
@@ -3980,25 +7323,12 @@ func (m *SubscribeRes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthApis
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Ev == nil {
-				m.Ev = &event.Event{}
-			}
-			if err := m.Ev.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Done = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApis(dAtA[iNdEx:])
@@ -4126,70 +7456,108 @@ var (
 	ErrIntOverflowApis   = fmt.Errorf("proto: integer overflow")
 )
 
-func init() { proto.RegisterFile("rpc/apis.proto", fileDescriptor_apis_94efd193c39410bc) }
-
-var fileDescriptor_apis_94efd193c39410bc = []byte{
-	// 992 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x95, 0xcd, 0x6e, 0x23, 0x45,
-	0x10, 0xc7, 0x19, 0x3b, 0x5f, 0x2e, 0x3b, 0x4e, 0xd2, 0xfb, 0x81, 0x35, 0xc9, 0x7a, 0xbd, 0x9d,
-	0x48, 0x64, 0xa3, 0xcd, 0x0c, 0x0a, 0x70, 0x20, 0x02, 0x01, 0xc1, 0x91, 0x63, 0x84, 0x02, 0x9a,
-	0xf8, 0x00, 0x12, 0x48, 0xdb, 0x1e, 0xb7, 0xed, 0x51, 0xec, 0x99, 0xc1, 0xdd, 0xce, 0x3a, 0xb2,
-	0x7c, 0xe1, 0x15, 0xb8, 0xf0, 0x48, 0x88, 0x13, 0x12, 0x27, 0x6e, 0x28, 0xf0, 0x20, 0xa8, 0xab,
-	0x7b, 0x26, 0x1e, 0x27, 0xac, 0x60, 0x2f, 0x56, 0x57, 0x75, 0xfd, 0x7f, 0xdd, 0x5d, 0xa9, 0xf9,
-	0x07, 0xca, 0xa3, 0xd8, 0x77, 0x59, 0x1c, 0x08, 0x27, 0x1e, 0x45, 0x32, 0x22, 0xf9, 0x51, 0xec,
-	0xdb, 0x1f, 0xf6, 0x02, 0xd9, 0x1f, 0xb7, 0x1d, 0x3f, 0x1a, 0xba, 0x41, 0x24, 0xe4, 0x61, 0xd4,
-	0xed, 0x06, 0x7e, 0xc0, 0x06, 0x6e, 0x23, 0x3a, 0x6c, 0x7e, 0x75, 0x71, 0xf8, 0xb5, 0xaa, 0xf5,
-	0xa3, 0x81, 0xeb, 0x47, 0x23, 0xee, 0xca, 0x89, 0x2b, 0x27, 0x5a, 0x6f, 0x7f, 0xf2, 0xff, 0xa4,
-	0xed, 0x41, 0xe4, 0x5f, 0xea, 0xdf, 0x37, 0x03, 0xf0, 0x2b, 0x1e, 0x4a, 0xfd, 0x6b, 0x00, 0x3b,
-	0xbd, 0x28, 0xea, 0x0d, 0xb8, 0x7a, 0x94, 0xcb, 0xc2, 0x30, 0x92, 0x4c, 0x06, 0x51, 0x68, 0xde,
-	0x67, 0x6f, 0x9b, 0x5d, 0x8c, 0xda, 0xe3, 0xae, 0x7b, 0x3a, 0x8c, 0xe5, 0xb5, 0xde, 0xa4, 0x4f,
-	0x60, 0xf5, 0x8c, 0x89, 0xbe, 0xc7, 0x7f, 0x20, 0x04, 0x96, 0xfa, 0x4c, 0xf4, 0x2b, 0x56, 0xcd,
-	0xda, 0x2f, 0x78, 0xb8, 0xa6, 0x9f, 0x42, 0xf9, 0x44, 0xdd, 0xf4, 0xe4, 0xfa, 0x35, 0x55, 0xc4,
-	0x86, 0x35, 0x3f, 0x1a, 0xc6, 0x03, 0x2e, 0x79, 0x25, 0x57, 0xb3, 0xf6, 0xd7, 0xbc, 0x34, 0xa6,
-	0x1f, 0xc3, 0xba, 0x21, 0x9c, 0x8f, 0x87, 0x0a, 0xb0, 0x09, 0xf9, 0x70, 0x3c, 0x44, 0x7d, 0xde,
-	0x53, 0xcb, 0xd7, 0xca, 0x9b, 0xb0, 0xde, 0xe0, 0xf2, 0x84, 0x0d, 0x58, 0xe8, 0x73, 0x25, 0x2f,
-	0x43, 0xae, 0x59, 0x37, 0xa7, 0xe7, 0x9a, 0x75, 0xb2, 0x0f, 0x1b, 0x63, 0xc1, 0xbf, 0x8c, 0xc2,
-	0x1e, 0x17, 0xf2, 0xf3, 0x3e, 0x0b, 0x42, 0xc3, 0x58, 0x4c, 0xd3, 0x0f, 0xa0, 0xd8, 0xe0, 0xf2,
-	0x42, 0x32, 0xc9, 0xcd, 0x3d, 0x2e, 0xf9, 0xb5, 0x21, 0xa9, 0x25, 0x79, 0x08, 0xcb, 0xdd, 0x80,
-	0x0f, 0x3a, 0x08, 0x28, 0x78, 0x3a, 0xa0, 0x55, 0x58, 0xf3, 0xd8, 0xab, 0xd6, 0xc4, 0x3c, 0xbe,
-	0xc3, 0x24, 0x43, 0x51, 0xc9, 0xc3, 0x35, 0x3d, 0x86, 0xd2, 0xc5, 0xb8, 0x2d, 0xfc, 0x51, 0xd0,
-	0x46, 0xee, 0x01, 0xac, 0xc8, 0x28, 0x0e, 0x7c, 0x51, 0xb1, 0x6a, 0xf9, 0xfd, 0xf2, 0x11, 0x71,
-	0xf4, 0x9f, 0xea, 0x14, 0x7f, 0x5b, 0x6a, 0xcb, 0x33, 0x15, 0x74, 0x17, 0x0a, 0x67, 0x3c, 0xe8,
-	0xf5, 0xa5, 0xc7, 0x05, 0x79, 0x0c, 0x2b, 0x7d, 0x0c, 0x4c, 0x6f, 0x4c, 0x44, 0x9f, 0x67, 0x5b,
-	0x20, 0x48, 0x05, 0x56, 0xdb, 0x3a, 0x32, 0x95, 0x49, 0x48, 0x9f, 0xe0, 0x13, 0xcf, 0xb9, 0x6c,
-	0xd6, 0x55, 0xe1, 0x42, 0xaf, 0xe8, 0xee, 0x7c, 0x07, 0x84, 0x7a, 0xef, 0x15, 0x1b, 0x8c, 0xb9,
-	0xa9, 0xd0, 0x01, 0xa5, 0x50, 0xba, 0xe0, 0x61, 0xc7, 0xbc, 0x59, 0xdc, 0x3b, 0x16, 0x36, 0xac,
-	0x34, 0x98, 0x50, 0xbb, 0x9b, 0x90, 0xef, 0x31, 0x81, 0x9b, 0x4b, 0x9e, 0x5a, 0xd2, 0x8f, 0x60,
-	0x59, 0xa2, 0xf0, 0x29, 0x2e, 0xd8, 0x2b, 0xdc, 0x2c, 0x1e, 0x15, 0x1c, 0x39, 0x71, 0x5a, 0x2a,
-	0xe1, 0xe9, 0x7c, 0x4a, 0xce, 0xe9, 0x6e, 0x22, 0xf9, 0x1b, 0x28, 0x29, 0xb5, 0xcf, 0x83, 0x18,
-	0x9b, 0xf2, 0xfe, 0x7c, 0x9c, 0xb2, 0x36, 0x0d, 0x2b, 0xcd, 0x7b, 0x99, 0xaa, 0x7b, 0xc9, 0xbf,
-	0x5a, 0x50, 0xc0, 0x49, 0x6c, 0x86, 0xdd, 0x88, 0xec, 0xc1, 0x52, 0x9f, 0xb3, 0x4e, 0xca, 0xd3,
-	0xdf, 0x23, 0xee, 0x9f, 0x71, 0xd6, 0xf1, 0x70, 0xf7, 0x3e, 0x0e, 0xd9, 0x86, 0xbc, 0x9c, 0x88,
-	0x4a, 0xbe, 0x96, 0xcf, 0x3e, 0x4a, 0x65, 0xd5, 0xdf, 0x50, 0x4e, 0x50, 0xb2, 0x54, 0xcb, 0xef,
-	0x97, 0x3c, 0x13, 0x91, 0x17, 0xb0, 0x36, 0xd2, 0xd7, 0x13, 0x95, 0x65, 0x54, 0xde, 0x7d, 0x42,
-	0x5a, 0x41, 0x6a, 0x50, 0x34, 0x6b, 0xf5, 0xd5, 0x55, 0x56, 0x10, 0x35, 0x9f, 0xa2, 0x2f, 0x32,
-	0x43, 0x27, 0xc8, 0x0e, 0xe4, 0xf8, 0x95, 0x79, 0x4c, 0x69, 0x7e, 0xe0, 0xbc, 0x1c, 0xbf, 0x3a,
-	0xfa, 0x63, 0x15, 0x96, 0x3e, 0x8b, 0x03, 0x41, 0x1a, 0x50, 0x68, 0x70, 0xa9, 0x47, 0x8e, 0x3c,
-	0x76, 0xb4, 0x31, 0x38, 0x89, 0x31, 0x38, 0x68, 0x0c, 0x76, 0xd9, 0x19, 0xc5, 0xbe, 0x93, 0xce,
-	0x25, 0x25, 0x3f, 0xfe, 0xfe, 0xf7, 0x4f, 0xb9, 0x12, 0x01, 0xb7, 0x97, 0x6a, 0xeb, 0x38, 0x49,
-	0xad, 0x89, 0xf6, 0x05, 0x52, 0xd2, 0x12, 0x6d, 0x11, 0x36, 0x60, 0x84, 0x43, 0x40, 0xb7, 0x51,
-	0xfc, 0x88, 0x3c, 0x50, 0xe2, 0xa4, 0xde, 0x9d, 0xaa, 0xa6, 0xcc, 0xc8, 0xb7, 0x40, 0x90, 0x62,
-	0x9a, 0x70, 0x2f, 0x6c, 0x2b, 0x85, 0x25, 0x33, 0x41, 0x29, 0x32, 0x77, 0x88, 0xad, 0x99, 0x19,
-	0x75, 0x82, 0xfe, 0x1e, 0x1e, 0x66, 0xd1, 0xad, 0xc9, 0x7f, 0x83, 0xef, 0x21, 0xbc, 0x4a, 0x76,
-	0x16, 0xe0, 0x5a, 0x9f, 0xe0, 0x5f, 0x42, 0x59, 0x7d, 0x93, 0xb7, 0xd6, 0x48, 0x1e, 0x20, 0x2a,
-	0x6b, 0x96, 0xa6, 0x95, 0xe9, 0xd4, 0xd1, 0xe7, 0x08, 0xdf, 0x25, 0xcf, 0x14, 0x7c, 0xae, 0xd6,
-	0x60, 0xdd, 0x69, 0xe2, 0x7b, 0x33, 0xf2, 0x1d, 0xac, 0xdf, 0xd6, 0x9c, 0x8f, 0x87, 0x84, 0xcc,
-	0x1f, 0xa0, 0xbd, 0xf4, 0x0e, 0xff, 0x1d, 0xe4, 0x3f, 0x23, 0x4f, 0xdd, 0x8c, 0xd6, 0x9d, 0x86,
-	0xe3, 0x61, 0x86, 0xfe, 0x12, 0xe0, 0xd6, 0x53, 0x0c, 0x3a, 0xe3, 0xb3, 0xf6, 0xdd, 0x9c, 0xa0,
-	0x07, 0x88, 0xdf, 0x23, 0x14, 0xf1, 0x3a, 0xef, 0x4e, 0x9b, 0xf5, 0x99, 0x3b, 0x5d, 0x30, 0xdb,
-	0x19, 0x69, 0xc0, 0x5a, 0x62, 0x45, 0xff, 0x3a, 0x69, 0x9b, 0xc9, 0x19, 0x89, 0x63, 0xd1, 0x2d,
-	0x3c, 0xa1, 0x48, 0x0a, 0xea, 0x04, 0x2d, 0xd6, 0x20, 0x34, 0x2d, 0x92, 0x0a, 0x12, 0x17, 0xb7,
-	0x17, 0x33, 0x82, 0xbe, 0x8d, 0x88, 0x2d, 0xb2, 0xa1, 0x10, 0x98, 0x75, 0xa7, 0x97, 0xfc, 0x7a,
-	0x46, 0xea, 0x50, 0x48, 0x8d, 0x8d, 0xac, 0xa3, 0x2e, 0x31, 0x76, 0x33, 0x08, 0xf3, 0xbe, 0x47,
-	0x1f, 0x21, 0x67, 0x83, 0x82, 0x2b, 0x92, 0xf4, 0xb1, 0x75, 0x40, 0x4e, 0xa0, 0x78, 0x2a, 0x64,
-	0x30, 0x64, 0x92, 0x37, 0x98, 0x58, 0xe4, 0x14, 0xf5, 0x75, 0xd0, 0x1b, 0x93, 0x9b, 0xd0, 0x92,
-	0xcb, 0x6f, 0x15, 0x8a, 0xf1, 0x05, 0x14, 0xd2, 0xaf, 0x97, 0x98, 0xa3, 0xe7, 0xfe, 0x85, 0xd8,
-	0x77, 0x52, 0x99, 0xdb, 0x24, 0xe9, 0x63, 0xeb, 0xe0, 0x5d, 0xeb, 0x64, 0xf3, 0x97, 0x9b, 0xaa,
-	0xf5, 0xdb, 0x4d, 0xd5, 0xfa, 0xf3, 0xa6, 0x6a, 0xfd, 0xfc, 0x57, 0xf5, 0xad, 0xf6, 0x0a, 0x76,
-	0xf9, 0xbd, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x6d, 0x6e, 0xb1, 0x30, 0xe8, 0x08, 0x00, 0x00,
+func init() { proto.RegisterFile("rpc/apis.proto", fileDescriptor_apis_844e2e6382aee696) }
+
+var fileDescriptor_apis_844e2e6382aee696 = []byte{
+	// 1588 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xdf, 0x6e, 0x1b, 0x45,
+	0x17, 0xff, 0xd6, 0xce, 0x1f, 0xef, 0xb1, 0xe3, 0x38, 0xd3, 0x36, 0x9f, 0xe5, 0xa6, 0x69, 0x3a,
+	0x8d, 0xfa, 0xa5, 0xf9, 0x5a, 0x2f, 0x84, 0xa2, 0xa2, 0x8a, 0x7f, 0x4d, 0x13, 0xa5, 0x41, 0x10,
+	0xd0, 0xda, 0x6a, 0x41, 0x02, 0xa9, 0xeb, 0xf5, 0x78, 0xbd, 0xaa, 0xbd, 0x6b, 0x76, 0x66, 0x13,
+	0x47, 0x51, 0x6e, 0x78, 0x03, 0x84, 0x90, 0xb8, 0xe3, 0x25, 0x78, 0x03, 0x6e, 0x10, 0x57, 0x48,
+	0xdc, 0x71, 0x85, 0x0a, 0x0f, 0x82, 0xe6, 0xcc, 0xec, 0x7a, 0xd7, 0x49, 0x4b, 0x8b, 0xc4, 0xcd,
+	0x6a, 0xce, 0x99, 0x73, 0x7e, 0x73, 0xce, 0x9c, 0x3f, 0x73, 0x16, 0xaa, 0xd1, 0xc8, 0xb5, 0x9c,
+	0x91, 0xcf, 0x9b, 0xa3, 0x28, 0x14, 0x21, 0x29, 0x46, 0x23, 0xb7, 0xf1, 0xba, 0xe7, 0x8b, 0x7e,
+	0xdc, 0x69, 0xba, 0xe1, 0xd0, 0xf2, 0x43, 0x2e, 0x6e, 0x87, 0xbd, 0x9e, 0xef, 0xfa, 0xce, 0xc0,
+	0xf2, 0xc2, 0xdb, 0x92, 0x61, 0xb9, 0x61, 0xc4, 0x2c, 0x31, 0xb6, 0xc4, 0x58, 0xe9, 0x35, 0xee,
+	0xbe, 0x9c, 0x4a, 0x67, 0x10, 0xba, 0x4f, 0xd5, 0xf7, 0xd5, 0x14, 0xd9, 0x21, 0x0b, 0x84, 0xfa,
+	0x6a, 0xc5, 0x15, 0x2f, 0x0c, 0xbd, 0x01, 0x93, 0xc6, 0x5b, 0x4e, 0x10, 0x84, 0xc2, 0x11, 0x7e,
+	0x18, 0x68, 0x3f, 0x1a, 0x97, 0xf5, 0x2e, 0x52, 0x9d, 0xb8, 0x67, 0xed, 0x0e, 0x47, 0xe2, 0x58,
+	0x6d, 0xd2, 0x2b, 0x30, 0xff, 0xd0, 0xe1, 0x7d, 0x9b, 0x7d, 0x49, 0x08, 0xcc, 0xf4, 0x1d, 0xde,
+	0xaf, 0x1b, 0x6b, 0xc6, 0x86, 0x69, 0xe3, 0x9a, 0xbe, 0x0f, 0xd5, 0x6d, 0x69, 0xe1, 0xf6, 0xf1,
+	0x0b, 0xa4, 0x48, 0x03, 0x4a, 0x6e, 0x38, 0x1c, 0x0d, 0x98, 0x60, 0xf5, 0xc2, 0x9a, 0xb1, 0x51,
+	0xb2, 0x53, 0x9a, 0xbe, 0x03, 0x0b, 0x1a, 0xe1, 0x20, 0x1e, 0x4a, 0x80, 0x1a, 0x14, 0x83, 0x78,
+	0x88, 0xfa, 0x45, 0x5b, 0x2e, 0x5f, 0xa8, 0xbe, 0x0f, 0x0b, 0x7b, 0x4c, 0x6c, 0x3b, 0x03, 0x27,
+	0x70, 0x99, 0x54, 0xaf, 0x42, 0x61, 0x7f, 0x47, 0x9f, 0x5e, 0xd8, 0xdf, 0x21, 0x1b, 0xb0, 0x18,
+	0x73, 0xf6, 0x61, 0x18, 0x78, 0x8c, 0x8b, 0x07, 0x7d, 0xc7, 0x0f, 0x34, 0xc6, 0x34, 0x9b, 0xbe,
+	0x09, 0xe5, 0x3d, 0x26, 0x5a, 0xc2, 0x11, 0x4c, 0xdb, 0xf1, 0x94, 0x1d, 0x6b, 0x24, 0xb9, 0x24,
+	0x17, 0x61, 0xb6, 0xe7, 0xb3, 0x41, 0x17, 0x01, 0x4c, 0x5b, 0x11, 0xf4, 0x0e, 0x94, 0x6c, 0xe7,
+	0xa8, 0x3d, 0xd6, 0xce, 0x77, 0x1d, 0xe1, 0xa0, 0x52, 0xc5, 0xc6, 0xb5, 0xd4, 0x12, 0x91, 0xe3,
+	0x26, 0xa6, 0x2b, 0x82, 0xfe, 0x60, 0x40, 0xa5, 0x15, 0x77, 0xb8, 0x1b, 0xf9, 0x1d, 0x3c, 0x6e,
+	0x13, 0xe6, 0x44, 0x38, 0xf2, 0x5d, 0x5e, 0x37, 0xd6, 0x8a, 0x1b, 0xd5, 0x2d, 0xd2, 0x54, 0x11,
+	0xdc, 0xc5, 0x6f, 0x5b, 0x6e, 0xd9, 0x5a, 0x82, 0xac, 0x80, 0x39, 0x8a, 0x3b, 0x03, 0x9f, 0xf7,
+	0x59, 0xa4, 0x8d, 0x99, 0x30, 0xd4, 0x75, 0x05, 0xf2, 0x18, 0x51, 0x2f, 0xe2, 0x66, 0x4a, 0x4b,
+	0x4d, 0x84, 0x3d, 0x70, 0x86, 0xac, 0x3e, 0xa3, 0x34, 0x53, 0x06, 0x59, 0x83, 0x32, 0x12, 0x6d,
+	0x65, 0xc8, 0xec, 0x5a, 0x71, 0xc3, 0xb4, 0xb3, 0x2c, 0xfa, 0xbd, 0x01, 0x26, 0x5a, 0xc4, 0xa5,
+	0xcd, 0x2b, 0x60, 0xf6, 0xa2, 0x70, 0x88, 0xf1, 0xd3, 0x01, 0x9b, 0x30, 0x48, 0x1d, 0xe6, 0x45,
+	0xa8, 0xf6, 0x0a, 0xb8, 0x97, 0x90, 0xff, 0xaa, 0x85, 0x3f, 0x1a, 0xb0, 0xf0, 0x40, 0x83, 0xa1,
+	0xa5, 0xb9, 0xd3, 0x8c, 0xa9, 0xd3, 0x08, 0xcc, 0x04, 0xf2, 0x20, 0x75, 0x89, 0xb8, 0x26, 0xcb,
+	0x69, 0x24, 0x8a, 0x08, 0xaf, 0xa9, 0x34, 0xb8, 0xca, 0x28, 0x5c, 0xa3, 0xec, 0x58, 0xa6, 0x7e,
+	0x7d, 0x16, 0xb9, 0x9a, 0x92, 0x5e, 0x60, 0xe5, 0xe2, 0xd6, 0x9c, 0xf2, 0x22, 0x65, 0x48, 0x2f,
+	0x90, 0x38, 0x88, 0x87, 0x1d, 0x16, 0xd5, 0xe7, 0xf1, 0x76, 0xb2, 0x2c, 0x7a, 0x77, 0x72, 0xcd,
+	0x5c, 0xa6, 0x06, 0x7a, 0xa8, 0x52, 0xa3, 0xbc, 0x45, 0x9a, 0xd1, 0xc8, 0x6d, 0xe6, 0x9c, 0xb4,
+	0xb5, 0x04, 0xbd, 0x0e, 0xe6, 0x43, 0xe6, 0x7b, 0x7d, 0x21, 0x15, 0x97, 0x61, 0xae, 0x8f, 0x84,
+	0x0e, 0x8e, 0xa6, 0xe8, 0xcd, 0x7c, 0xd1, 0x70, 0x19, 0xaa, 0x8e, 0xa2, 0xb4, 0x64, 0x42, 0xd2,
+	0x2b, 0x58, 0x14, 0x07, 0x4c, 0xec, 0xef, 0x48, 0xc1, 0xa9, 0xea, 0xa2, 0xd7, 0xb3, 0x35, 0xc3,
+	0x65, 0xae, 0x1f, 0x3a, 0x83, 0x98, 0x69, 0x09, 0x45, 0x50, 0x0a, 0x95, 0x16, 0x0b, 0xba, 0xba,
+	0x4a, 0xf8, 0xb9, 0x8d, 0xa4, 0x07, 0x73, 0x7b, 0x0e, 0x7a, 0x5b, 0x83, 0xa2, 0xe7, 0x70, 0xdc,
+	0x9c, 0xb1, 0xe5, 0x92, 0xdc, 0x81, 0x8a, 0x18, 0xdb, 0xcc, 0x65, 0xfe, 0x48, 0xd8, 0xce, 0x11,
+	0x06, 0xab, 0xbc, 0x55, 0x6b, 0x8a, 0x71, 0xb3, 0x9d, 0xe1, 0xdb, 0x39, 0xa9, 0x49, 0xdd, 0xa9,
+	0x0c, 0x53, 0x04, 0x7d, 0x04, 0xa5, 0xb6, 0x5c, 0xd8, 0xec, 0x2c, 0xae, 0xf1, 0x6a, 0xb8, 0x85,
+	0x2c, 0xee, 0xdb, 0x30, 0x2b, 0xd0, 0xb9, 0xab, 0xb8, 0x48, 0xd1, 0x4c, 0x8d, 0xe6, 0x1c, 0xd9,
+	0x8a, 0x9f, 0x7a, 0x5f, 0x50, 0x3d, 0x02, 0xbd, 0xff, 0x34, 0x6b, 0xc9, 0x3f, 0xb6, 0xec, 0x3c,
+	0xe4, 0x9f, 0x0d, 0x30, 0xb1, 0xe8, 0xf6, 0x83, 0x5e, 0x48, 0xd6, 0x61, 0xa6, 0xcf, 0x9c, 0x6e,
+	0x8a, 0xa7, 0x5e, 0x17, 0xdc, 0x7f, 0xc8, 0x9c, 0xae, 0x8d, 0xbb, 0xe7, 0xe1, 0x90, 0xcb, 0x50,
+	0x14, 0x63, 0x55, 0x11, 0x39, 0xa7, 0x24, 0x57, 0x55, 0x01, 0xaa, 0xcc, 0xac, 0x15, 0x37, 0x2a,
+	0xb6, 0xa6, 0xc8, 0x2d, 0x28, 0x45, 0xca, 0x3c, 0x55, 0xaa, 0xe7, 0xb9, 0x90, 0x4a, 0xc8, 0xaa,
+	0xd0, 0x6b, 0x5d, 0x35, 0x12, 0x2a, 0xcb, 0xa2, 0xb7, 0x72, 0x3d, 0x53, 0xf6, 0xc1, 0x02, 0x3b,
+	0xd4, 0xce, 0x54, 0xb2, 0xfd, 0xd2, 0x2e, 0xb0, 0x43, 0xfa, 0xad, 0x01, 0x95, 0xdd, 0x43, 0xbf,
+	0xcb, 0x02, 0x97, 0xa1, 0xf7, 0x75, 0x98, 0x3f, 0xf2, 0x45, 0xc0, 0x38, 0xd7, 0xa9, 0x97, 0x90,
+	0xd2, 0x63, 0x3e, 0x08, 0x85, 0xee, 0x53, 0xb8, 0x96, 0x71, 0x96, 0x4e, 0xdc, 0x4f, 0xf2, 0x07,
+	0x89, 0x84, 0xbb, 0xad, 0xbb, 0x80, 0x22, 0xc8, 0xff, 0xa1, 0xc4, 0xf4, 0x49, 0xd8, 0x08, 0xca,
+	0x5b, 0x8b, 0xfa, 0x6e, 0x13, 0x03, 0xec, 0x54, 0x80, 0xbe, 0x0b, 0xe5, 0x94, 0xcb, 0x38, 0xb1,
+	0x64, 0xc3, 0x53, 0x64, 0x52, 0xe0, 0x4b, 0x58, 0xe0, 0x59, 0xdb, 0xed, 0x89, 0x0c, 0x7d, 0x0c,
+	0x66, 0x6b, 0x10, 0x8a, 0x07, 0x61, 0x1c, 0x08, 0x79, 0xf5, 0x47, 0x7e, 0xd0, 0x0d, 0x8f, 0x92,
+	0x12, 0x57, 0x94, 0x6c, 0x7a, 0xa3, 0x28, 0xec, 0xc6, 0x2e, 0xeb, 0x6a, 0xaf, 0x52, 0x5a, 0xea,
+	0x0c, 0x7d, 0xce, 0x59, 0x17, 0x5d, 0x2b, 0xda, 0x9a, 0xa2, 0x1f, 0x43, 0xf9, 0xb1, 0xba, 0x10,
+	0x59, 0xd0, 0x2f, 0xb8, 0xae, 0x1b, 0x30, 0xe7, 0xca, 0xd3, 0x79, 0xbd, 0x80, 0xf6, 0x56, 0xd1,
+	0xde, 0xd4, 0x28, 0x5b, 0xef, 0xd2, 0xb7, 0xa0, 0x9a, 0x01, 0x94, 0xce, 0xde, 0x80, 0x59, 0x2e,
+	0x9c, 0xb4, 0x93, 0xd5, 0x50, 0x31, 0x2b, 0xa3, 0xb6, 0xe9, 0xd7, 0x05, 0x58, 0x68, 0x1d, 0x07,
+	0xae, 0xe4, 0xc5, 0x5c, 0xb7, 0x28, 0x7e, 0x1c, 0xb8, 0x7e, 0xe0, 0xa1, 0x35, 0x25, 0x3b, 0x21,
+	0xc9, 0x3a, 0x2c, 0xb8, 0x71, 0x14, 0xb1, 0x40, 0xa8, 0xce, 0xa7, 0xfd, 0xcd, 0x33, 0xa5, 0x54,
+	0xdf, 0xf7, 0xfa, 0x8c, 0x27, 0x52, 0xca, 0xf7, 0x3c, 0x53, 0x4e, 0x0b, 0x18, 0x37, 0xfe, 0x09,
+	0x8b, 0x5a, 0xcc, 0x0d, 0x83, 0x2e, 0x06, 0xda, 0xb0, 0xa7, 0xd9, 0x64, 0x13, 0x6a, 0x11, 0x1b,
+	0x3a, 0x7e, 0xe0, 0x07, 0x9e, 0x62, 0x71, 0x0c, 0x7d, 0xd1, 0x3e, 0xc3, 0x97, 0xc1, 0x90, 0x57,
+	0x2c, 0x67, 0x2e, 0x7c, 0x0c, 0x8a, 0x76, 0x4a, 0xcb, 0x84, 0x1a, 0x31, 0x16, 0x71, 0xfd, 0x0a,
+	0x28, 0x02, 0xdf, 0x9a, 0x30, 0x60, 0xf5, 0x12, 0xba, 0x8a, 0xeb, 0xad, 0xdf, 0x00, 0x66, 0xee,
+	0x8f, 0x7c, 0x4e, 0xf6, 0xc0, 0xdc, 0x63, 0x89, 0xc5, 0xcb, 0x4d, 0x35, 0xbe, 0x35, 0x93, 0xf1,
+	0xad, 0x89, 0xe3, 0x5b, 0x43, 0xc5, 0x24, 0x7d, 0x0b, 0x28, 0xf9, 0xea, 0xd7, 0x3f, 0xbf, 0x29,
+	0x54, 0x08, 0x58, 0x5e, 0xaa, 0xbb, 0x83, 0xdd, 0xbb, 0x3d, 0x56, 0xd3, 0x1b, 0xa9, 0x28, 0x15,
+	0x35, 0xc8, 0x35, 0x00, 0x29, 0x6c, 0x6a, 0xf4, 0x32, 0x2a, 0x5f, 0x22, 0x17, 0x2c, 0x6f, 0x22,
+	0x6f, 0x9d, 0xc8, 0xe4, 0x3f, 0x25, 0x9f, 0x01, 0x41, 0x14, 0x5d, 0xd4, 0xe7, 0x82, 0x2d, 0xa5,
+	0x60, 0x49, 0x8f, 0xa3, 0x14, 0x31, 0x57, 0x48, 0x43, 0x61, 0xe6, 0xb4, 0x13, 0xe8, 0x2f, 0xe0,
+	0x62, 0x1e, 0xba, 0x3d, 0x7e, 0x39, 0xf0, 0x75, 0x04, 0x5f, 0x25, 0x2b, 0x96, 0x77, 0x8e, 0x7e,
+	0x02, 0xff, 0x04, 0xaa, 0xf2, 0x1d, 0x9c, 0x0c, 0xb0, 0xe4, 0x02, 0x42, 0xe5, 0x47, 0xda, 0x46,
+	0x75, 0xc2, 0x94, 0xb5, 0x48, 0x6f, 0x22, 0xf8, 0x75, 0x72, 0x4d, 0x82, 0x67, 0x64, 0x35, 0xac,
+	0x75, 0x92, 0x4c, 0xa7, 0xa7, 0xe4, 0x73, 0x58, 0x98, 0xc8, 0x1c, 0xc4, 0x43, 0x42, 0xb2, 0x07,
+	0xa8, 0x89, 0xf7, 0x0c, 0xfe, 0xff, 0x10, 0xff, 0x1a, 0xb9, 0x6a, 0xe5, 0x74, 0xad, 0x93, 0x20,
+	0x1e, 0xe6, 0xd0, 0x9f, 0x00, 0x4c, 0xde, 0x71, 0x0d, 0x9d, 0x9b, 0x86, 0x1b, 0x67, 0x79, 0x9c,
+	0x6e, 0x22, 0xfc, 0x3a, 0xa1, 0x96, 0x97, 0xf2, 0xad, 0x93, 0xfd, 0x9d, 0x53, 0xeb, 0x64, 0x6a,
+	0x24, 0x3e, 0x25, 0x7b, 0x50, 0x4a, 0x9e, 0xff, 0xe7, 0x66, 0x5a, 0x2d, 0x39, 0x23, 0x99, 0x12,
+	0xe8, 0x12, 0x9e, 0x50, 0x26, 0xa6, 0xe5, 0x69, 0xae, 0x06, 0xc2, 0x41, 0x81, 0xa4, 0x0a, 0xc9,
+	0xac, 0xdd, 0x98, 0xe6, 0x70, 0xfa, 0x5f, 0x84, 0x58, 0x22, 0x8b, 0x96, 0xa7, 0xb9, 0xd6, 0xc9,
+	0x53, 0x76, 0x7c, 0x4a, 0x76, 0xc0, 0x4c, 0x87, 0x09, 0xb2, 0x80, 0x7a, 0xc9, 0xf8, 0xad, 0x13,
+	0x21, 0x3b, 0x6b, 0xd0, 0x4b, 0x88, 0xb3, 0x78, 0xcf, 0xd8, 0xa4, 0x60, 0xf1, 0x54, 0x71, 0x1b,
+	0xca, 0xbb, 0x5c, 0xf8, 0x43, 0x47, 0xb0, 0x3d, 0x87, 0x4f, 0xe3, 0x94, 0x95, 0x39, 0x0e, 0xcf,
+	0x58, 0x22, 0x11, 0x2a, 0x16, 0xcb, 0x28, 0xbd, 0x07, 0xf3, 0x38, 0x4a, 0xb4, 0xc7, 0x53, 0xf9,
+	0xa8, 0xd0, 0x92, 0x31, 0x23, 0xe3, 0x8a, 0x50, 0xe2, 0x49, 0xfa, 0x7d, 0x84, 0xe5, 0x97, 0xb4,
+	0xf9, 0xbf, 0xb9, 0xdf, 0xcc, 0x93, 0x41, 0x2f, 0x22, 0x62, 0x95, 0x54, 0xe4, 0xe5, 0xa4, 0xfa,
+	0x8f, 0x30, 0x9b, 0xb3, 0x1d, 0xfc, 0x79, 0x88, 0x17, 0xce, 0xb4, 0xdd, 0xe9, 0x1b, 0xcf, 0xa2,
+	0xb4, 0x70, 0x5a, 0x9c, 0x74, 0xe3, 0xe7, 0xc2, 0xaa, 0x64, 0xcb, 0xb5, 0x6d, 0xba, 0x8c, 0xa8,
+	0x35, 0x52, 0xc5, 0x38, 0x4e, 0x30, 0xb6, 0xb1, 0x87, 0xa9, 0x19, 0x97, 0x54, 0xb5, 0x87, 0xfa,
+	0xbf, 0xa2, 0x91, 0xa7, 0xa7, 0x82, 0xe8, 0xa5, 0x6a, 0x1f, 0x80, 0x99, 0x8e, 0x03, 0x44, 0xc7,
+	0x3e, 0xf3, 0x4b, 0xd5, 0x38, 0xc3, 0x4a, 0x91, 0x64, 0x2e, 0x24, 0xec, 0x7b, 0xc6, 0xe6, 0x6b,
+	0xc6, 0x76, 0xed, 0xa7, 0x67, 0xab, 0xc6, 0x2f, 0xcf, 0x56, 0x8d, 0xdf, 0x9f, 0xad, 0x1a, 0xdf,
+	0xfd, 0xb1, 0xfa, 0x9f, 0xce, 0x1c, 0x7a, 0xf7, 0xc6, 0x5f, 0x03, 0x00, 0xae, 0xc1, 0x0e, 0xdf,
+	0xf7, 0x0f, 0x00, 0x00,
 }
@@ -30,11 +30,11 @@ func (s *MockApisSubscribeServer) Send(req *SubscribeRes) error {
 }
 
 func TestRpcServer_Subscribe(t *testing.T) {
-	monkey.Patch(NewRPCServer, func(tp txpool.TxPool, bcache blockcache.BlockCache, _global global.BaseVariable, p2pService p2p.Service) *GRPCServer {
+	monkey.Patch(NewRPCServer, func(tp txpool.TxPool, bcache blockcache.BlockCache, _global global.BaseVariable, p2pService p2p.Service, slots SlotCounter) *GRPCServer {
 		return &GRPCServer{}
 	})
 
-	s := NewRPCServer(nil, nil, nil, nil)
+	s := NewRPCServer(nil, nil, nil, nil, nil)
 	ec := event.GetEventCollectorInstance()
 	req := &SubscribeReq{Topics: []event.Event_Topic{event.Event_TransactionResult}}
 	res := MockApisSubscribeServer{
@@ -11,7 +11,11 @@ type BalanceHandler struct {
 }
 
 func (m *BalanceHandler) balanceKey(to string) string {
-	return IOSTPrefix + to + "-b"
+	return balanceKey(to)
+}
+
+func balanceKey(id string) string {
+	return IOSTPrefix + id + "-b"
 }
 
 // SetBalance set balance to id
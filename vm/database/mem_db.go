@@ -0,0 +1,98 @@
+package database
+
+import (
+	"sort"
+	"strings"
+)
+
+// MemDB is an in memory IMultiValue, the changes are kept until Commit, and Rollback discards them
+type MemDB struct {
+	data    map[string]map[string]string
+	changes map[string]map[string]*string
+}
+
+// NewMemDB returns an empty MemDB
+func NewMemDB() *MemDB {
+	return &MemDB{
+		data:    make(map[string]map[string]string),
+		changes: make(map[string]map[string]*string),
+	}
+}
+
+// Get returns the value of key, "" if the key doesn't exist
+func (m *MemDB) Get(table string, key string) (string, error) {
+	if v, ok := m.changes[table][key]; ok {
+		if v == nil {
+			return "", nil
+		}
+		return *v, nil
+	}
+	return m.data[table][key], nil
+}
+
+// Put puts key-value into the changes
+func (m *MemDB) Put(table string, key string, value string) error {
+	m.change(table, key, &value)
+	return nil
+}
+
+// Del deletes key in the changes
+func (m *MemDB) Del(table string, key string) error {
+	m.change(table, key, nil)
+	return nil
+}
+
+func (m *MemDB) change(table string, key string, value *string) {
+	if m.changes[table] == nil {
+		m.changes[table] = make(map[string]*string)
+	}
+	m.changes[table][key] = value
+}
+
+// Has returns if key exists
+func (m *MemDB) Has(table string, key string) (bool, error) {
+	if v, ok := m.changes[table][key]; ok {
+		return v != nil, nil
+	}
+	_, ok := m.data[table][key]
+	return ok, nil
+}
+
+// Keys returns the existing keys with prefix in order
+func (m *MemDB) Keys(table string, prefix string) ([]string, error) {
+	keys := make([]string, 0)
+	for k := range m.data[table] {
+		if _, changed := m.changes[table][k]; !changed && strings.HasPrefix(k, prefix) {
+			keys = append(keys, k)
+		}
+	}
+	for k, v := range m.changes[table] {
+		if v != nil && strings.HasPrefix(k, prefix) {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys, nil
+}
+
+// Commit applies the changes
+func (m *MemDB) Commit() {
+	for table, changes := range m.changes {
+		if m.data[table] == nil {
+			m.data[table] = make(map[string]string)
+		}
+		for k, v := range changes {
+			if v == nil {
+				delete(m.data[table], k)
+			} else {
+				m.data[table][k] = *v
+			}
+		}
+	}
+	m.changes = make(map[string]map[string]*string)
+}
+
+// Rollback discards the changes since the last commit
+func (m *MemDB) Rollback() {
+	m.changes = make(map[string]map[string]*string)
+}
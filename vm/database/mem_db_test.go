@@ -0,0 +1,44 @@
+package database
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMemDB(t *testing.T) {
+	m := NewMemDB()
+	m.Put("state", "a-1", "x")
+	m.Put("state", "a-2", "y")
+	m.Put("state", "b-1", "z")
+	m.Commit()
+
+	m.Put("state", "a-1", "changed")
+	m.Del("state", "a-2")
+	m.Put("state", "a-3", "new")
+	if v, _ := m.Get("state", "a-1"); v != "changed" {
+		t.Fatal(v)
+	}
+	if ok, _ := m.Has("state", "a-2"); ok {
+		t.Fatal("deleted key exists")
+	}
+	if keys, _ := m.Keys("state", "a-"); !reflect.DeepEqual(keys, []string{"a-1", "a-3"}) {
+		t.Fatal(keys)
+	}
+
+	m.Rollback()
+	if v, _ := m.Get("state", "a-1"); v != "x" {
+		t.Fatal(v)
+	}
+	if keys, _ := m.Keys("state", "a-"); !reflect.DeepEqual(keys, []string{"a-1", "a-2"}) {
+		t.Fatal(keys)
+	}
+
+	v := NewVisitor(0, m)
+	v.SetBalance("alice", 100)
+	v.Commit()
+	v.SetBalance("alice", -30)
+	v.Rollback()
+	if b := v.Balance("alice"); b != 100 {
+		t.Fatal(b)
+	}
+}
@@ -0,0 +1,138 @@
+package database
+
+import (
+	"sort"
+	"strings"
+)
+
+type recordKey struct {
+	table string
+	key   string
+}
+
+// RecordDB is an IMultiValue which records the keys read and written through it. The written values
+// are kept after Commit and dropped by Rollback, so the committed writes can be applied to another db.
+type RecordDB struct {
+	db       IMultiValue
+	reads    map[recordKey]bool
+	prefixes []recordKey
+	staged   map[recordKey]*string
+	writes   map[recordKey]*string
+}
+
+// NewRecordDB returns a RecordDB recording the accesses of db
+func NewRecordDB(db IMultiValue) *RecordDB {
+	return &RecordDB{
+		db:       db,
+		reads:    make(map[recordKey]bool),
+		prefixes: make([]recordKey, 0),
+		staged:   make(map[recordKey]*string),
+		writes:   make(map[recordKey]*string),
+	}
+}
+
+// Get returns the value of key
+func (r *RecordDB) Get(table string, key string) (string, error) {
+	r.reads[recordKey{table, key}] = true
+	return r.db.Get(table, key)
+}
+
+// Put sets the value of key
+func (r *RecordDB) Put(table string, key string, value string) error {
+	r.staged[recordKey{table, key}] = &value
+	return r.db.Put(table, key, value)
+}
+
+// Del deletes key
+func (r *RecordDB) Del(table string, key string) error {
+	r.staged[recordKey{table, key}] = nil
+	return r.db.Del(table, key)
+}
+
+// Has returns whether key exists
+func (r *RecordDB) Has(table string, key string) (bool, error) {
+	r.reads[recordKey{table, key}] = true
+	return r.db.Has(table, key)
+}
+
+// Keys returns the keys with the prefix, any key written with the prefix later is a conflict
+func (r *RecordDB) Keys(table string, prefix string) ([]string, error) {
+	r.prefixes = append(r.prefixes, recordKey{table, prefix})
+	return r.db.Keys(table, prefix)
+}
+
+// Commit keeps the staged writes
+func (r *RecordDB) Commit() {
+	for k, v := range r.staged {
+		r.writes[k] = v
+	}
+	r.staged = make(map[recordKey]*string)
+	r.db.Commit()
+}
+
+// Rollback drops the staged writes, the reads are kept
+func (r *RecordDB) Rollback() {
+	r.staged = make(map[recordKey]*string)
+	r.db.Rollback()
+}
+
+// TouchedBalance returns true if the balance of id is read or written through r.
+func (r *RecordDB) TouchedBalance(id string) bool {
+	k := recordKey{StateTable, balanceKey(id)}
+	if _, ok := r.writes[k]; ok || r.reads[k] {
+		return true
+	}
+	if _, ok := r.staged[k]; ok {
+		return true
+	}
+	for _, p := range r.prefixes {
+		if p.table == k.table && strings.HasPrefix(k.key, p.key) {
+			return true
+		}
+	}
+	return false
+}
+
+// Conflict returns true if any key read or written through r is written through w.
+func (r *RecordDB) Conflict(w *RecordDB) bool {
+	for k := range w.writes {
+		if r.reads[k] {
+			return true
+		}
+		if _, ok := r.writes[k]; ok {
+			return true
+		}
+		for _, p := range r.prefixes {
+			if p.table == k.table && strings.HasPrefix(k.key, p.key) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Apply writes the committed writes to db in the order of keys, db is not committed.
+func (r *RecordDB) Apply(db IMultiValue) error {
+	keys := make([]recordKey, 0, len(r.writes))
+	for k := range r.writes {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].table != keys[j].table {
+			return keys[i].table < keys[j].table
+		}
+		return keys[i].key < keys[j].key
+	})
+	for _, k := range keys {
+		var err error
+		if v := r.writes[k]; v != nil {
+			err = db.Put(k.table, k.key, *v)
+		} else {
+			err = db.Del(k.table, k.key)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
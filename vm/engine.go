@@ -28,23 +28,28 @@ var (
 	errContractNotFound = errors.New("contract not found")
 	errSetUpArgs        = errors.New("key does not exist")
 	errCannotPay        = errors.New("publisher's balance less than price * limit")
+	// ErrTimeout is returned if the tx is killed by the wall time limit of this node
+	ErrTimeout = errors.New("execution timeout")
 )
 
 // Engine the smart contract engine
 type Engine interface {
 	SetUp(k, v string) error
 	Exec(tx0 *tx.Tx, limit time.Duration) (*tx.TxReceipt, error)
+	Trace(tx0 *tx.Tx, limit time.Duration) (*tx.TxReceipt, *host.Tracer, error)
 	GC()
 }
 
 var staticMonitor = NewMonitor()
 var jsPath = "./v8vm/v8/libjs/"
 var logLevel = ""
+var parallelWorkers = 0
 
 // SetUp setup global engine settings
 func SetUp(config *common.VMConfig) error {
 	jsPath = config.JsPath
 	logLevel = config.LogLevel
+	parallelWorkers = config.Parallel
 	return nil
 }
 
@@ -125,6 +130,12 @@ func (e *engineImpl) exec(tx0 *tx.Tx) (*tx.TxReceipt, error) {
 		e.ho.PopCtx()
 	}()
 
+	if common.Forks.IsParallel(e.ho.Context().Value("number").(int64)) {
+		// the costs and the payment flag of the previous tx are not carried over
+		e.ho.ClearCost()
+		e.ho.Context().GSet("abi_payment", nil)
+	}
+
 	e.ho.Context().GSet("gas_limit", tx0.GasLimit)
 	e.ho.Context().GSet("receipts", make([]tx.Receipt, 0))
 
@@ -155,7 +166,7 @@ func (e *engineImpl) exec(tx0 *tx.Tx) (*tx.TxReceipt, error) {
 		gasLimit := e.ho.Context().GValue("gas_limit").(int64)
 
 		txr.Status = status
-		if status.Code == 4 && status.Message == "out of gas" {
+		if (status.Code == tx.ErrorRuntime || status.Code == tx.ErrorGasRunOut) && status.Message == "out of gas" {
 			cost = contract.NewCost(0, 0, gasLimit)
 		}
 
@@ -215,6 +226,17 @@ func (e *engineImpl) Exec(tx0 *tx.Tx, limit time.Duration) (*tx.TxReceipt, error
 	}
 	return tr, err
 }
+
+// Trace executes the tx like Exec and records its call tree and storage accesses
+func (e *engineImpl) Trace(tx0 *tx.Tx, limit time.Duration) (*tx.TxReceipt, *host.Tracer, error) {
+	t := host.NewTracer()
+	e.ho.SetTracer(t)
+	defer e.ho.SetTracer(nil)
+
+	tr, err := e.Exec(tx0, limit)
+	return tr, t, err
+}
+
 func (e *engineImpl) GC() {
 	e.logger.Stop()
 }
@@ -300,7 +322,9 @@ func (e *engineImpl) runAction(action tx.Action) (cost *contract.Cost, status tx
 	e.ho.Context().Set("stack0", "direct_call")
 	e.ho.Context().Set("stack_height", 1) // record stack trace
 
-	_, cost, err = staticMonitor.Call(e.ho, action.Contract, action.ActionName, action.Data)
+	e.ho.Tracer().Enter(action.Contract, action.ActionName, action.Data)
+	rtn, cost, err := staticMonitor.Call(e.ho, action.Contract, action.ActionName, action.Data)
+	e.ho.Tracer().Exit(rtn, cost, err)
 
 	if cost == nil {
 		panic("cost is nil")
@@ -309,6 +333,11 @@ func (e *engineImpl) runAction(action tx.Action) (cost *contract.Cost, status tx
 	if err != nil {
 
 		if strings.Contains(err.Error(), "execution killed") {
+			// the time limit is different on every node, so a killed tx has no receipt instead of a timeout one
+			if common.Forks.IsMetering(e.ho.Context().Value("number").(int64)) {
+				ilog.Warnf("action %v.%v killed: %v", action.Contract, action.ActionName, err)
+				return cost, status, receipts, ErrTimeout
+			}
 			status = tx.Status{
 				Code:    tx.ErrorTimeout,
 				Message: err.Error(),
@@ -318,6 +347,9 @@ func (e *engineImpl) runAction(action tx.Action) (cost *contract.Cost, status tx
 				Code:    tx.ErrorRuntime,
 				Message: err.Error(),
 			}
+			if status.Message == "out of gas" && common.Forks.IsMetering(e.ho.Context().Value("number").(int64)) {
+				status.Code = tx.ErrorGasRunOut
+			}
 		}
 
 		receipt := tx.Receipt{
@@ -390,9 +422,33 @@ func loadBlkInfo(ctx *host.Context, bh *block.BlockHead) *host.Context {
 	c.Set("number", bh.Number)
 	c.Set("witness", bh.Witness)
 	c.Set("time", bh.Time)
+	c.Set("random", blockRandom(bh))
 	return c
 }
 
+// blockRandom returns the output of the beacon of the block in base58, it's empty before the BeaconHeight.
+func blockRandom(bh *block.BlockHead) string {
+	if !common.Forks.IsBeacon(bh.Number) {
+		return ""
+	}
+	if beacon, err := bh.Beacon(); err == nil {
+		return common.Base58Encode(beacon.Output)
+	}
+	return ""
+}
+
+// RecordRandom keeps the random of the block in the state before its txs are executed, so the contracts of the
+// later blocks can read it by the number of the block.
+func RecordRandom(bh *block.BlockHead, cb database.IMultiValue) {
+	random := blockRandom(bh)
+	if random == "" {
+		return
+	}
+	vi := database.NewVisitor(0, cb)
+	host.SetBlockRandom(vi, bh.Number, random)
+	vi.Commit()
+}
+
 func loadTxInfo(h *host.Host, t *tx.Tx, publisherID string) {
 	h.PushCtx()
 	h.Context().Set("time", t.Time)
@@ -7,6 +7,7 @@ import (
 
 	"github.com/golang/mock/gomock"
 	"github.com/iost-official/go-iost/account"
+	"github.com/iost-official/go-iost/common"
 	blk "github.com/iost-official/go-iost/core/block"
 	"github.com/iost-official/go-iost/core/contract"
 	"github.com/iost-official/go-iost/core/event"
@@ -62,6 +63,15 @@ func TestNewEngine(t *testing.T) { // test of normal engine work
 		t.Fatal(blkInfo)
 	}
 
+	// the block has no beacon, so its random is empty after the fork
+	forks := common.Forks
+	common.SetForks(&common.ForkConfig{ParallelHeight: -1, MeteringHeight: -1, BeaconHeight: 0, LimitHeight: -1})
+	bi, _ = e.(*engineImpl).ho.BlockInfo()
+	common.SetForks(forks)
+	if string(bi) != `{"number":10,"parent_hash":"ZiCa","random":"","time":123456,"witness":"witness"}` {
+		t.Fatal(string(bi))
+	}
+
 	//ac, err := account.NewAccount(nil)
 	//if err != nil {
 	//	t.Fatal(err)
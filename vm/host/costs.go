@@ -17,10 +17,15 @@ var (
 
 	BlockInfoCost = contract.NewCost(0, 0, 1)
 	TxInfoCost    = contract.NewCost(0, 0, 1)
+	RandomCost    = contract.NewCost(0, 0, 1)
+
+	BlockRandomCost = contract.NewCost(100, 0, 8)
 
 	TransferCost = contract.NewCost(300, 0, 3)
 
 	RequireAuthCost = contract.NewCost(0, 0, 1)
+
+	VerifyEvidenceCost = contract.NewCost(0, 0, 100)
 )
 
 // EventCost return cost based on event size
@@ -42,3 +47,14 @@ func CodeSavageCost(size int) *contract.Cost {
 func CommonErrorCost(layer int) *contract.Cost {
 	return contract.NewCost(0, 0, int64(layer*10))
 }
+
+// HashCost returns cost of hashing the data, based on data size
+func HashCost(size int) *contract.Cost {
+	return contract.NewCost(0, 0, int64(2+size/64))
+}
+
+// cost of the signature apis
+var (
+	VerifySignatureCost = contract.NewCost(0, 0, 50)
+	PubkeyToIDCost      = contract.NewCost(0, 0, 5)
+)
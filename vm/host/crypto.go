@@ -0,0 +1,73 @@
+package host
+
+import (
+	"crypto/sha256"
+
+	"github.com/iost-official/go-iost/account"
+	"github.com/iost-official/go-iost/common"
+	"github.com/iost-official/go-iost/core/contract"
+	"github.com/iost-official/go-iost/crypto"
+	"golang.org/x/crypto/ripemd160"
+	"golang.org/x/crypto/sha3"
+)
+
+// Crypto crypto handler of contracts, binary values are in base58
+type Crypto struct {
+	h *Host
+}
+
+// NewCrypto new crypto
+func NewCrypto(h *Host) Crypto {
+	return Crypto{h: h}
+}
+
+// Sha256 returns sha256 of the data
+func (c *Crypto) Sha256(data string) (string, *contract.Cost) {
+	sum := sha256.Sum256([]byte(data))
+	return common.Base58Encode(sum[:]), HashCost(len(data))
+}
+
+// Sha3 returns sha3-256 of the data
+func (c *Crypto) Sha3(data string) (string, *contract.Cost) {
+	sum := sha3.Sum256([]byte(data))
+	return common.Base58Encode(sum[:]), HashCost(len(data))
+}
+
+// Keccak256 returns the legacy keccak-256 of the data, as used by ethereum
+func (c *Crypto) Keccak256(data string) (string, *contract.Cost) {
+	k := sha3.NewLegacyKeccak256()
+	k.Write([]byte(data))
+	return common.Base58Encode(k.Sum(nil)), HashCost(len(data))
+}
+
+// Ripemd160 returns ripemd160 of the data
+func (c *Crypto) Ripemd160(data string) (string, *contract.Cost) {
+	r := ripemd160.New()
+	r.Write([]byte(data))
+	return common.Base58Encode(r.Sum(nil)), HashCost(len(data))
+}
+
+// VerifySignature verifies the signature of the message by algo, "ed25519" or "secp256k1",
+// message, signature and pubkey are in base58, unknown algo or invalid input is not verified
+func (c *Crypto) VerifySignature(algo, message, signature, pubkey string) (bool, *contract.Cost) {
+	a, ok := crypto.NewAlgorithm(algo)
+	if !ok {
+		return false, VerifySignatureCost
+	}
+	msg := common.Base58Decode(message)
+	sig := common.Base58Decode(signature)
+	pk := common.Base58Decode(pubkey)
+	if len(sig) == 0 || len(pk) == 0 {
+		return false, VerifySignatureCost
+	}
+	return a.Verify(msg, pk, sig), VerifySignatureCost
+}
+
+// PubkeyToID returns the account id of the pubkey in base58
+func (c *Crypto) PubkeyToID(pubkey string) (string, *contract.Cost) {
+	pk := common.Base58Decode(pubkey)
+	if len(pk) == 0 {
+		return "", PubkeyToIDCost
+	}
+	return account.GetIDByPubkey(pk), PubkeyToIDCost
+}
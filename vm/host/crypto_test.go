@@ -0,0 +1,74 @@
+package host
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/iost-official/go-iost/account"
+	"github.com/iost-official/go-iost/common"
+	"github.com/iost-official/go-iost/core/contract"
+	"github.com/iost-official/go-iost/crypto"
+)
+
+func TestCrypto_Hash(t *testing.T) {
+	h := NewHost(NewContext(nil), nil, nil, nil)
+	tests := []struct {
+		name string
+		hash func(string) (string, *contract.Cost)
+		data string
+		want string
+	}{
+		{"sha256", h.Sha256, "abc", "ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad"},
+		{"sha3", h.Sha3, "abc", "3a985da74fe225b2045c172d6bd390bd855f086e3e9d525b46bfe24511431532"},
+		{"keccak256", h.Keccak256, "", "c5d2460186f7233c927e7db2dcc703c0e500b653ca82273b7bfad8045d85a470"},
+		{"ripemd160", h.Ripemd160, "abc", "8eb208f7e05d987a9b044a8e98c6b087f15a0bfc"},
+	}
+	for _, tt := range tests {
+		got, cost := tt.hash(tt.data)
+		if hex.EncodeToString(common.Base58Decode(got)) != tt.want {
+			t.Errorf("%v(%q) = %v, want %v", tt.name, tt.data, hex.EncodeToString(common.Base58Decode(got)), tt.want)
+		}
+		if cost.ToGas() != HashCost(len(tt.data)).ToGas() {
+			t.Errorf("%v cost = %v", tt.name, cost)
+		}
+	}
+	_, small := h.Sha256("a")
+	_, large := h.Sha256(string(make([]byte, 1024)))
+	if large.ToGas() <= small.ToGas() {
+		t.Errorf("hash cost should grow with the data, %v <= %v", large, small)
+	}
+}
+
+func TestCrypto_VerifySignature(t *testing.T) {
+	h := NewHost(NewContext(nil), nil, nil, nil)
+	msg := common.Sha3([]byte("message"))
+	for _, name := range []string{"ed25519", "secp256k1"} {
+		algo, _ := crypto.NewAlgorithm(name)
+		seckey := algo.GenSeckey()
+		pubkey := algo.GetPubkey(seckey)
+		sig := algo.Sign(msg, seckey)
+
+		ok, cost := h.VerifySignature(name, common.Base58Encode(msg), common.Base58Encode(sig), common.Base58Encode(pubkey))
+		if !ok || cost != VerifySignatureCost {
+			t.Errorf("%v: valid signature not verified", name)
+		}
+		other := common.Sha3([]byte("other"))
+		if ok, _ := h.VerifySignature(name, common.Base58Encode(other), common.Base58Encode(sig), common.Base58Encode(pubkey)); ok {
+			t.Errorf("%v: signature of another message verified", name)
+		}
+		if ok, _ := h.VerifySignature(name, common.Base58Encode(msg), common.Base58Encode(sig), "abc"); ok {
+			t.Errorf("%v: signature verified with an invalid pubkey", name)
+		}
+
+		id, _ := h.PubkeyToID(common.Base58Encode(pubkey))
+		if id != account.GetIDByPubkey(pubkey) {
+			t.Errorf("%v: id = %v", name, id)
+		}
+	}
+	if ok, _ := h.VerifySignature("rsa", "", "", ""); ok {
+		t.Error("unknown algorithm should not verify")
+	}
+	if id, _ := h.PubkeyToID(""); id != "" {
+		t.Errorf("id of the empty pubkey = %v", id)
+	}
+}
@@ -19,26 +19,31 @@ func NewDBHandler(h *Host) DBHandler {
 
 // Put put kv to db
 func (h *DBHandler) Put(key string, value interface{}) *contract.Cost {
-	h.h.db.Put(
-		h.modifyKey(key),
-		database.MustMarshal(value),
-	)
+	k, v := h.modifyKey(key), database.MustMarshal(value)
+	if h.h.tracer != nil {
+		h.h.tracer.access("put", k, "", h.h.db.Get(k), v, PutCost)
+	}
+	h.h.db.Put(k, v)
 	return PutCost
 }
 
 // Get get value of key from db
 func (h *DBHandler) Get(key string) (value interface{}, cost *contract.Cost) {
-
-	rtn := database.MustUnmarshal(h.h.db.Get(h.modifyKey(key)))
-
-	return rtn, GetCost
+	k := h.modifyKey(key)
+	o := h.h.db.Get(k)
+	if h.h.tracer != nil {
+		h.h.tracer.access("get", k, "", o, "", GetCost)
+	}
+	return database.MustUnmarshal(o), GetCost
 }
 
 // Del delete key
 func (h *DBHandler) Del(key string) *contract.Cost {
-
-	h.h.db.Del(h.modifyKey(key))
-
+	k := h.modifyKey(key)
+	if h.h.tracer != nil {
+		h.h.tracer.access("del", k, "", h.h.db.Get(k), "", DelCost)
+	}
+	h.h.db.Del(k)
 	return DelCost
 }
 
@@ -49,15 +54,22 @@ func (h *DBHandler) Has(key string) (bool, *contract.Cost) {
 
 // MapPut put kfv to db
 func (h *DBHandler) MapPut(key, field string, value interface{}) *contract.Cost {
-	v := database.MustMarshal(value)
-	h.h.db.MPut(h.modifyKey(key), field, v)
+	k, v := h.modifyKey(key), database.MustMarshal(value)
+	if h.h.tracer != nil {
+		h.h.tracer.access("map_put", k, field, h.h.db.MGet(k, field), v, PutCost)
+	}
+	h.h.db.MPut(k, field, v)
 	return PutCost
 }
 
 // MapGet get value by kf from db
 func (h *DBHandler) MapGet(key, field string) (value interface{}, cost *contract.Cost) {
-	rtn := database.MustUnmarshal(h.h.db.MGet(h.modifyKey(key), field))
-	return rtn, GetCost
+	k := h.modifyKey(key)
+	o := h.h.db.MGet(k, field)
+	if h.h.tracer != nil {
+		h.h.tracer.access("map_get", k, field, o, "", GetCost)
+	}
+	return database.MustUnmarshal(o), GetCost
 }
 
 // MapKeys list keys
@@ -68,7 +80,11 @@ func (h *DBHandler) MapKeys(key string) (fields []string, cost *contract.Cost) {
 
 // MapDel delete field
 func (h *DBHandler) MapDel(key, field string) *contract.Cost {
-	h.h.db.MDel(h.modifyKey(key), field)
+	k := h.modifyKey(key)
+	if h.h.tracer != nil {
+		h.h.tracer.access("map_del", k, field, h.h.db.MGet(k, field), "", DelCost)
+	}
+	h.h.db.MDel(k, field)
 	return DelCost
 }
 
@@ -84,13 +100,21 @@ func (h *DBHandler) MapLen(key string) (int, *contract.Cost) {
 
 // GlobalGet get another contract's data
 func (h *DBHandler) GlobalGet(con, key string) (value interface{}, cost *contract.Cost) {
-	o := h.h.db.Get(con + database.Separator + key)
+	k := con + database.Separator + key
+	o := h.h.db.Get(k)
+	if h.h.tracer != nil {
+		h.h.tracer.access("global_get", k, "", o, "", GetCost)
+	}
 	return database.MustUnmarshal(o), GetCost
 }
 
 // GlobalMapGet get another contract's map data
 func (h *DBHandler) GlobalMapGet(con, key, field string) (value interface{}, cost *contract.Cost) {
-	o := h.h.db.MGet(con+database.Separator+key, field)
+	k := con + database.Separator + key
+	o := h.h.db.MGet(k, field)
+	if h.h.tracer != nil {
+		h.h.tracer.access("global_map_get", k, field, o, "", GetCost)
+	}
 	return database.MustUnmarshal(o), GetCost
 }
 
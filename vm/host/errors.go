@@ -8,6 +8,7 @@ var (
 	ErrTransferNegValue = errors.New("trasfer amount less than zero")
 	ErrReenter          = errors.New("re-entering")
 	ErrPermissionLost   = errors.New("transaction has no permission")
+	ErrInvalidEvent     = errors.New("invalid event name or too many topics")
 
 	ErrContractNotFound = errors.New("contract not exists")
 	ErrUpdateRefused    = errors.New("update refused")
@@ -26,11 +26,13 @@ type Host struct {
 	APIDelegate
 	EventPoster
 	DHCP
+	Crypto
 
 	logger  *ilog.Logger
 	ctx     *Context
 	db      *database.Visitor
 	monitor Monitor
+	tracer  *Tracer
 
 	deadline time.Time
 }
@@ -49,6 +51,7 @@ func NewHost(ctx *Context, db *database.Visitor, monitor Monitor, logger *ilog.L
 	h.APIDelegate = NewAPI(h)
 	h.EventPoster = EventPoster{}
 	h.DHCP = NewDHCP(h)
+	h.Crypto = NewCrypto(h)
 
 	return h
 
@@ -86,7 +89,9 @@ func (h *Host) Call(contract, api, jarg string) ([]interface{}, *contract.Cost,
 
 	h.ctx.Set("stack_height", height+1)
 	h.ctx.Set(key, record)
+	h.tracer.Enter(contract, api, jarg)
 	rtn, cost, err := h.monitor.Call(h, contract, api, jarg)
+	h.tracer.Exit(rtn, cost, err)
 
 	h.ctx = h.ctx.Base()
 
@@ -222,6 +227,16 @@ func (h *Host) DB() *database.Visitor {
 	return h.db
 }
 
+// Tracer return the tracer of this host, nil if the execution isn't traced
+func (h *Host) Tracer() *Tracer {
+	return h.tracer
+}
+
+// SetTracer set the tracer recording the calls and storage accesses, nil to stop tracing
+func (h *Host) SetTracer(t *Tracer) {
+	h.tracer = t
+}
+
 // PushCtx make a new context based on current one
 func (h *Host) PushCtx() {
 	ctx := NewContext(h.ctx)
@@ -239,7 +254,8 @@ func (h *Host) Deadline() time.Time {
 	return h.deadline
 }
 
-// SetDeadline set this host's deadline
+// SetDeadline set this host's deadline, it is a node-local safety valve, from the metering fork on
+// the execution is bounded by the gas limit on every node, and a tx running out of time has no receipt
 func (h *Host) SetDeadline(t time.Time) {
 	h.deadline = t
 }
@@ -8,6 +8,8 @@ import (
 	"time"
 
 	. "github.com/golang/mock/gomock"
+	"github.com/iost-official/go-iost/common"
+	"github.com/iost-official/go-iost/core/tx"
 	"github.com/iost-official/go-iost/vm/database"
 )
 
@@ -169,7 +171,47 @@ func TestHost_RequireAuth(t *testing.T) {
 }
 
 func TestHost_BlockInfo(t *testing.T) {
+	forks := common.Forks
+	defer common.SetForks(forks)
+	ctx := NewContext(nil)
+	ctx.Set("number", int64(10))
+	ctx.Set("random", "seed")
+	host := NewHost(ctx, nil, nil, nil)
+
+	common.SetForks(&common.ForkConfig{ParallelHeight: -1, MeteringHeight: -1, BeaconHeight: 11, LimitHeight: -1})
+	info, _ := host.BlockInfo()
+	if string(info) != `{"number":10,"parent_hash":null,"time":null,"witness":null}` {
+		t.Fatal(string(info))
+	}
+	common.SetForks(&common.ForkConfig{ParallelHeight: -1, MeteringHeight: -1, BeaconHeight: 10, LimitHeight: -1})
+	info, _ = host.BlockInfo()
+	if string(info) != `{"number":10,"parent_hash":null,"random":"seed","time":null,"witness":null}` {
+		t.Fatal(string(info))
+	}
+	random, cost := host.Random()
+	if random != "seed" || cost != RandomCost {
+		t.Fatal(random, cost)
+	}
+}
 
+func TestHost_BlockRandom(t *testing.T) {
+	defer func(n int64) { RandomHistory = n }(RandomHistory)
+	RandomHistory = 2
+	db := database.NewVisitor(0, database.NewMemDB())
+	host := NewHost(NewContext(nil), db, nil, nil)
+
+	SetBlockRandom(db, 1, "seed1")
+	SetBlockRandom(db, 2, "seed2")
+	random, cost := host.BlockRandom(1)
+	if random != "seed1" || cost != BlockRandomCost {
+		t.Fatal(random, cost)
+	}
+	SetBlockRandom(db, 3, "seed3")
+	for number, expect := range map[int64]string{1: "", 2: "seed2", 3: "seed3", 4: ""} {
+		if random, _ := host.BlockRandom(number); random != expect {
+			t.Fatalf("random of block %v: expect %q, got %q", number, expect, random)
+		}
+	}
 }
 
 func TestTeller_Transfer(t *testing.T) {
@@ -210,3 +252,30 @@ func TestTeller_Transfer(t *testing.T) {
 	host.Transfer("hello", "world", 3)
 
 }
+
+func TestHost_Emit(t *testing.T) {
+	ctx := NewContext(nil)
+	ctx.Set("contract_name", "token")
+	ctx.GSet("receipts", make([]tx.Receipt, 0))
+	host := NewHost(ctx, nil, nil, nil)
+
+	cost, err := host.Emit("transfer", []string{"alice", "bob"}, "100")
+	if err != nil {
+		t.Fatal(err)
+	}
+	rs := ctx.GValue("receipts").([]tx.Receipt)
+	if len(rs) != 1 || rs[0].Type != tx.EventDefined || cost.ToGas() != EventCost(len(rs[0].Content)).ToGas() {
+		t.Fatal(rs, cost)
+	}
+	r := tx.TxReceipt{Receipts: rs}
+	if e := r.Events()[0]; e.Contract != "token" || e.Name != "transfer" || e.Topics[1] != "bob" || e.Data != "100" {
+		t.Fatalf("event: %+v", e)
+	}
+
+	if _, err := host.Emit("", nil, ""); err != ErrInvalidEvent {
+		t.Errorf("empty name: %v", err)
+	}
+	if _, err := host.Emit("e", make([]string, tx.MaxEventTopics+1), ""); err != ErrInvalidEvent {
+		t.Errorf("too many topics: %v", err)
+	}
+}
@@ -2,11 +2,28 @@ package host
 
 import (
 	"encoding/json"
+	"strconv"
 
+	"github.com/iost-official/go-iost/common"
 	"github.com/iost-official/go-iost/core/contract"
 	"github.com/iost-official/go-iost/vm/database"
 )
 
+// RandomHistory is the number of the blocks whose randoms are kept in the state for BlockRandom.
+var RandomHistory int64 = 1024
+
+// randomKey returns the key of the random of the block at number, it's in the storage of iost.system so no
+// contract can write it.
+func randomKey(number int64) string {
+	return "iost.system" + database.Separator + "random" + database.Separator + strconv.FormatInt(number, 10)
+}
+
+// SetBlockRandom keeps the random of the block at number, and drops the one of the block RandomHistory before.
+func SetBlockRandom(db *database.Visitor, number int64, random string) {
+	db.Put(randomKey(number), random)
+	db.Del(randomKey(number - RandomHistory))
+}
+
 // Info current info handler of this isolate
 type Info struct {
 	h *Host
@@ -17,7 +34,7 @@ func NewInfo(h *Host) Info {
 	return Info{h: h}
 }
 
-// BlockInfo get block info, in json
+// BlockInfo get block info, in json, the random is in it from the BeaconHeight of the forks
 func (h *Info) BlockInfo() (info database.SerializedJSON, cost *contract.Cost) {
 
 	blkInfo := make(map[string]interface{})
@@ -26,6 +43,9 @@ func (h *Info) BlockInfo() (info database.SerializedJSON, cost *contract.Cost) {
 	blkInfo["number"] = h.h.ctx.Value("number")
 	blkInfo["witness"] = h.h.ctx.Value("witness")
 	blkInfo["time"] = h.h.ctx.Value("time")
+	if number, ok := h.h.ctx.Value("number").(int64); ok && common.Forks.IsBeacon(number) {
+		blkInfo["random"] = h.h.ctx.Value("random")
+	}
 
 	bij, err := json.Marshal(blkInfo)
 	if err != nil {
@@ -35,6 +55,23 @@ func (h *Info) BlockInfo() (info database.SerializedJSON, cost *contract.Cost) {
 	return database.SerializedJSON(bij), BlockInfoCost
 }
 
+// Random get the random seed of the block in base58, it's the beacon of the block that the witness can't choose
+func (h *Info) Random() (string, *contract.Cost) {
+	random, _ := h.h.ctx.Value("random").(string)
+	return random, RandomCost
+}
+
+// BlockRandom get the random seed of the block at number in base58, it's empty if the block has no beacon, or
+// it isn't one of the last RandomHistory blocks. A contract commits to a bet in a block, and resolves it with the
+// random of a later block, which isn't known when the bet is committed.
+func (h *Info) BlockRandom(number int64) (string, *contract.Cost) {
+	k := randomKey(number)
+	if !h.h.db.Has(k) {
+		return "", BlockRandomCost
+	}
+	return h.h.db.Get(k), BlockRandomCost
+}
+
 // TxInfo get tx info
 func (h *Info) TxInfo() (info database.SerializedJSON, cost *contract.Cost) {
 
@@ -15,8 +15,9 @@ const (
 
 // Teller handler of iost
 type Teller struct {
-	h    *Host
-	cost map[string]*contract.Cost
+	h       *Host
+	cost    map[string]*contract.Cost
+	credits map[string]int64
 }
 
 // NewTeller new teller
@@ -164,11 +165,44 @@ func (h *Teller) PayCost(c *contract.Cost, who string) {
 	h.cost[who] = c
 }
 
+// ClearCost clears the costs to be paid, it is called before executing a tx
+func (h *Teller) ClearCost() {
+	h.cost = make(map[string]*contract.Cost)
+}
+
+// DeferCredits makes DoPay record the fees instead of adding them to the balances of the receivers,
+// so the txs paying the same witness don't conflict with each other.
+func (h *Teller) DeferCredits() {
+	h.credits = make(map[string]int64)
+}
+
+// Credits returns the fees recorded by the last DoPay after DeferCredits
+func (h *Teller) Credits() map[string]int64 {
+	return h.credits
+}
+
+// pay transfers the fee, the receiver is credited later if the credits are deferred
+func (h *Teller) pay(from, to string, amount int64) error {
+	if h.credits == nil {
+		return h.transfer(from, to, amount)
+	}
+	bf := h.h.db.Balance(from)
+	if strings.HasPrefix(from, ContractAccountPrefix) && bf >= amount || bf > amount {
+		h.h.db.SetBalance(from, -1*amount)
+		h.credits[to] += amount
+		return nil
+	}
+	return ErrBalanceNotEnough
+}
+
 // DoPay ...
 func (h *Teller) DoPay(witness string, gasPrice int64) error {
 	if gasPrice < 0 {
 		panic("gas_price error")
 	}
+	if h.credits != nil {
+		h.credits = make(map[string]int64)
+	}
 
 	for k, c := range h.cost {
 		fee := gasPrice * c.ToGas()
@@ -177,22 +211,22 @@ func (h *Teller) DoPay(witness string, gasPrice int64) error {
 		}
 		bfee := fee / 10
 		if strings.HasPrefix(k, "IOST") {
-			err := h.transfer(k, witness, fee-bfee)
+			err := h.pay(k, witness, fee-bfee)
 			if err != nil {
 				return err
 			}
 			// 10% of gas transferred to iost.bonus
-			err = h.transfer(k, ContractAccountPrefix+"iost.bonus", bfee)
+			err = h.pay(k, ContractAccountPrefix+"iost.bonus", bfee)
 			if err != nil {
 				return err
 			}
 		} else if strings.HasPrefix(k, ContractGasPrefix) {
-			err := h.transfer(k, witness, fee-bfee)
+			err := h.pay(k, witness, fee-bfee)
 			if err != nil {
 				return err
 			}
 			// 10% of gas transferred to iost.bonus
-			err = h.transfer(k, ContractAccountPrefix+"iost.bonus", bfee)
+			err = h.pay(k, ContractAccountPrefix+"iost.bonus", bfee)
 			if err != nil {
 				return err
 			}
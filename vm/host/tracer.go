@@ -0,0 +1,126 @@
+package host
+
+import (
+	"encoding/json"
+
+	"github.com/iost-official/go-iost/core/contract"
+	"github.com/iost-official/go-iost/vm/database"
+)
+
+// Tracer records the execution of a tx, the host records nothing unless a tracer is set.
+type Tracer struct {
+	Calls []*CallTrace `json:"calls"`
+	stack []*CallTrace
+}
+
+// CallTrace is a contract call, its steps are the storage accesses and the nested calls in order.
+type CallTrace struct {
+	Contract string        `json:"contract"`
+	API      string        `json:"api"`
+	Args     string        `json:"args"`
+	Return   []interface{} `json:"return,omitempty"`
+	Error    string        `json:"error,omitempty"`
+	Gas      int64         `json:"gas"`
+	Steps    []*TraceStep  `json:"steps,omitempty"`
+}
+
+// TraceStep is a storage access or a nested call, the values are the ones before and after the access.
+type TraceStep struct {
+	Op     string      `json:"op"`
+	Gas    int64       `json:"gas"`
+	Key    string      `json:"key,omitempty"`
+	Field  string      `json:"field,omitempty"`
+	Before interface{} `json:"before,omitempty"`
+	After  interface{} `json:"after,omitempty"`
+	Call   *CallTrace  `json:"call,omitempty"`
+}
+
+// NewTracer returns a tracer.
+func NewTracer() *Tracer {
+	return &Tracer{
+		Calls: make([]*CallTrace, 0),
+	}
+}
+
+// Enter records the start of a call.
+func (t *Tracer) Enter(contractName, api, args string) {
+	if t == nil {
+		return
+	}
+	c := &CallTrace{
+		Contract: contractName,
+		API:      api,
+		Args:     args,
+	}
+	if parent := t.current(); parent != nil {
+		parent.Steps = append(parent.Steps, &TraceStep{Op: "call", Call: c})
+	} else {
+		t.Calls = append(t.Calls, c)
+	}
+	t.stack = append(t.stack, c)
+}
+
+// Exit records the result of the current call.
+func (t *Tracer) Exit(rtn []interface{}, cost *contract.Cost, err error) {
+	c := t.current()
+	if c == nil {
+		return
+	}
+	t.stack = t.stack[:len(t.stack)-1]
+	c.Return = rtn
+	if cost != nil {
+		c.Gas = cost.ToGas()
+	}
+	if err != nil {
+		c.Error = err.Error()
+	}
+	if parent := t.current(); parent != nil {
+		parent.Steps[len(parent.Steps)-1].Gas = c.Gas
+	}
+}
+
+func (t *Tracer) current() *CallTrace {
+	if t == nil || len(t.stack) == 0 {
+		return nil
+	}
+	return t.stack[len(t.stack)-1]
+}
+
+// access records a storage access of the current call, the values are the serialized ones in the db.
+func (t *Tracer) access(op, key, field, before, after string, cost *contract.Cost) {
+	c := t.current()
+	if c == nil {
+		return
+	}
+	c.Steps = append(c.Steps, &TraceStep{
+		Op:     op,
+		Gas:    cost.ToGas(),
+		Key:    key,
+		Field:  field,
+		Before: traceValue(before),
+		After:  traceValue(after),
+	})
+}
+
+func traceValue(raw string) interface{} {
+	if raw == "" {
+		return nil
+	}
+	switch v := database.Unmarshal(raw).(type) {
+	case database.SerializedJSON:
+		if json.Valid(v) {
+			return json.RawMessage(v)
+		}
+		return string(v)
+	case error:
+		return raw
+	default:
+		return v
+	}
+}
+
+// JSON returns the call tree in json.
+func (t *Tracer) JSON() (string, error) {
+	b, err := json.Marshal(t)
+	return string(b), err
+}
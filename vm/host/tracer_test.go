@@ -0,0 +1,93 @@
+package host
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/iost-official/go-iost/core/contract"
+	"github.com/iost-official/go-iost/vm/database"
+)
+
+// traceMonitor runs the fake contracts of the test, "a.main" writes a key and calls "b.fail".
+type traceMonitor struct{}
+
+func (m *traceMonitor) Call(h *Host, contractName, api string, jarg string) ([]interface{}, *contract.Cost, error) {
+	h.PushCtx()
+	defer h.PopCtx()
+	h.Context().Set("contract_name", contractName)
+
+	cost := contract.Cost0()
+	if api == "fail" {
+		_, c := h.Get("k")
+		cost.AddAssign(c)
+		return nil, cost, errors.New("failed")
+	}
+	cost.AddAssign(h.Put("k", "old"))
+	cost.AddAssign(h.Put("k", "new"))
+	cost.AddAssign(h.MapPut("m", "f", "v"))
+	_, c, _ := h.Call("b", "fail", "[]")
+	cost.AddAssign(c)
+	return []interface{}{"done"}, cost, nil
+}
+
+func (m *traceMonitor) Compile(con *contract.Contract) (string, error) {
+	return con.Code, nil
+}
+
+func TestTracer(t *testing.T) {
+	ctx := NewContext(nil)
+	ctx.Set("stack_height", 0)
+	h := NewHost(ctx, database.NewVisitor(0, database.NewDatabase()), &traceMonitor{}, nil)
+
+	rtn, cost, err := h.Call("a", "main", `["x"]`)
+	if err != nil || rtn[0] != "done" {
+		t.Fatal(rtn, err)
+	}
+	if h.Tracer() != nil {
+		t.Fatal("nothing should be traced without a tracer")
+	}
+
+	tracer := NewTracer()
+	h.SetTracer(tracer)
+	_, cost, _ = h.Call("a", "main", `["x"]`)
+	h.SetTracer(nil)
+
+	if len(tracer.Calls) != 1 {
+		t.Fatalf("calls: %v", len(tracer.Calls))
+	}
+	c := tracer.Calls[0]
+	if c.Contract != "a" || c.API != "main" || c.Args != `["x"]` || c.Return[0] != "done" || c.Gas != cost.ToGas() {
+		t.Fatalf("call: %+v", c)
+	}
+	if len(c.Steps) != 4 {
+		t.Fatalf("steps: %v", len(c.Steps))
+	}
+	put := c.Steps[0]
+	if put.Op != "put" || put.Key != "a-k" || put.Before != "new" || put.After != "old" || put.Gas != PutCost.ToGas() {
+		t.Errorf("put: %+v", put)
+	}
+	if put := c.Steps[1]; put.Before != "old" || put.After != "new" {
+		t.Errorf("put: %+v", put)
+	}
+	if mp := c.Steps[2]; mp.Op != "map_put" || mp.Field != "f" || mp.Before != "v" || mp.After != "v" {
+		t.Errorf("map_put: %+v", mp)
+	}
+	step := c.Steps[3]
+	sub := step.Call
+	if step.Op != "call" || sub == nil || sub.Contract != "b" || sub.Error != "failed" || step.Gas != GetCost.ToGas() {
+		t.Fatalf("call step: %+v", step)
+	}
+	if len(sub.Steps) != 1 || sub.Steps[0].Op != "get" || sub.Steps[0].Key != "b-k" {
+		t.Errorf("sub steps: %+v", sub.Steps)
+	}
+
+	j, err := tracer.JSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded Tracer
+	if err := json.Unmarshal([]byte(j), &decoded); err != nil || len(decoded.Calls) != 1 {
+		t.Fatal(j, err)
+	}
+}
@@ -1,6 +1,8 @@
 package host
 
 import (
+	"encoding/json"
+
 	"github.com/iost-official/go-iost/core/contract"
 	"github.com/iost-official/go-iost/core/event"
 	"github.com/iost-official/go-iost/core/tx"
@@ -26,6 +28,10 @@ func (h *APIDelegate) receipt(t tx.ReceiptType, s string) {
 	rs := h.h.ctx.GValue("receipts").([]tx.Receipt)
 	h.h.ctx.GSet("receipts", append(rs, rec))
 
+	// the events are posted when their block is linked
+	if t == tx.EventDefined {
+		return
+	}
 	topic := event.Event_ContractSystemEvent
 	if t == tx.UserDefined {
 		topic = event.Event_ContractUserEvent
@@ -46,3 +52,25 @@ func (h *APIDelegate) Receipt(s string) *contract.Cost {
 	h.receipt(tx.UserDefined, s)
 	return ReceiptCost(len(s))
 }
+
+// Emit emits a structured event of the contract, the topics are indexed for filtering
+func (h *APIDelegate) Emit(name string, topics []string, data string) (*contract.Cost, error) {
+	if name == "" || len(topics) > tx.MaxEventTopics {
+		return EventCost(0), ErrInvalidEvent
+	}
+	if topics == nil {
+		topics = []string{}
+	}
+	contractName, _ := h.h.ctx.Value("contract_name").(string)
+	b, err := json.Marshal(&tx.Event{
+		Contract: contractName,
+		Name:     name,
+		Topics:   topics,
+		Data:     data,
+	})
+	if err != nil {
+		return EventCost(0), err
+	}
+	h.receipt(tx.EventDefined, string(b))
+	return EventCost(len(b)), nil
+}
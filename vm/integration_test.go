@@ -974,3 +974,52 @@ func TestJS_LuckyBet(t *testing.T) {
 		t.Log(js.vi.Balance("CA"+js.cname), js.cname)
 	})
 }
+
+func TestJS_InfiniteLoop(t *testing.T) {
+	ilog.Stop()
+	forks := common.Forks
+	common.SetForks(&common.ForkConfig{ParallelHeight: -1, MeteringHeight: 0})
+	defer common.SetForks(forks)
+
+	js := NewJSTester(t)
+	defer js.Clear()
+
+	js.SetJS(`
+class Contract {
+	init() {
+	}
+	loop() {
+		let i = 0;
+		while (true) {
+			i++;
+		}
+	}
+}
+
+module.exports = Contract;
+`)
+	js.SetAPI("loop")
+	js.DoSet()
+
+	// the loop stops at the same instruction on every run, however long each of them takes
+	var gas int64
+	for i := 0; i < 3; i++ {
+		js.NewBlock(&block.BlockHead{
+			ParentHash: []byte("abc"),
+			Number:     int64(201 + i),
+			Witness:    "witness",
+			Time:       123456,
+		})
+		r := js.TestJS("loop", `[]`)
+		if r.Status.Code != tx.ErrorGasRunOut {
+			t.Fatalf("run %v: %v", i, r.Status)
+		}
+		if i > 0 && r.GasUsage != gas {
+			t.Fatalf("run %v: gas %v, the first run used %v", i, r.GasUsage, gas)
+		}
+		gas = r.GasUsage
+	}
+	if gas <= 0 {
+		t.Fatalf("gas: %v", gas)
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/iost-official/go-iost/vm/host"
 	"github.com/iost-official/go-iost/vm/native"
 	"github.com/iost-official/go-iost/vm/v8vm"
+	"github.com/iost-official/go-iost/vm/wasm"
 )
 
 var (
@@ -30,6 +31,9 @@ func NewMonitor() *Monitor {
 	}
 	jsvm := Factory("javascript")
 	m.vms["javascript"] = jsvm
+	// create all vms here, vms is read by engines running in parallel
+	m.vms["native"] = Factory("native")
+	m.vms["wasm"] = Factory("wasm")
 	return m
 }
 
@@ -112,6 +116,8 @@ func (m *Monitor) Compile(con *contract.Contract) (string, error) {
 	case "javascript":
 		jsvm, _ := m.vms["javascript"]
 		return jsvm.Compile(con)
+	case "wasm":
+		return m.vms["wasm"].Compile(con)
 	}
 	return "", errors.New("vm unsupported")
 }
@@ -152,6 +158,10 @@ func Factory(lang string) VM {
 		vm.Init()
 		//vm.SetJSPath(jsPath)
 		return vm
+	case "wasm":
+		vm := wasm.NewVM()
+		vm.Init()
+		return vm
 	}
 	return nil
 }
@@ -0,0 +1,73 @@
+package native
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/iost-official/go-iost/common"
+	"github.com/iost-official/go-iost/core/contract"
+	"github.com/iost-official/go-iost/vm/database"
+	"github.com/iost-official/go-iost/vm/host"
+)
+
+// consensusParamsKey is the key of the consensus parameters in state db
+const consensusParamsKey = "iost.system-consensus"
+
+// LoadConsensusParams returns the consensus parameters stored in the genesis block, it returns nil if there is none.
+func LoadConsensusParams(vi *database.Visitor) (*common.GenesisConsensusConfig, error) {
+	v := database.MustUnmarshal(vi.Get(consensusParamsKey))
+	if v == nil {
+		return nil, nil
+	}
+	s, ok := v.(string)
+	if !ok {
+		return nil, errors.New("wrong type of consensus params")
+	}
+	params := common.DefaultGenesisConsensusConfig()
+	err := json.Unmarshal([]byte(s), params)
+	if err != nil {
+		return nil, err
+	}
+	return params, params.Validate()
+}
+
+var (
+	// initConsensus can only be invoked in genesis block, saves the consensus parameters
+	initConsensus = &abi{
+		name: "InitConsensus",
+		args: []string{"number", "number", "number", "number", "number", "number", "number", "number", "number", "number", "number"},
+		do: func(h *host.Host, args ...interface{}) (rtn []interface{}, cost *contract.Cost, err error) {
+			cost = contract.Cost0()
+
+			if h.Context().Value("number").(int64) != 0 {
+				return []interface{}{}, cost, errors.New("InitConsensus in normal block")
+			}
+
+			params := &common.GenesisConsensusConfig{
+				SlotLength:         args[0].(int64),
+				ConfirmNumber:      args[1].(int64),
+				ConfirmNumerator:   args[2].(int64),
+				ConfirmDenominator: args[3].(int64),
+				MaxBlockGas:        args[4].(int64),
+				MaxBlockSize:       args[5].(int64),
+				ProducerNumber:     args[6].(int64),
+				Forks: common.ForkConfig{
+					ParallelHeight: args[7].(int64),
+					MeteringHeight: args[8].(int64),
+					BeaconHeight:   args[9].(int64),
+					LimitHeight:    args[10].(int64),
+				},
+			}
+			err = params.Validate()
+			if err != nil {
+				return []interface{}{}, cost, err
+			}
+			b, err := json.Marshal(params)
+			if err != nil {
+				return []interface{}{}, cost, err
+			}
+			h.DB().Put(consensusParamsKey, database.MustMarshal(string(b)))
+			return []interface{}{}, cost, nil
+		},
+	}
+)
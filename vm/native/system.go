@@ -4,6 +4,8 @@ import (
 	"errors"
 
 	"github.com/bitly/go-simplejson"
+	"github.com/iost-official/go-iost/common"
+	"github.com/iost-official/go-iost/core/block"
 	"github.com/iost-official/go-iost/core/contract"
 	"github.com/iost-official/go-iost/vm/host"
 )
@@ -23,6 +25,8 @@ func init() {
 	register(&systemABIs, destroyCode)
 	register(&systemABIs, issueIOST)
 	register(&systemABIs, initSetCode)
+	register(&systemABIs, verifyEvidence)
+	register(&systemABIs, initConsensus)
 }
 
 // var .
@@ -182,4 +186,23 @@ var (
 			return []interface{}{actID}, cost, err
 		},
 	}
+
+	// verifyEvidence checks the base58 encoded double-sign evidence, returns the witness and the slot
+	verifyEvidence = &abi{
+		name: "VerifyEvidence",
+		args: []string{"string"},
+		do: func(h *host.Host, args ...interface{}) (rtn []interface{}, cost *contract.Cost, err error) {
+			cost = host.VerifyEvidenceCost
+			var e block.Evidence
+			err = e.Decode(common.Base58Decode(args[0].(string)))
+			if err != nil {
+				return nil, host.CommonErrorCost(1), err
+			}
+			err = e.Verify()
+			if err != nil {
+				return nil, cost, err
+			}
+			return []interface{}{e.Witness(), e.Slot()}, cost, nil
+		},
+	}
 )
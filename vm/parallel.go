@@ -0,0 +1,146 @@
+package vm
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/iost-official/go-iost/common"
+	"github.com/iost-official/go-iost/core/block"
+	"github.com/iost-official/go-iost/core/tx"
+	"github.com/iost-official/go-iost/db"
+	"github.com/iost-official/go-iost/metrics"
+	"github.com/iost-official/go-iost/vm/database"
+)
+
+var (
+	metricsParallelTxCount   = metrics.NewCounter("iost_vm_parallel_tx", nil)
+	metricsReexecutedTxCount = metrics.NewCounter("iost_vm_reexecuted_tx", nil)
+)
+
+// ParallelWorkers returns the number of workers executing txs in parallel in the config
+func ParallelWorkers() int {
+	return parallelWorkers
+}
+
+// Executor executes the txs of a block in batches.
+//
+// With more than one worker and from the parallel fork on, the txs of a batch are executed speculatively
+// on forks of the db, recording the keys each tx reads and writes. The results are merged in block order,
+// a tx whose keys are written by the txs merged before it in the batch is executed again on the merged
+// state. The fees paid to the witness are credited when merging, so they don't make every pair of txs
+// conflict, and a tx touching the balance of a credited account is executed again. The receipts and the
+// state are the same as executing the txs one by one by the engine.
+type Executor struct {
+	engine  Engine
+	bh      *block.BlockHead
+	db      db.MVCCDB
+	workers int
+}
+
+// NewExecutor returns an Executor of the block on db, the txs executed one by one are run by engine,
+// which must be the engine of the block on db.
+func NewExecutor(engine Engine, bh *block.BlockHead, mvccdb db.MVCCDB, workers int) *Executor {
+	return &Executor{
+		engine:  engine,
+		bh:      bh,
+		db:      mvccdb,
+		workers: workers,
+	}
+}
+
+type speculation struct {
+	rdb     *database.RecordDB
+	credits map[string]int64
+	receipt *tx.TxReceipt
+	err     error
+}
+
+// Exec executes the txs in order with their time limits, returns the receipts and the errors of the txs.
+func (e *Executor) Exec(txs []*tx.Tx, limits []time.Duration) ([]*tx.TxReceipt, []error) {
+	receipts := make([]*tx.TxReceipt, len(txs))
+	errs := make([]error, len(txs))
+	if e.workers < 2 || !common.Forks.IsParallel(e.bh.Number) {
+		for i, t := range txs {
+			receipts[i], errs[i] = e.engine.Exec(t, limits[i])
+		}
+		return receipts, errs
+	}
+
+	specs := e.speculate(txs, limits)
+	merged := database.NewRecordDB(e.db)
+	credited := make(map[string]bool)
+	for i, t := range txs {
+		s := specs[i]
+		for id := range s.credits {
+			credited[id] = true
+		}
+		// a tx may run out of time only because of the other txs running in parallel, so it's tried again
+		if s.timeout() || s.rdb.Conflict(merged) || s.touches(credited) {
+			metricsReexecutedTxCount.Add(1, nil)
+			receipts[i], errs[i] = NewEngine(e.bh, merged).Exec(t, limits[i])
+			continue
+		}
+		metricsParallelTxCount.Add(1, nil)
+		receipts[i], errs[i] = s.receipt, s.err
+		if s.err != nil {
+			continue
+		}
+		if err := s.rdb.Apply(merged); err != nil {
+			receipts[i], errs[i] = nil, err
+			merged.Rollback()
+			continue
+		}
+		ids := make([]string, 0, len(s.credits))
+		for id := range s.credits {
+			ids = append(ids, id)
+		}
+		sort.Strings(ids)
+		vi := database.NewVisitor(0, merged)
+		for _, id := range ids {
+			vi.SetBalance(id, s.credits[id])
+		}
+		merged.Commit()
+	}
+	return receipts, errs
+}
+
+func (s *speculation) timeout() bool {
+	return s.err == ErrTimeout || s.receipt != nil && s.receipt.Status.Code == tx.ErrorTimeout
+}
+
+// touches returns whether the tx touched the balance of any of the accounts, the credits of the accounts
+// are deferred in the speculation, so the balances it touched may be different from the ones of executing
+// the txs one by one.
+func (s *speculation) touches(accounts map[string]bool) bool {
+	for id := range accounts {
+		if s.rdb.TouchedBalance(id) {
+			return true
+		}
+	}
+	return false
+}
+
+func (e *Executor) speculate(txs []*tx.Tx, limits []time.Duration) []*speculation {
+	specs := make([]*speculation, len(txs))
+	sem := make(chan struct{}, e.workers)
+	var wg sync.WaitGroup
+	for i := range txs {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			s := &speculation{rdb: database.NewRecordDB(e.db.Fork())}
+			engine := newEngine(e.bh, database.NewVisitor(defaultCacheLength, s.rdb)).(*engineImpl)
+			engine.ho.DeferCredits()
+			s.receipt, s.err = engine.Exec(txs[i], limits[i])
+			s.credits = engine.ho.Credits()
+			specs[i] = s
+		}(i)
+	}
+	wg.Wait()
+	return specs
+}
@@ -0,0 +1,184 @@
+package vm
+
+import (
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/iost-official/go-iost/account"
+	"github.com/iost-official/go-iost/common"
+	"github.com/iost-official/go-iost/core/block"
+	"github.com/iost-official/go-iost/core/contract"
+	"github.com/iost-official/go-iost/core/tx"
+	"github.com/iost-official/go-iost/crypto"
+	"github.com/iost-official/go-iost/db"
+	"github.com/iost-official/go-iost/db/kv"
+	"github.com/iost-official/go-iost/ilog"
+	"github.com/iost-official/go-iost/vm/database"
+)
+
+// payContract is a js contract, "pay" transfers 1000000 from its first argument to the second one,
+// and puts the result code of the transfer to the key "k", so the result depends on the balance.
+func payContract() *contract.Contract {
+	return &contract.Contract{
+		ID: "Contractpay",
+		Code: `
+class Contract {
+	init() {
+	}
+	pay(from, to) {
+		storage.put("k", JSON.stringify(BlockChain.transfer(from, to, 1000000)));
+	}
+}
+
+module.exports = Contract;
+`,
+		Info: &contract.Info{
+			Lang:    "javascript",
+			Version: "1.0.0",
+			Abi: []*contract.ABI{
+				{
+					Name:     "pay",
+					Payment:  0,
+					GasPrice: int64(1),
+					Limit:    contract.NewCost(10000, 10000, 10000),
+					Args:     []string{"string", "string"},
+				},
+			},
+		},
+	}
+}
+
+// execBlock executes the txs of the block on a new db, returns the receipts, the errors and all the
+// keys and values of the state.
+func execBlock(t *testing.T, path string, workers int, bh *block.BlockHead, txs []*tx.Tx) ([]*tx.TxReceipt, []error, map[string]string) {
+	mvccdb, err := db.NewMVCCDB(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(path)
+	vi := database.NewVisitor(0, mvccdb)
+	for i := 0; i < 5; i++ {
+		vi.SetBalance(testID[2*i], 1000000)
+	}
+	vi.SetBalance(testID[10], 1000)
+	vi.SetContract(systemContract)
+	vi.SetContract(payContract())
+	vi.Commit()
+
+	limits := make([]time.Duration, len(txs))
+	for i := range limits {
+		limits[i] = time.Second
+	}
+	receipts, errs := NewExecutor(NewEngine(bh, mvccdb), bh, mvccdb, workers).Exec(txs, limits)
+
+	mvccdb.Tag("block")
+	if err := mvccdb.Flush("block"); err != nil {
+		t.Fatal(err)
+	}
+	mvccdb.Close()
+	storage, err := kv.NewStorage(path, kv.LevelDBStorage)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer storage.Close()
+	keys, err := storage.Keys(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	state := make(map[string]string, len(keys))
+	for _, k := range keys {
+		v, err := storage.Get(k)
+		if err != nil {
+			t.Fatal(err)
+		}
+		state[string(k)] = string(v)
+	}
+	return receipts, errs, state
+}
+
+func TestExecutor_Parallel(t *testing.T) {
+	ilog.Stop()
+	forks := common.Forks
+	common.SetForks(&common.ForkConfig{ParallelHeight: 0, MeteringHeight: -1, BeaconHeight: -1, LimitHeight: -1})
+	defer common.SetForks(forks)
+
+	call := func(from int, contract, api, args string) *tx.Tx {
+		act := tx.NewAction(contract, api, args)
+		ac, err := account.NewAccount(common.Base58Decode(testID[2*from+1]), crypto.Secp256k1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		trx, err := MakeTxWithAuth(act, ac)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return trx
+	}
+	transfer := func(from, to int, amount int64) *tx.Tx {
+		return call(from, "iost.system", "Transfer", fmt.Sprintf(`["%v","%v",%v]`, testID[2*from], testID[2*to], amount))
+	}
+	// the witness is an account publishing txs
+	witness := testID[8]
+	pay := func(from, to int) *tx.Tx {
+		return call(from, "Contractpay", "pay", fmt.Sprintf(`["%v","%v"]`, testID[2*from], testID[2*to]))
+	}
+	bh := &block.BlockHead{
+		ParentHash: []byte("abc"),
+		Number:     10,
+		Witness:    witness,
+		Time:       123456,
+	}
+	// the witness transfers all of its balance but the fee of the tx, which is enough to pay the fee to itself
+	// and the bonus one after the other, but not if the fee to itself is deferred
+	receipts, _, _ := execBlock(t, "mvcc_fee", 0, bh, []*tx.Tx{transfer(4, 0, 1)})
+	fee := receipts[0].GasUsage
+	txs := []*tx.Tx{
+		transfer(4, 0, 1000000-fee),
+		transfer(0, 4, 300000), // pays the witness
+		transfer(2, 3, 100),
+		pay(4, 0),           // the publisher is the witness, reads the balance credited by the previous txs
+		transfer(4, 0, 100), // the publisher is the witness
+		transfer(0, 1, 100), // same publisher as the second tx
+		transfer(1, 2, 200), // reads the balances written by the previous txs
+		pay(1, 3),
+		call(2, "Contractpay", "missing", `[]`), // fails in the execution
+		transfer(5, 0, 2000),                    // balance not enough to pay the gas
+		transfer(3, 1, 2000000),                 // balance not enough to transfer
+		pay(3, 4),
+	}
+
+	serialReceipts, serialErrs, serialState := execBlock(t, "mvcc_serial", 0, bh, txs)
+	for _, workers := range []int{2, 4, len(txs)} {
+		receipts, errs, state := execBlock(t, "mvcc_parallel", workers, bh, txs)
+		for i := range txs {
+			if (serialErrs[i] == nil) != (errs[i] == nil) {
+				t.Fatalf("workers %v, tx %v: serial err %v, parallel err %v", workers, i, serialErrs[i], errs[i])
+			}
+			if serialErrs[i] != nil {
+				continue
+			}
+			if string(serialReceipts[i].Encode()) != string(receipts[i].Encode()) {
+				t.Fatalf("workers %v, tx %v: serial receipt %v, parallel receipt %v", workers, i, serialReceipts[i], receipts[i])
+			}
+		}
+		if len(serialState) != len(state) {
+			t.Fatalf("workers %v: serial state has %v keys, parallel state has %v", workers, len(serialState), len(state))
+		}
+		for k, v := range serialState {
+			if state[k] != v {
+				t.Fatalf("workers %v, key %q: serial %q, parallel %q", workers, k, v, state[k])
+			}
+		}
+	}
+
+	for i, code := range map[int]tx.StatusCode{0: tx.Success, 3: tx.Success, 4: tx.Success, 8: tx.ErrorRuntime, 10: tx.ErrorRuntime} {
+		if serialErrs[i] != nil || serialReceipts[i].Status.Code != code {
+			t.Fatalf("tx %v: err %v, receipt %v", i, serialErrs[i], serialReceipts[i])
+		}
+	}
+	if serialErrs[9] == nil {
+		t.Fatal("tx of balance not enough to pay the gas succeeded")
+	}
+}
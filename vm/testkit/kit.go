@@ -0,0 +1,274 @@
+// Package testkit runs contracts in memory for testing them without a node.
+package testkit
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/iost-official/go-iost/account"
+	"github.com/iost-official/go-iost/common"
+	"github.com/iost-official/go-iost/core/block"
+	"github.com/iost-official/go-iost/core/contract"
+	"github.com/iost-official/go-iost/core/tx"
+	"github.com/iost-official/go-iost/crypto"
+	"github.com/iost-official/go-iost/vm"
+	"github.com/iost-official/go-iost/vm/database"
+	"github.com/iost-official/go-iost/vm/host"
+	"github.com/iost-official/go-iost/vm/native"
+)
+
+// default settings of the kit
+var (
+	DefaultGasLimit int64 = 100000
+	DefaultGasPrice int64 = 1
+	DefaultBalance  int64 = 1000000000
+	// ExecTimeLimit is the time limit of a call
+	ExecTimeLimit = 10 * time.Second
+)
+
+var (
+	errNoSigner      = errors.New("no signer of the call")
+	errDeploy        = errors.New("deploy failed")
+	errAccountExists = errors.New("account exists")
+)
+
+// Kit is a chain in memory, the txs are executed by the vm on a database.Visitor over a database.MemDB.
+// Each call is in the current block, the block only changes by Advance.
+type Kit struct {
+	db       *database.MemDB
+	visitor  *database.Visitor
+	head     *block.BlockHead
+	now      time.Time
+	witness  *account.Account
+	accounts map[string]*account.Account
+
+	GasLimit int64
+	GasPrice int64
+}
+
+// New returns a kit with the system contract at block 1, the changes of all the forks take effect.
+func New() *Kit {
+	return NewWithForks(&common.ForkConfig{})
+}
+
+// NewWithForks returns a kit with the system contract at block 1, running by the rules of the forks. The forks
+// are set to common.Forks, which is global, so the kits of a process run by the forks of the last one created.
+func NewWithForks(forks *common.ForkConfig) *Kit {
+	common.SetForks(forks)
+	db := database.NewMemDB()
+	k := &Kit{
+		db:       db,
+		visitor:  database.NewVisitor(0, db),
+		now:      time.Now(),
+		accounts: make(map[string]*account.Account),
+		GasLimit: DefaultGasLimit,
+		GasPrice: DefaultGasPrice,
+	}
+	k.witness, _ = account.NewAccount(crypto.Ed25519.GenSeckey(), crypto.Ed25519)
+	k.visitor.SetContract(native.ABI())
+	k.visitor.Commit()
+
+	genesis := &block.Block{
+		Head: &block.BlockHead{
+			Witness: k.witness.ID,
+			Time:    k.slot(),
+		},
+	}
+	genesis.CalculateHeadHash()
+	k.head = k.nextHead(genesis, 1)
+	return k
+}
+
+func (k *Kit) slot() int64 {
+	return k.now.Unix() / common.SlotLength
+}
+
+// nextHead returns the head of the block after parent at number, with the beacon of the witness, and keeps
+// the random of the block in the state.
+func (k *Kit) nextHead(parent *block.Block, number int64) *block.BlockHead {
+	head := &block.BlockHead{
+		ParentHash: parent.HeadHash(),
+		Number:     number,
+		Witness:    k.witness.ID,
+		Time:       k.slot(),
+	}
+	if beacon, err := block.NewBeacon(parent, k.witness.Algorithm, k.witness.Seckey); err == nil {
+		head.SetBeacon(beacon)
+	}
+	vm.RecordRandom(head, k.db)
+	return head
+}
+
+// Head returns the head of the current block.
+func (k *Kit) Head() *block.BlockHead {
+	return k.head
+}
+
+// Now returns the time of the chain.
+func (k *Kit) Now() time.Time {
+	return k.now
+}
+
+// Advance moves the chain forward by blocks and d, the following calls are in the new block.
+func (k *Kit) Advance(blocks int64, d time.Duration) {
+	k.now = k.now.Add(d)
+	if blocks <= 0 {
+		k.head.Time = k.slot()
+		return
+	}
+	parent := &block.Block{Head: k.head}
+	parent.CalculateHeadHash()
+	k.head = k.nextHead(parent, k.head.Number+blocks)
+}
+
+// NewAccount creates an ed25519 account with the balance, it's referred to by name in the kit.
+func (k *Kit) NewAccount(name string, balance int64) (*account.Account, error) {
+	if _, ok := k.accounts[name]; ok {
+		return nil, errAccountExists
+	}
+	acc, err := account.NewAccount(crypto.Ed25519.GenSeckey(), crypto.Ed25519)
+	if err != nil {
+		return nil, err
+	}
+	k.accounts[name] = acc
+	k.SetBalance(acc.ID, balance)
+	return acc, nil
+}
+
+// Account returns the account of name, nil if there is none.
+func (k *Kit) Account(name string) *account.Account {
+	return k.accounts[name]
+}
+
+// ID returns the id of the account name, or name itself if it isn't an account of the kit.
+func (k *Kit) ID(name string) string {
+	if acc, ok := k.accounts[name]; ok {
+		return acc.ID
+	}
+	return name
+}
+
+// Balance returns the balance of the account name or id.
+func (k *Kit) Balance(name string) int64 {
+	return k.visitor.Balance(k.ID(name))
+}
+
+// SetBalance sets the balance of the account name or id.
+func (k *Kit) SetBalance(name string, balance int64) {
+	id := k.ID(name)
+	k.visitor.SetBalance(id, balance-k.visitor.Balance(id))
+	k.visitor.Commit()
+}
+
+// Get returns the value of key in the storage of the contract.
+func (k *Kit) Get(contractID, key string) interface{} {
+	return database.MustUnmarshal(k.visitor.Get(contractID + database.Separator + key))
+}
+
+// MapGet returns the value of field of the map key in the storage of the contract.
+func (k *Kit) MapGet(contractID, key, field string) interface{} {
+	return database.MustUnmarshal(k.visitor.MGet(contractID+database.Separator+key, field))
+}
+
+// Contract returns the deployed contract, nil if there is none.
+func (k *Kit) Contract(contractID string) *contract.Contract {
+	return k.visitor.Contract(contractID)
+}
+
+// Deploy deploys the contract of the code and its abi in json, and returns the contract id.
+func (k *Kit) Deploy(code, abi string, publisher string) (string, *Result, error) {
+	con, err := (&contract.Compiler{}).Parse("", code, abi)
+	if err != nil {
+		return "", nil, err
+	}
+	r, err := k.Call("iost.system", "SetCode", fmt.Sprintf(`["%v"]`, con.B64Encode()), publisher)
+	if err != nil {
+		return "", r, err
+	}
+	if !r.Succeeded() || len(r.Return) == 0 {
+		return "", r, fmt.Errorf("%v: %v", errDeploy, r.Receipt.Status.Message)
+	}
+	id, _ := r.Return[0].(string)
+	return id, r, nil
+}
+
+// Call calls the api of the contract with the args in json, signed by the signers. The first signer
+// publishes the tx and pays for it, the others are the signers of the tx. The error is returned only if
+// the tx can't be executed, the failure of the call is in the receipt.
+func (k *Kit) Call(contractID, api, args string, signers ...string) (*Result, error) {
+	if len(signers) == 0 {
+		return nil, errNoSigner
+	}
+	accs := make([]*account.Account, len(signers))
+	for i, name := range signers {
+		if accs[i] = k.accounts[name]; accs[i] == nil {
+			return nil, fmt.Errorf("unknown account %v", name)
+		}
+	}
+	pubkeys := make([][]byte, 0, len(accs)-1)
+	for _, acc := range accs[1:] {
+		pubkeys = append(pubkeys, acc.Pubkey)
+	}
+
+	act := tx.NewAction(contractID, api, args)
+	trx := tx.NewTx([]*tx.Action{&act}, pubkeys, k.GasLimit, k.GasPrice, k.now.Add(time.Minute).UnixNano())
+	trx.Time = k.now.UnixNano()
+	signs := make([]*crypto.Signature, 0, len(accs)-1)
+	for _, acc := range accs[1:] {
+		sig, err := tx.SignTxContent(trx, acc)
+		if err != nil {
+			return nil, err
+		}
+		signs = append(signs, sig)
+	}
+	trx, err := tx.SignTx(trx, accs[0], signs...)
+	if err != nil {
+		return nil, err
+	}
+
+	e := vm.NewEngine(k.head, k.db)
+	defer e.GC()
+	receipt, tracer, err := e.Trace(trx, ExecTimeLimit)
+	r := &Result{
+		Receipt: receipt,
+		Trace:   tracer,
+	}
+	if len(tracer.Calls) > 0 {
+		r.Return = tracer.Calls[0].Return
+	}
+	return r, err
+}
+
+// Result is the result of a call.
+type Result struct {
+	Receipt *tx.TxReceipt
+	Return  []interface{}
+	Trace   *host.Tracer
+}
+
+// Succeeded returns if the call succeeded.
+func (r *Result) Succeeded() bool {
+	return r.Receipt.Status.Code == tx.Success
+}
+
+// Gas returns the gas usage of the call.
+func (r *Result) Gas() int64 {
+	return r.Receipt.GasUsage
+}
+
+// Receipts returns the contents of the receipts of the contracts.
+func (r *Result) Receipts() []string {
+	contents := make([]string, 0)
+	for _, rc := range r.Receipt.Receipts {
+		if rc.Type == tx.UserDefined {
+			contents = append(contents, rc.Content)
+		}
+	}
+	return contents
+}
+
+// Events returns the events emitted by the contracts.
+func (r *Result) Events() []*tx.Event {
+	return r.Receipt.Events()
+}
@@ -0,0 +1,205 @@
+package testkit
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"testing"
+	"time"
+)
+
+// echoModule is a wasm contract, "echo" returns its argument and "set" puts it to the key "k".
+const echoModule = "0061736d0100000001190560017f017f60017f0060027f7f0060000060047f7f7f7f0002300404696f737403617267000004696f737406726573756c74000104696f737403726574000204696f737403707574000403030203030503010001070e02046563686f00040373657400050a30021401017f410010002100410010014100200010020b1901017f4100100021004100100141e40041014100200010030b0b08010041e4000b016b"
+
+const echoABI = `{"lang":"wasm","VersionCode":"1.0.0","abi":[{"name":"echo","args":["string"]},{"name":"set","args":["string"]}]}`
+
+// echoJS is the js contract of the same apis as echoModule.
+const echoJS = `class Echo {
+    init() {
+    }
+    echo(s) {
+        return s;
+    }
+    set(s) {
+        storage.put("k", s);
+    }
+}
+
+module.exports = Echo;`
+
+const echoJSABI = `{"lang":"javascript","version":"1.0.0","abi":[{"name":"echo","args":["string"]},{"name":"set","args":["string"]}]}`
+
+func echoCode(t *testing.T) string {
+	b, err := hex.DecodeString(echoModule)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return base64.StdEncoding.EncodeToString(b)
+}
+
+func TestKit_Transfer(t *testing.T) {
+	k := New()
+	k.NewAccount("alice", 1000000)
+	k.NewAccount("bob", 0)
+
+	r, err := k.Call("iost.system", "Transfer", `["$a", "$b", 100]`, "alice")
+	if err != nil || r.Succeeded() {
+		t.Fatalf("transfer from unknown account: %v %v", err, r.Receipt.Status)
+	}
+	gas := r.Gas()
+
+	args := `["` + k.ID("alice") + `", "` + k.ID("bob") + `", 100]`
+	r, err = k.Call("iost.system", "Transfer", args, "alice")
+	if err != nil || !r.Succeeded() {
+		t.Fatalf("transfer: %v %v", err, r.Receipt.Status)
+	}
+	if r.Gas() <= 0 {
+		t.Fatalf("gas: %v", r.Gas())
+	}
+	gas += r.Gas()
+	if k.Balance("bob") != 100 || k.Balance("alice") != 1000000-100-gas*k.GasPrice {
+		t.Fatalf("balance: %v %v", k.Balance("alice"), k.Balance("bob"))
+	}
+
+	if _, err := k.Call("iost.system", "Transfer", args, "carol"); err == nil {
+		t.Fatal("call by unknown signer")
+	}
+	if _, err := k.NewAccount("alice", 0); err != errAccountExists {
+		t.Fatalf("new account: %v", err)
+	}
+}
+
+func TestKit_Deploy(t *testing.T) {
+	k := New()
+	k.NewAccount("alice", DefaultBalance)
+	id, _, err := k.Deploy(echoCode(t), echoABI, "alice")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if k.Contract(id) == nil {
+		t.Fatalf("contract %v isn't deployed", id)
+	}
+
+	r, err := k.Call(id, "echo", `["hello"]`, "alice")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := r.Check(&Expect{Return: []interface{}{"hello"}}); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.Check(&Expect{Return: []interface{}{"bye"}}); err == nil {
+		t.Fatal("wrong return passed the check")
+	}
+
+	if _, err := k.Call(id, "set", `["v"]`, "alice"); err != nil {
+		t.Fatal(err)
+	}
+	if v := k.Get(id, "k"); v != "v" {
+		t.Fatalf("storage: %v", v)
+	}
+}
+
+func TestKit_DeployJS(t *testing.T) {
+	k := New()
+	k.NewAccount("alice", DefaultBalance)
+	id, r, err := k.Deploy(echoJS, echoJSABI, "alice")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Gas() <= 0 {
+		t.Fatalf("gas of deploy: %v", r.Gas())
+	}
+	if c := k.Contract(id); c == nil || c.Info.Lang != "javascript" || c.ABI("echo") == nil {
+		t.Fatalf("contract %v isn't deployed with the abi: %v", id, c)
+	}
+
+	r, err = k.Call(id, "echo", `["hello"]`, "alice")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := r.Check(&Expect{Return: []interface{}{"hello"}}); err != nil {
+		t.Fatal(err)
+	}
+	if r, err := k.Call(id, "missing", `[]`, "alice"); err != nil || r.Succeeded() {
+		t.Fatalf("call of the api not in the abi: %v %v", err, r.Receipt.Status)
+	}
+
+	if _, err := k.Call(id, "set", `["v"]`, "alice"); err != nil {
+		t.Fatal(err)
+	}
+	if v := k.Get(id, "k"); v != "v" {
+		t.Fatalf("storage: %v", v)
+	}
+}
+
+func TestKit_Advance(t *testing.T) {
+	k := New()
+	head, now := k.Head(), k.Now()
+	k.Advance(2, 6*time.Second)
+	if k.Head().Number != head.Number+2 || !k.Now().Equal(now.Add(6*time.Second)) {
+		t.Fatalf("advance: %v %v", k.Head().Number, k.Now())
+	}
+	if k.Head().Time != k.Now().Unix()/3 {
+		t.Fatalf("head time: %v", k.Head().Time)
+	}
+	if _, err := k.Head().Beacon(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRun(t *testing.T) {
+	s, err := ParseScenario([]byte(`{
+		"accounts": {"alice": 1000000},
+		"tests": [{
+			"name": "set",
+			"steps": [
+				{"call": "echo", "args": ["$alice"], "signers": ["alice"], "expect": {"return": ["$alice"], "max_gas": 2000}},
+				{"call": "set", "args": ["v"], "advance": {"blocks": 1, "seconds": 3}, "storage": {"k": "v"}}
+			]
+		}, {
+			"name": "fail",
+			"steps": [{"call": "set", "args": ["v"], "storage": {"k": "w"}}]
+		}]
+	}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	results := Run(s, echoCode(t), echoABI)
+	if len(results) != 2 {
+		t.Fatalf("results: %v", len(results))
+	}
+	if results[0].Err != nil || results[0].Gas <= 0 {
+		t.Fatalf("set: %v %v", results[0].Err, results[0].Gas)
+	}
+	if results[1].Err == nil || results[1].Gas <= 0 {
+		t.Fatalf("fail: %v %v", results[1].Err, results[1].Gas)
+	}
+}
+
+func TestRun_JS(t *testing.T) {
+	s, err := ParseScenario([]byte(`{
+		"accounts": {"alice": 1000000},
+		"tests": [{
+			"name": "set",
+			"steps": [
+				{"call": "echo", "args": ["$contract"], "signers": ["alice"], "expect": {"return": ["$contract"]}},
+				{"call": "set", "args": ["v"], "advance": {"blocks": 1, "seconds": 3}, "storage": {"k": "v"}}
+			]
+		}, {
+			"name": "fail",
+			"steps": [{"call": "set", "args": ["v"], "storage": {"k": "w"}}]
+		}]
+	}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	results := Run(s, echoJS, echoJSABI)
+	if len(results) != 2 {
+		t.Fatalf("results: %v", len(results))
+	}
+	if results[0].Err != nil || results[0].Gas <= 0 {
+		t.Fatalf("set: %v %v", results[0].Err, results[0].Gas)
+	}
+	if results[1].Err == nil || results[1].Gas <= 0 {
+		t.Fatalf("fail: %v %v", results[1].Err, results[1].Gas)
+	}
+}
@@ -0,0 +1,235 @@
+package testkit
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Scenario is a test file of a contract, each test runs on a new kit with the accounts funded and
+// the contract deployed by the deployer. In the strings of the steps "$contract" is replaced by the
+// contract id and "$<name>" by the id of the account name.
+type Scenario struct {
+	Contract string           `json:"contract"`
+	ABI      string           `json:"abi"`
+	Deployer string           `json:"deployer"`
+	Accounts map[string]int64 `json:"accounts"`
+	Tests    []*Test          `json:"tests"`
+}
+
+// Test is a named list of steps
+type Test struct {
+	Name  string  `json:"name"`
+	Steps []*Step `json:"steps"`
+}
+
+// Step is a call of the contract, an advance of the chain or checks of the state, in the order.
+type Step struct {
+	Call     string        `json:"call"`
+	Contract string        `json:"contract"`
+	Args     []interface{} `json:"args"`
+	Signers  []string      `json:"signers"`
+	Expect   *Expect       `json:"expect"`
+
+	Advance *Advance `json:"advance"`
+
+	Storage map[string]interface{}            `json:"storage"`
+	Map     map[string]map[string]interface{} `json:"map"`
+	Balance map[string]int64                  `json:"balance"`
+}
+
+// Advance moves the chain forward
+type Advance struct {
+	Blocks  int64 `json:"blocks"`
+	Seconds int64 `json:"seconds"`
+}
+
+// Expect is the expected result of a call, the empty fields aren't checked. A call is expected to
+// succeed unless Error is set, then the call must fail with the message containing it.
+type Expect struct {
+	Error    string        `json:"error"`
+	Return   []interface{} `json:"return"`
+	MaxGas   int64         `json:"max_gas"`
+	Receipts []string      `json:"receipts"`
+}
+
+// TestResult is the result of a test of a scenario
+type TestResult struct {
+	Name string
+	Err  error
+	Gas  int64
+}
+
+// ParseScenario parses the scenario in json.
+func ParseScenario(data []byte) (*Scenario, error) {
+	s := &Scenario{}
+	if err := json.Unmarshal(data, s); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Check returns the first mismatch of the result and e.
+func (r *Result) Check(e *Expect) error {
+	if e == nil {
+		e = &Expect{}
+	}
+	if e.Error != "" {
+		if r.Succeeded() {
+			return fmt.Errorf("expect error %q, but succeeded", e.Error)
+		}
+		if !strings.Contains(r.Receipt.Status.Message, e.Error) {
+			return fmt.Errorf("expect error %q, got %q", e.Error, r.Receipt.Status.Message)
+		}
+		return nil
+	}
+	if !r.Succeeded() {
+		return fmt.Errorf("call failed: %v", r.Receipt.Status.Message)
+	}
+	if e.Return != nil && !jsonEqual(e.Return, r.Return) {
+		return fmt.Errorf("expect return %v, got %v", toJSON(e.Return), toJSON(r.Return))
+	}
+	if e.MaxGas > 0 && r.Gas() > e.MaxGas {
+		return fmt.Errorf("expect gas at most %v, got %v", e.MaxGas, r.Gas())
+	}
+	if e.Receipts != nil && !reflect.DeepEqual(e.Receipts, r.Receipts()) {
+		return fmt.Errorf("expect receipts %v, got %v", e.Receipts, r.Receipts())
+	}
+	return nil
+}
+
+// Run runs the tests of the scenario on the contract of code and abi.
+func Run(s *Scenario, code, abi string) []*TestResult {
+	results := make([]*TestResult, 0, len(s.Tests))
+	for _, t := range s.Tests {
+		r := &TestResult{Name: t.Name}
+		r.Gas, r.Err = s.run(t, code, abi)
+		results = append(results, r)
+	}
+	return results
+}
+
+func (s *Scenario) run(t *Test, code, abi string) (int64, error) {
+	k := New()
+	deployer := s.Deployer
+	if deployer == "" {
+		deployer = "deployer"
+	}
+	names := make([]string, 0, len(s.Accounts)+1)
+	for name := range s.Accounts {
+		names = append(names, name)
+	}
+	if _, ok := s.Accounts[deployer]; !ok {
+		names = append(names, deployer)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		balance, ok := s.Accounts[name]
+		if !ok {
+			balance = DefaultBalance
+		}
+		if _, err := k.NewAccount(name, balance); err != nil {
+			return 0, err
+		}
+	}
+
+	id, r, err := k.Deploy(code, abi, deployer)
+	if err != nil {
+		return 0, fmt.Errorf("deploy: %v", err)
+	}
+	gas := r.Gas()
+
+	// the longer names are replaced first, so that "$alice" doesn't break "$alice2"
+	sort.Slice(names, func(i, j int) bool {
+		return len(names[i]) > len(names[j])
+	})
+	pairs := []string{"$contract", id}
+	for _, name := range names {
+		pairs = append(pairs, "$"+name, k.ID(name))
+	}
+	expand := strings.NewReplacer(pairs...).Replace
+
+	for i, step := range t.Steps {
+		g, err := k.step(step, id, deployer, expand)
+		gas += g
+		if err != nil {
+			return gas, fmt.Errorf("step %v: %v", i+1, err)
+		}
+	}
+	return gas, nil
+}
+
+func (k *Kit) step(s *Step, id, deployer string, expand func(string) string) (int64, error) {
+	var gas int64
+	if s.Call != "" {
+		contractID := id
+		if s.Contract != "" {
+			contractID = expand(s.Contract)
+		}
+		args := "[]"
+		if s.Args != nil {
+			args = expand(toJSON(s.Args))
+		}
+		signers := s.Signers
+		if len(signers) == 0 {
+			signers = []string{deployer}
+		}
+		r, err := k.Call(contractID, s.Call, args, signers...)
+		if err != nil {
+			return 0, err
+		}
+		gas = r.Gas()
+		expect := s.Expect
+		if expect != nil && expect.Return != nil {
+			e := *expect
+			if err := json.Unmarshal([]byte(expand(toJSON(e.Return))), &e.Return); err != nil {
+				return gas, err
+			}
+			expect = &e
+		}
+		if err := r.Check(expect); err != nil {
+			return gas, fmt.Errorf("%v: %v", s.Call, err)
+		}
+	}
+	if s.Advance != nil {
+		k.Advance(s.Advance.Blocks, time.Duration(s.Advance.Seconds)*time.Second)
+	}
+	for key, want := range s.Storage {
+		if got := k.Get(id, expand(key)); !jsonEqual(want, got) {
+			return gas, fmt.Errorf("storage %v: expect %v, got %v", key, toJSON(want), toJSON(got))
+		}
+	}
+	for key, fields := range s.Map {
+		for field, want := range fields {
+			if got := k.MapGet(id, expand(key), expand(field)); !jsonEqual(want, got) {
+				return gas, fmt.Errorf("map %v %v: expect %v, got %v", key, field, toJSON(want), toJSON(got))
+			}
+		}
+	}
+	for name, want := range s.Balance {
+		if got := k.Balance(strings.TrimPrefix(name, "$")); got != want {
+			return gas, fmt.Errorf("balance of %v: expect %v, got %v", name, want, got)
+		}
+	}
+	return gas, nil
+}
+
+func toJSON(v interface{}) string {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(b)
+}
+
+// jsonEqual compares a and b as json, so the numbers of different types are equal.
+func jsonEqual(a, b interface{}) bool {
+	var x, y interface{}
+	if json.Unmarshal([]byte(toJSON(a)), &x) != nil || json.Unmarshal([]byte(toJSON(b)), &y) != nil {
+		return false
+	}
+	return reflect.DeepEqual(x, y)
+}
@@ -0,0 +1,335 @@
+package testkit
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"time"
+
+	"github.com/iost-official/go-iost/common"
+	"github.com/iost-official/go-iost/core/tx"
+)
+
+var errNoKit = errors.New("no kit, the test isn't started")
+
+// Request is a call of a js test to the kit, it's a line of json.
+type Request struct {
+	Method string            `json:"method"`
+	Args   []json.RawMessage `json:"args"`
+}
+
+// Response is the result of a request, it's a line of json.
+type Response struct {
+	Result interface{} `json:"result,omitempty"`
+	Error  string      `json:"error,omitempty"`
+}
+
+// CallResult is the result of a call given to a js test.
+type CallResult struct {
+	Succeeded bool          `json:"succeeded"`
+	Message   string        `json:"message"`
+	Return    []interface{} `json:"return"`
+	Gas       int64         `json:"gas"`
+	Receipts  []string      `json:"receipts"`
+	Events    []*tx.Event   `json:"events"`
+}
+
+// Server runs the requests of the js tests run by RunnerJS. Each test starts a new kit by the "start" request,
+// and ends by the "end" request with its error.
+type Server struct {
+	// Load returns the code and the abi of the contract at path, the abi is read from abiPath if it isn't empty.
+	Load func(path, abiPath string) (string, string, error)
+	// Report is called with the result of each test.
+	Report func(*TestResult)
+
+	kit *Kit
+	gas int64
+}
+
+// Serve runs the requests read from r and writes the responses to w, until r is closed.
+func (s *Server) Serve(r io.Reader, w io.Writer) error {
+	br := bufio.NewReader(r)
+	for {
+		line, err := br.ReadBytes('\n')
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		res := &Response{}
+		req := &Request{}
+		if err := json.Unmarshal(line, req); err != nil {
+			res.Error = err.Error()
+		} else {
+			res.Result, err = s.handle(req)
+			if err != nil {
+				res.Error = err.Error()
+			}
+		}
+		b, err := json.Marshal(res)
+		if err != nil {
+			return err
+		}
+		if _, err := w.Write(append(b, '\n')); err != nil {
+			return err
+		}
+	}
+}
+
+func (s *Server) handle(req *Request) (interface{}, error) {
+	if s.kit == nil && req.Method != "start" {
+		return nil, errNoKit
+	}
+	switch req.Method {
+	case "start":
+		var name string
+		forks := &common.ForkConfig{}
+		if err := unmarshalArgs(req.Args, &name, forks); err != nil {
+			return nil, err
+		}
+		s.kit = NewWithForks(forks)
+		s.gas = 0
+		return nil, nil
+	case "end":
+		var name, msg string
+		if err := unmarshalArgs(req.Args, &name, &msg); err != nil {
+			return nil, err
+		}
+		r := &TestResult{Name: name, Gas: s.gas}
+		if msg != "" {
+			r.Err = errors.New(msg)
+		}
+		s.kit = nil
+		if s.Report != nil {
+			s.Report(r)
+		}
+		return nil, nil
+	case "newAccount":
+		var name string
+		var balance int64
+		if err := unmarshalArgs(req.Args, &name, &balance); err != nil {
+			return nil, err
+		}
+		acc, err := s.kit.NewAccount(name, balance)
+		if err != nil {
+			return nil, err
+		}
+		return acc.ID, nil
+	case "id":
+		var name string
+		if err := unmarshalArgs(req.Args, &name); err != nil {
+			return nil, err
+		}
+		return s.kit.ID(name), nil
+	case "deploy":
+		var path, abiPath, publisher string
+		if err := unmarshalArgs(req.Args, &path, &abiPath, &publisher); err != nil {
+			return nil, err
+		}
+		if s.Load == nil {
+			return nil, fmt.Errorf("can't load %v", path)
+		}
+		code, abi, err := s.Load(path, abiPath)
+		if err != nil {
+			return nil, err
+		}
+		id, r, err := s.kit.Deploy(code, abi, publisher)
+		if r != nil {
+			s.gas += r.Gas()
+		}
+		return id, err
+	case "call":
+		var contractID, api string
+		var args interface{}
+		var signers []string
+		if err := unmarshalArgs(req.Args, &contractID, &api, &args, &signers); err != nil {
+			return nil, err
+		}
+		if args == nil {
+			args = []interface{}{}
+		}
+		r, err := s.kit.Call(contractID, api, toJSON(args), signers...)
+		if err != nil {
+			return nil, err
+		}
+		s.gas += r.Gas()
+		return &CallResult{
+			Succeeded: r.Succeeded(),
+			Message:   r.Receipt.Status.Message,
+			Return:    r.Return,
+			Gas:       r.Gas(),
+			Receipts:  r.Receipts(),
+			Events:    r.Events(),
+		}, nil
+	case "advance":
+		var blocks, seconds int64
+		if err := unmarshalArgs(req.Args, &blocks, &seconds); err != nil {
+			return nil, err
+		}
+		s.kit.Advance(blocks, time.Duration(seconds)*time.Second)
+		return s.kit.Head().Number, nil
+	case "head":
+		return map[string]int64{"number": s.kit.Head().Number, "time": s.kit.Now().Unix()}, nil
+	case "get":
+		var contractID, key string
+		if err := unmarshalArgs(req.Args, &contractID, &key); err != nil {
+			return nil, err
+		}
+		return s.kit.Get(contractID, key), nil
+	case "mapGet":
+		var contractID, key, field string
+		if err := unmarshalArgs(req.Args, &contractID, &key, &field); err != nil {
+			return nil, err
+		}
+		return s.kit.MapGet(contractID, key, field), nil
+	case "balance":
+		var name string
+		if err := unmarshalArgs(req.Args, &name); err != nil {
+			return nil, err
+		}
+		return s.kit.Balance(name), nil
+	case "setBalance":
+		var name string
+		var balance int64
+		if err := unmarshalArgs(req.Args, &name, &balance); err != nil {
+			return nil, err
+		}
+		s.kit.SetBalance(name, balance)
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("unknown method %v", req.Method)
+	}
+}
+
+// RunJS runs the js test file by node with RunnerJS, the requests of the tests are served by s. The output of the
+// tests is written to stdout and stderr.
+func RunJS(file, filter string, s *Server, stdout, stderr io.Writer) error {
+	reqR, reqW, err := os.Pipe()
+	if err != nil {
+		return err
+	}
+	defer reqR.Close()
+	resR, resW, err := os.Pipe()
+	if err != nil {
+		reqW.Close()
+		return err
+	}
+
+	cmd := exec.Command("node", "-e", RunnerJS, file, filter)
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+	cmd.ExtraFiles = []*os.File{reqW, resR}
+	err = cmd.Start()
+	// the pipes of the child are closed here, so the requests end when it exits
+	reqW.Close()
+	resR.Close()
+	if err != nil {
+		resW.Close()
+		return err
+	}
+	serr := s.Serve(reqR, resW)
+	resW.Close()
+	if err := cmd.Wait(); err != nil {
+		return fmt.Errorf("node: %v", err)
+	}
+	return serr
+}
+
+// unmarshalArgs unmarshals the args into vs in order, the values of the missing and null args are unchanged.
+func unmarshalArgs(args []json.RawMessage, vs ...interface{}) error {
+	for i, v := range vs {
+		if i >= len(args) || string(args[i]) == "null" {
+			continue
+		}
+		if err := json.Unmarshal(args[i], v); err != nil {
+			return fmt.Errorf("arg %v: %v", i+1, err)
+		}
+	}
+	return nil
+}
+
+// RunnerJS is the node script running the js test file of its first argument, the tests whose names don't contain
+// its second argument are skipped. It writes the requests to fd 3 and reads the responses from fd 4.
+//
+// A test file registers the tests by the global test(name, fn, options), each test runs synchronously on a new
+// kit, which is the global kit. The forks of the kit are options.forks, all the forks take effect if it's omitted.
+const RunnerJS = `
+const fs = require("fs");
+const path = require("path");
+
+const file = path.resolve(process.argv[1]);
+const filter = process.argv[2] || "";
+const dir = path.dirname(file);
+
+let pending = Buffer.alloc(0);
+function readLine() {
+    for (;;) {
+        const i = pending.indexOf(10);
+        if (i >= 0) {
+            const line = pending.slice(0, i).toString();
+            pending = pending.slice(i + 1);
+            return line;
+        }
+        const b = Buffer.alloc(65536);
+        const n = fs.readSync(4, b, 0, b.length, null);
+        if (n === 0) {
+            throw new Error("testkit closed");
+        }
+        pending = Buffer.concat([pending, b.slice(0, n)]);
+    }
+}
+
+function request(method, ...args) {
+    fs.writeSync(3, JSON.stringify({method: method, args: args}) + "\n");
+    const res = JSON.parse(readLine());
+    if (res.error) {
+        throw new Error(res.error);
+    }
+    return res.result;
+}
+
+global.kit = {
+    // newAccount creates the account funded with the balance, and returns its id
+    newAccount: (name, balance) => request("newAccount", name, balance),
+    // id returns the id of the account name
+    id: name => request("id", name),
+    // deploy deploys the contract by the deployer, the paths are relative to the test file, and returns its id
+    deploy: (contract, deployer, abi) => request("deploy", path.resolve(dir, contract), abi ? path.resolve(dir, abi) : "", deployer),
+    // call calls the api with the args signed by the signers, the first signer pays for it,
+    // it returns {succeeded, message, return, gas, receipts, events}
+    call: (contract, api, args, signers) => request("call", contract, api, args || [], typeof signers === "string" ? [signers] : signers || []),
+    // advance moves the chain forward by the blocks and the seconds, and returns the number of the block
+    advance: (blocks, seconds) => request("advance", blocks || 0, seconds || 0),
+    // head returns the number and the time in seconds of the current block
+    head: () => request("head"),
+    get: (contract, key) => request("get", contract, key),
+    mapGet: (contract, key, field) => request("mapGet", contract, key, field),
+    balance: name => request("balance", name),
+    setBalance: (name, balance) => request("setBalance", name, balance)
+};
+
+const tests = [];
+global.test = function (name, fn, options) {
+    tests.push({name: name, fn: fn, options: options || {}});
+};
+
+require(file);
+for (const t of tests) {
+    if (t.name.indexOf(filter) < 0) {
+        continue;
+    }
+    request("start", t.name, t.options.forks || null);
+    let error = "";
+    try {
+        t.fn(global.kit);
+    } catch (e) {
+        error = e && e.stack ? e.stack : String(e);
+    }
+    request("end", t.name, error);
+}
+`
@@ -0,0 +1,152 @@
+package testkit
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/iost-official/go-iost/common"
+)
+
+func echoServer(t *testing.T, results *[]*TestResult) *Server {
+	return &Server{
+		Load: func(path, abiPath string) (string, string, error) {
+			return echoCode(t), echoABI, nil
+		},
+		Report: func(r *TestResult) {
+			*results = append(*results, r)
+		},
+	}
+}
+
+func TestServer(t *testing.T) {
+	forks := common.Forks
+	defer common.SetForks(forks)
+	results := make([]*TestResult, 0)
+	s := echoServer(t, &results)
+
+	serve := func(requests ...string) []*Response {
+		var out bytes.Buffer
+		if err := s.Serve(strings.NewReader(strings.Join(requests, "\n")+"\n"), &out); err != nil {
+			t.Fatal(err)
+		}
+		lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+		if len(lines) != len(requests) {
+			t.Fatalf("responses: %v", lines)
+		}
+		res := make([]*Response, len(lines))
+		for i, l := range lines {
+			res[i] = &Response{}
+			if err := json.Unmarshal([]byte(l), res[i]); err != nil {
+				t.Fatal(err)
+			}
+		}
+		return res
+	}
+	res := serve(
+		`{"method":"id","args":["alice"]}`,
+		`{"method":"start","args":["echo",{"BeaconHeight":-1}]}`,
+		`{"method":"newAccount","args":["alice",1000000000]}`,
+		`{"method":"deploy","args":["echo.wasm","","alice"]}`,
+	)
+	id, _ := res[3].Result.(string)
+	res = append(res, serve(
+		`{"method":"call","args":["`+id+`","echo",["hello"],["alice"]]}`,
+		`{"method":"advance","args":[2,3]}`,
+		`{"method":"unknown"}`,
+		`{"method":"end","args":["echo",""]}`,
+	)...)
+	if res[0].Error != errNoKit.Error() {
+		t.Fatalf("request before start: %+v", res[0])
+	}
+	if common.Forks.BeaconHeight != -1 || common.Forks.ParallelHeight != 0 {
+		t.Fatalf("forks of the kit: %+v", common.Forks)
+	}
+	if res[3].Error != "" || id == "" {
+		t.Fatalf("deploy: %+v", res[3])
+	}
+	call, _ := res[4].Result.(map[string]interface{})
+	if res[4].Error != "" || call["succeeded"] != true || toJSON(call["return"]) != `["hello"]` {
+		t.Fatalf("call: %+v", res[4])
+	}
+	if res[5].Result != float64(3) {
+		t.Fatalf("advance: %+v", res[5])
+	}
+	if res[6].Error == "" {
+		t.Fatalf("unknown method: %+v", res[6])
+	}
+	if len(results) != 1 || results[0].Name != "echo" || results[0].Err != nil || results[0].Gas <= 0 {
+		t.Fatalf("results: %+v", results)
+	}
+}
+
+const echoTest = `const assert = require("assert");
+
+test("echo", function (kit) {
+    kit.newAccount("alice", 1000000000);
+    const id = kit.deploy("./echo.wasm", "alice");
+    const r = kit.call(id, "echo", ["hello"], "alice");
+    assert.ok(r.succeeded, r.message);
+    assert.deepStrictEqual(r.return, ["hello"]);
+    kit.call(id, "set", ["v"], "alice");
+    assert.strictEqual(kit.get(id, "k"), "v");
+});
+
+test("echo fails", function (kit) {
+    kit.newAccount("alice", 1000000000);
+    const id = kit.deploy("./echo.wasm", "alice");
+    assert.deepStrictEqual(kit.call(id, "echo", ["hello"], "alice").return, ["bye"]);
+});
+
+test("forks", function (kit) {
+    assert.strictEqual(kit.head().number, 1);
+}, {forks: {BeaconHeight: 5}});
+`
+
+func TestRunJS(t *testing.T) {
+	if _, err := exec.LookPath("node"); err != nil {
+		t.Skip("node isn't installed")
+	}
+	forks := common.Forks
+	defer common.SetForks(forks)
+	dir, err := ioutil.TempDir("", "testkit")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "echo.test.js")
+	if err := ioutil.WriteFile(file, []byte(echoTest), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	results := make([]*TestResult, 0)
+	var stderr bytes.Buffer
+	if err := RunJS(file, "", echoServer(t, &results), os.Stdout, &stderr); err != nil {
+		t.Fatal(err, stderr.String())
+	}
+	if len(results) != 3 {
+		t.Fatalf("results: %+v", results)
+	}
+	if results[0].Err != nil || results[0].Gas <= 0 {
+		t.Fatalf("echo: %v", results[0].Err)
+	}
+	if results[1].Err == nil || !strings.Contains(results[1].Err.Error(), "bye") {
+		t.Fatalf("echo fails: %v", results[1].Err)
+	}
+	if results[2].Err != nil || common.Forks.BeaconHeight != 5 {
+		t.Fatalf("forks: %v %+v", results[2].Err, common.Forks)
+	}
+
+	results = results[:0]
+	if err := RunJS(file, "fails", echoServer(t, &results), os.Stdout, &stderr); err != nil {
+		t.Fatal(err, stderr.String())
+	}
+	if len(results) != 1 || results[0].Name != "echo fails" {
+		t.Fatalf("filtered results: %+v", results)
+	}
+}
@@ -181,6 +181,25 @@ func goBlockInfo(cSbx C.SandboxPtr, info **C.char, gasUsed *C.size_t) int {
 	return BlockInfoSuccess
 }
 
+//export goBlockRandom
+func goBlockRandom(cSbx C.SandboxPtr, number *C.char, random **C.char, gasUsed *C.size_t) int {
+	sbx, ok := GetSandbox(cSbx)
+	if !ok {
+		return BlockInfoUnexpectedError
+	}
+
+	n, err := strconv.ParseInt(C.GoString(number), 10, 64)
+	if err != nil {
+		return BlockInfoUnexpectedError
+	}
+
+	r, cost := sbx.host.BlockRandom(n)
+	*gasUsed = C.size_t(cost.Data)
+	*random = C.CString(r)
+
+	return BlockInfoSuccess
+}
+
 //export goTxInfo
 func goTxInfo(cSbx C.SandboxPtr, info **C.char, gasUsed *C.size_t) int {
 	sbx, ok := GetSandbox(cSbx)
@@ -270,6 +289,28 @@ func goRequireAuth(cSbx C.SandboxPtr, pubKey *C.char, ok *C.bool, gasUsed *C.siz
 	return APICallSuccess
 }
 
+//export goEmit
+func goEmit(cSbx C.SandboxPtr, name, topics, data *C.char, gasUsed *C.size_t) int {
+	sbx, ok := GetSandbox(cSbx)
+	if !ok {
+		return APICallUnexpectedError
+	}
+
+	var topicList []string
+	if err := json.Unmarshal([]byte(C.GoString(topics)), &topicList); err != nil {
+		return APICallUnexpectedError
+	}
+
+	cost, err := sbx.host.Emit(C.GoString(name), topicList, C.GoString(data))
+	*gasUsed = C.size_t(cost.Data)
+
+	if err != nil {
+		return APICallUnexpectedError
+	}
+
+	return APICallSuccess
+}
+
 //export goGrantServi
 func goGrantServi(cSbx C.SandboxPtr, pubKey *C.char, amount *C.char, gasUsed *C.size_t) int {
 	sbx, sbOk := GetSandbox(cSbx)
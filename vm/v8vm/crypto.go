@@ -0,0 +1,79 @@
+package v8
+
+/*
+#include "v8/vm.h"
+*/
+import "C"
+import (
+	"github.com/iost-official/go-iost/core/contract"
+)
+
+// hash calls f on the data and returns the hash in base58.
+func hash(cSbx C.SandboxPtr, data *C.char, gasUsed *C.size_t, f func(*Sandbox, string) (string, *contract.Cost)) *C.char {
+	sbx, ok := GetSandbox(cSbx)
+	if !ok {
+		panic("get sandbox failed.")
+	}
+
+	h, cost := f(sbx, C.GoString(data))
+	*gasUsed = C.size_t(cost.ToGas())
+
+	return C.CString(h)
+}
+
+//export goSha256
+func goSha256(cSbx C.SandboxPtr, data *C.char, gasUsed *C.size_t) *C.char {
+	return hash(cSbx, data, gasUsed, func(sbx *Sandbox, d string) (string, *contract.Cost) {
+		return sbx.host.Sha256(d)
+	})
+}
+
+//export goSha3
+func goSha3(cSbx C.SandboxPtr, data *C.char, gasUsed *C.size_t) *C.char {
+	return hash(cSbx, data, gasUsed, func(sbx *Sandbox, d string) (string, *contract.Cost) {
+		return sbx.host.Sha3(d)
+	})
+}
+
+//export goKeccak256
+func goKeccak256(cSbx C.SandboxPtr, data *C.char, gasUsed *C.size_t) *C.char {
+	return hash(cSbx, data, gasUsed, func(sbx *Sandbox, d string) (string, *contract.Cost) {
+		return sbx.host.Keccak256(d)
+	})
+}
+
+//export goRipemd160
+func goRipemd160(cSbx C.SandboxPtr, data *C.char, gasUsed *C.size_t) *C.char {
+	return hash(cSbx, data, gasUsed, func(sbx *Sandbox, d string) (string, *contract.Cost) {
+		return sbx.host.Ripemd160(d)
+	})
+}
+
+//export goVerify
+func goVerify(cSbx C.SandboxPtr, algo, message, signature, pubkey *C.char, gasUsed *C.size_t) C.int {
+	sbx, ok := GetSandbox(cSbx)
+	if !ok {
+		panic("get sandbox failed.")
+	}
+
+	valid, cost := sbx.host.VerifySignature(C.GoString(algo), C.GoString(message), C.GoString(signature), C.GoString(pubkey))
+	*gasUsed = C.size_t(cost.ToGas())
+
+	if valid {
+		return 1
+	}
+	return 0
+}
+
+//export goPubkeyToID
+func goPubkeyToID(cSbx C.SandboxPtr, pubkey *C.char, gasUsed *C.size_t) *C.char {
+	sbx, ok := GetSandbox(cSbx)
+	if !ok {
+		panic("get sandbox failed.")
+	}
+
+	id, cost := sbx.host.PubkeyToID(C.GoString(pubkey))
+	*gasUsed = C.size_t(cost.ToGas())
+
+	return C.CString(id)
+}
@@ -0,0 +1,45 @@
+package v8
+
+import (
+	"bytes"
+	"debug/elf"
+	"io/ioutil"
+	"testing"
+)
+
+// libvmExports are the functions of libvm added after it was last built, the shipped library must export them.
+var libvmExports = []string{
+	"setSandboxMetering",
+	"_ZN14IOSTBlockchain4EmitEPKcS1_S1_", // IOSTBlockchain::Emit
+	"InitGoCrypto",
+	"_ZN14IOSTBlockchain11BlockRandomEPKc", // IOSTBlockchain::BlockRandom
+}
+
+func TestLibvm_Exports(t *testing.T) {
+	b, err := ioutil.ReadFile("v8/libv8/_linux_amd64/libvm.so")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.HasPrefix(b, []byte("version https://git-lfs")) {
+		t.Skip("libvm.so isn't fetched by git lfs")
+	}
+	f, err := elf.NewFile(bytes.NewReader(b))
+	if err != nil {
+		t.Fatal(err)
+	}
+	syms, err := f.DynamicSymbols()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defined := make(map[string]bool, len(syms))
+	for _, s := range syms {
+		if s.Section != elf.SHN_UNDEF {
+			defined[s.Name] = true
+		}
+	}
+	for _, name := range libvmExports {
+		if !defined[name] {
+			t.Errorf("libvm.so doesn't export %v, rebuild it by make in vm/v8vm/v8", name)
+		}
+	}
+}
@@ -16,6 +16,8 @@ int goCall(SandboxPtr, const char *, const char *, const char *, char **, size_t
 int goCallWithReceipt(SandboxPtr, const char *, const char *, const char *, char **, size_t *);
 int goRequireAuth(SandboxPtr, const char *, bool *, size_t *);
 int goGrantServi(SandboxPtr, const char *, const char *, size_t *);
+int goEmit(SandboxPtr, const char *, const char *, const char *, size_t *);
+int goBlockRandom(SandboxPtr, const char *, char **, size_t *);
 int goPut(SandboxPtr, const char *, const char *, size_t *);
 char *goGet(SandboxPtr, const char *, size_t *);
 int goDel(SandboxPtr, const char *, size_t *);
@@ -25,6 +27,12 @@ char *goMapGet(SandboxPtr, const char *, const char *, size_t *);
 int goMapDel(SandboxPtr, const char *, const char *, size_t *);
 char *goMapKeys(SandboxPtr, const char *, size_t *);
 char *goGlobalGet(SandboxPtr, const char *, const char *, size_t *);
+char *goSha256(SandboxPtr, const char *, size_t *);
+char *goSha3(SandboxPtr, const char *, size_t *);
+char *goKeccak256(SandboxPtr, const char *, size_t *);
+char *goRipemd160(SandboxPtr, const char *, size_t *);
+int goVerify(SandboxPtr, const char *, const char *, const char *, const char *, size_t *);
+char *goPubkeyToID(SandboxPtr, const char *, size_t *);
 int goConsoleLog(SandboxPtr, const char *, const char *);
 */
 import "C"
@@ -38,6 +46,7 @@ import (
 
 	"sync"
 
+	"github.com/iost-official/go-iost/common"
 	"github.com/iost-official/go-iost/core/contract"
 	"github.com/iost-official/go-iost/vm/host"
 )
@@ -49,6 +58,8 @@ type Sandbox struct {
 	isolate C.IsolatePtr
 	context C.SandboxPtr
 	host    *host.Host
+	// metering is whether the contract stops at the same instruction on every node when it runs out of gas
+	metering bool
 }
 
 //var sbxMap = make(map[C.SandboxPtr]*Sandbox)
@@ -105,7 +116,9 @@ func (sbx *Sandbox) Init(vmType vmPoolType) {
 		(C.callFunc)(C.goCall),
 		(C.callFunc)(C.goCallWithReceipt),
 		(C.requireAuthFunc)(C.goRequireAuth),
-		(C.grantServiFunc)(C.goGrantServi))
+		(C.grantServiFunc)(C.goGrantServi),
+		(C.emitFunc)(C.goEmit),
+		(C.blockRandomFunc)(C.goBlockRandom))
 	C.InitGoStorage((C.putFunc)(C.goPut),
 		(C.getFunc)(C.goGet),
 		(C.delFunc)(C.goDel),
@@ -115,6 +128,12 @@ func (sbx *Sandbox) Init(vmType vmPoolType) {
 		(C.mapDelFunc)(C.goMapDel),
 		(C.mapKeysFunc)(C.goMapKeys),
 		(C.globalGetFunc)(C.goGlobalGet))
+	C.InitGoCrypto((C.hashFunc)(C.goSha256),
+		(C.hashFunc)(C.goSha3),
+		(C.hashFunc)(C.goKeccak256),
+		(C.hashFunc)(C.goRipemd160),
+		(C.verifyFunc)(C.goVerify),
+		(C.pubkeyToIDFunc)(C.goPubkeyToID))
 	C.loadVM(sbx.context, C.int(vmType))
 }
 
@@ -123,10 +142,22 @@ func (sbx *Sandbox) SetGasLimit(limit int64) {
 	C.setSandboxGasLimit(sbx.context, C.size_t(limit))
 }
 
+// SetMetering set whether the execution is stopped as soon as it runs out of gas
+func (sbx *Sandbox) SetMetering(metering bool) {
+	sbx.metering = metering
+	if metering {
+		C.setSandboxMetering(sbx.context, 1)
+	} else {
+		C.setSandboxMetering(sbx.context, 0)
+	}
+}
+
 // SetHost set host in sandbox and set gas limit
 func (sbx *Sandbox) SetHost(host *host.Host) {
 	sbx.host = host
 	sbx.SetGasLimit(host.GasLimit())
+	number, _ := host.Context().Value("number").(int64)
+	sbx.SetMetering(common.Forks.IsMetering(number))
 }
 
 // SetJSPath set js path and ReloadVM
@@ -202,9 +233,12 @@ func (sbx *Sandbox) Execute(preparedCode string) (string, int64, error) {
 		err = errors.New(C.GoString(rs.Err))
 	}
 
-	gasUsed := rs.gasUsed
+	gasUsed := int64(rs.gasUsed)
+	if sbx.metering && gasUsed > sbx.host.GasLimit() {
+		err = errors.New("out of gas")
+	}
 
-	return result, int64(gasUsed), err
+	return result, gasUsed, err
 }
 
 func formatFuncArgs(args []interface{}) (string, error) {
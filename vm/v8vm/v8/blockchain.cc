@@ -12,11 +12,14 @@ static callFunc CCall = nullptr;
 static callFunc CCallWR = nullptr;
 static requireAuthFunc CRequireAuth = nullptr;
 static grantServiFunc CGrantServi = nullptr;
+static emitFunc CEmit = nullptr;
+static blockRandomFunc CBlockRandom = nullptr;
 
 void InitGoBlockchain(transferFunc transfer, withdrawFunc withdraw,
                         depositFunc deposit, topUpFunc topUp, countermandFunc countermand,
                         blockInfoFunc blkInfo, txInfoFunc txInfo, callFunc call, callFunc callWR,
-                        requireAuthFunc requireAuth, grantServiFunc grantServi) {
+                        requireAuthFunc requireAuth, grantServiFunc grantServi, emitFunc emit,
+                        blockRandomFunc blockRandom) {
     CTransfer = transfer;
     CWithdraw = withdraw;
     CDeposit = deposit;
@@ -28,6 +31,8 @@ void InitGoBlockchain(transferFunc transfer, withdrawFunc withdraw,
     CCallWR = callWR;
     CRequireAuth = requireAuth;
     CGrantServi = grantServi;
+    CEmit = emit;
+    CBlockRandom = blockRandom;
 }
 
 int IOSTBlockchain::Transfer(const char *from, const char *to, const char *amount) {
@@ -97,6 +102,16 @@ char *IOSTBlockchain::TxInfo() {
     return info;
 }
 
+char *IOSTBlockchain::BlockRandom(const char *number) {
+    size_t gasUsed = 0;
+    char *random = nullptr;
+    int ret = CBlockRandom(sbxPtr, number, &random, &gasUsed);
+
+    Sandbox *sbx = static_cast<Sandbox*>(sbxPtr);
+    sbx->gasUsed += gasUsed;
+    return random;
+}
+
 char *IOSTBlockchain::Call(const char *contract, const char *api, const char *args) {
     size_t gasUsed = 0;
     char *result = nullptr;
@@ -136,6 +151,15 @@ int IOSTBlockchain::GrantServi(const char *pubKey, const char *amount) {
     return ret;
 }
 
+int IOSTBlockchain::Emit(const char *name, const char *topics, const char *data) {
+    size_t gasUsed = 0;
+    int ret = CEmit(sbxPtr, name, topics, data, &gasUsed);
+
+    Sandbox *sbx = static_cast<Sandbox*>(sbxPtr);
+    sbx->gasUsed += gasUsed;
+    return ret;
+}
+
 void NewIOSTBlockchain(const FunctionCallbackInfo<Value> &args) {
     Isolate *isolate = args.GetIsolate();
     Local<Context> context = isolate->GetCurrentContext();
@@ -418,6 +442,44 @@ void IOSTBlockchain_blockInfo(const FunctionCallbackInfo<Value> &args) {
     }
 }
 
+void IOSTBlockchain_blockRandom(const FunctionCallbackInfo<Value> &args) {
+    Isolate *isolate = args.GetIsolate();
+    Local<Object> self = args.Holder();
+
+    if (args.Length() != 1) {
+        Local<Value> err = Exception::Error(
+            String::NewFromUtf8(isolate, "IOSTBlockchain_blockRandom invalid argument length")
+        );
+        isolate->ThrowException(err);
+        return;
+    }
+
+    if (!args[0]->IsString()) {
+        Local<Value> err = Exception::Error(
+            String::NewFromUtf8(isolate, "IOSTBlockchain_blockRandom number must be string")
+        );
+        isolate->ThrowException(err);
+        return;
+    }
+
+    String::Utf8Value numberStr(args[0]);
+
+    Local<External> extVal = Local<External>::Cast(self->GetInternalField(0));
+    if (!extVal->IsExternal()) {
+        std::cout << "IOSTBlockchain_blockRandom val error" << std::endl;
+        return;
+    }
+
+    IOSTBlockchain *bc = static_cast<IOSTBlockchain *>(extVal->Value());
+    char *random = bc->BlockRandom(*numberStr);
+    if (random == nullptr) {
+        args.GetReturnValue().SetNull();
+    } else {
+        args.GetReturnValue().Set(String::NewFromUtf8(isolate, random));
+        free(random);
+    }
+}
+
 void IOSTBlockchain_txInfo(const FunctionCallbackInfo<Value> &args) {
     Isolate *isolate = args.GetIsolate();
     Local<Object> self = args.Holder();
@@ -634,6 +696,44 @@ void IOSTBlockchain_grantServi(const FunctionCallbackInfo<Value> &args) {
     args.GetReturnValue().Set(ret);
 }
 
+void IOSTBlockchain_emit(const FunctionCallbackInfo<Value> &args) {
+    Isolate *isolate = args.GetIsolate();
+    Local<Object> self = args.Holder();
+
+    if (args.Length() != 3) {
+        Local<Value> err = Exception::Error(
+            String::NewFromUtf8(isolate, "IOSTBlockchain_emit invalid argument length")
+        );
+        isolate->ThrowException(err);
+        return;
+    }
+
+    for (int i = 0; i < 3; i++) {
+        if (!args[i]->IsString()) {
+            Local<Value> err = Exception::Error(
+                String::NewFromUtf8(isolate, "IOSTBlockchain_emit name, topics and data must be string")
+            );
+            isolate->ThrowException(err);
+            return;
+        }
+    }
+
+    String::Utf8Value nameStr(args[0]);
+    String::Utf8Value topicsStr(args[1]);
+    String::Utf8Value dataStr(args[2]);
+
+    Local<External> extVal = Local<External>::Cast(self->GetInternalField(0));
+    if (!extVal->IsExternal()) {
+        std::cout << "IOSTBlockchain_emit val error" << std::endl;
+        return;
+    }
+
+    IOSTBlockchain *bc = static_cast<IOSTBlockchain *>(extVal->Value());
+    int ret = bc->Emit(*nameStr, *topicsStr, *dataStr);
+
+    args.GetReturnValue().Set(ret);
+}
+
 void InitBlockchain(Isolate *isolate, Local<ObjectTemplate> globalTpl) {
     Local<FunctionTemplate> blockchainClass =
         FunctionTemplate::New(isolate, NewIOSTBlockchain);
@@ -666,6 +766,10 @@ void InitBlockchain(Isolate *isolate, Local<ObjectTemplate> globalTpl) {
         String::NewFromUtf8(isolate, "blockInfo"),
         FunctionTemplate::New(isolate, IOSTBlockchain_blockInfo)
     );
+    blockchainTpl->Set(
+        String::NewFromUtf8(isolate, "blockRandom"),
+        FunctionTemplate::New(isolate, IOSTBlockchain_blockRandom)
+    );
     blockchainTpl->Set(
         String::NewFromUtf8(isolate, "txInfo"),
         FunctionTemplate::New(isolate, IOSTBlockchain_txInfo)
@@ -686,6 +790,10 @@ void InitBlockchain(Isolate *isolate, Local<ObjectTemplate> globalTpl) {
         String::NewFromUtf8(isolate, "grantServi"),
         FunctionTemplate::New(isolate, IOSTBlockchain_grantServi)
     );
+    blockchainTpl->Set(
+        String::NewFromUtf8(isolate, "emit"),
+        FunctionTemplate::New(isolate, IOSTBlockchain_emit)
+    );
 
 
     globalTpl->Set(blockchainClassName, blockchainClass);
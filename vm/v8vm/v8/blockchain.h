@@ -23,10 +23,12 @@ public:
     int Countermand(const char *, const char *, const char *);
     char *BlockInfo();
     char *TxInfo();
+    char *BlockRandom(const char *);
     char *Call(const char *, const char *, const char *);
     char *CallWithReceipt(const char *, const char *, const char *);
     bool RequireAuth(const char *pubKey);
     int GrantServi(const char *, const char *);
+    int Emit(const char *, const char *, const char *);
 };
 
 #endif // IOST_V8_BLOCKCHAIN_H
\ No newline at end of file
@@ -0,0 +1,208 @@
+#include "crypto.h"
+#include <iostream>
+
+static hashFunc CSha256 = nullptr;
+static hashFunc CSha3 = nullptr;
+static hashFunc CKeccak256 = nullptr;
+static hashFunc CRipemd160 = nullptr;
+static verifyFunc CVerify = nullptr;
+static pubkeyToIDFunc CPubkeyToID = nullptr;
+
+void InitGoCrypto(hashFunc sha256, hashFunc sha3, hashFunc keccak256, hashFunc ripemd160,
+    verifyFunc verify, pubkeyToIDFunc pubkeyToID) {
+    CSha256 = sha256;
+    CSha3 = sha3;
+    CKeccak256 = keccak256;
+    CRipemd160 = ripemd160;
+    CVerify = verify;
+    CPubkeyToID = pubkeyToID;
+}
+
+char *IOSTCrypto::Hash(hashFunc f, const char *data) {
+    size_t gasUsed = 0;
+    char *ret = f(sbxPtr, data, &gasUsed);
+    Sandbox *sbx = static_cast<Sandbox*>(sbxPtr);
+    sbx->gasUsed += gasUsed;
+    return ret;
+}
+
+char *IOSTCrypto::Sha256(const char *data) {
+    return Hash(CSha256, data);
+}
+
+char *IOSTCrypto::Sha3(const char *data) {
+    return Hash(CSha3, data);
+}
+
+char *IOSTCrypto::Keccak256(const char *data) {
+    return Hash(CKeccak256, data);
+}
+
+char *IOSTCrypto::Ripemd160(const char *data) {
+    return Hash(CRipemd160, data);
+}
+
+int IOSTCrypto::Verify(const char *algo, const char *message, const char *signature, const char *pubkey) {
+    size_t gasUsed = 0;
+    int ret = CVerify(sbxPtr, algo, message, signature, pubkey, &gasUsed);
+    Sandbox *sbx = static_cast<Sandbox*>(sbxPtr);
+    sbx->gasUsed += gasUsed;
+    return ret;
+}
+
+char *IOSTCrypto::PubkeyToID(const char *pubkey) {
+    size_t gasUsed = 0;
+    char *ret = CPubkeyToID(sbxPtr, pubkey, &gasUsed);
+    Sandbox *sbx = static_cast<Sandbox*>(sbxPtr);
+    sbx->gasUsed += gasUsed;
+    return ret;
+}
+
+void NewIOSTCrypto(const FunctionCallbackInfo<Value> &args) {
+    Isolate *isolate = args.GetIsolate();
+    Local<Context> context = isolate->GetCurrentContext();
+    Local<Object> global = context->Global();
+
+    Local<Value> val = global->GetInternalField(0);
+    if (!val->IsExternal()) {
+        std::cout << "NewIOSTCrypto val error" << std::endl;
+        return;
+    }
+    SandboxPtr sbx = static_cast<SandboxPtr>(Local<External>::Cast(val)->Value());
+
+    IOSTCrypto *ic = new IOSTCrypto(sbx);
+
+    Local<Object> self = args.Holder();
+    self->SetInternalField(0, External::New(isolate, ic));
+
+    args.GetReturnValue().Set(self);
+}
+
+// getCrypto checks the arguments are count strings, and returns the IOSTCrypto of the holder.
+static IOSTCrypto *getCrypto(const FunctionCallbackInfo<Value> &args, int count, const char *name) {
+    Isolate *isolate = args.GetIsolate();
+    Local<Object> self = args.Holder();
+
+    if (args.Length() != count) {
+        std::string msg = std::string(name) + " invalid argument length.";
+        isolate->ThrowException(Exception::Error(String::NewFromUtf8(isolate, msg.c_str())));
+        return nullptr;
+    }
+    for (int i = 0; i < count; i++) {
+        if (!args[i]->IsString()) {
+            std::string msg = std::string(name) + " arguments must be string.";
+            isolate->ThrowException(Exception::Error(String::NewFromUtf8(isolate, msg.c_str())));
+            return nullptr;
+        }
+    }
+
+    Local<External> extVal = Local<External>::Cast(self->GetInternalField(0));
+    if (!extVal->IsExternal()) {
+        std::cout << name << " val error" << std::endl;
+        return nullptr;
+    }
+    return static_cast<IOSTCrypto *>(extVal->Value());
+}
+
+static void returnString(const FunctionCallbackInfo<Value> &args, char *ret) {
+    if (ret == nullptr) {
+        args.GetReturnValue().SetNull();
+    } else {
+        args.GetReturnValue().Set(String::NewFromUtf8(args.GetIsolate(), ret));
+        free(ret);
+    }
+}
+
+void IOSTCrypto_sha256(const FunctionCallbackInfo<Value> &args) {
+    IOSTCrypto *ic = getCrypto(args, 1, "IOSTCrypto_sha256");
+    if (ic == nullptr) {
+        return;
+    }
+    String::Utf8Value data(args[0]);
+    returnString(args, ic->Sha256(*data));
+}
+
+void IOSTCrypto_sha3(const FunctionCallbackInfo<Value> &args) {
+    IOSTCrypto *ic = getCrypto(args, 1, "IOSTCrypto_sha3");
+    if (ic == nullptr) {
+        return;
+    }
+    String::Utf8Value data(args[0]);
+    returnString(args, ic->Sha3(*data));
+}
+
+void IOSTCrypto_keccak256(const FunctionCallbackInfo<Value> &args) {
+    IOSTCrypto *ic = getCrypto(args, 1, "IOSTCrypto_keccak256");
+    if (ic == nullptr) {
+        return;
+    }
+    String::Utf8Value data(args[0]);
+    returnString(args, ic->Keccak256(*data));
+}
+
+void IOSTCrypto_ripemd160(const FunctionCallbackInfo<Value> &args) {
+    IOSTCrypto *ic = getCrypto(args, 1, "IOSTCrypto_ripemd160");
+    if (ic == nullptr) {
+        return;
+    }
+    String::Utf8Value data(args[0]);
+    returnString(args, ic->Ripemd160(*data));
+}
+
+void IOSTCrypto_verify(const FunctionCallbackInfo<Value> &args) {
+    IOSTCrypto *ic = getCrypto(args, 4, "IOSTCrypto_verify");
+    if (ic == nullptr) {
+        return;
+    }
+    String::Utf8Value algo(args[0]);
+    String::Utf8Value message(args[1]);
+    String::Utf8Value signature(args[2]);
+    String::Utf8Value pubkey(args[3]);
+    int ret = ic->Verify(*algo, *message, *signature, *pubkey);
+    args.GetReturnValue().Set(ret);
+}
+
+void IOSTCrypto_pubkeyToID(const FunctionCallbackInfo<Value> &args) {
+    IOSTCrypto *ic = getCrypto(args, 1, "IOSTCrypto_pubkeyToID");
+    if (ic == nullptr) {
+        return;
+    }
+    String::Utf8Value pubkey(args[0]);
+    returnString(args, ic->PubkeyToID(*pubkey));
+}
+
+void InitCrypto(Isolate *isolate, Local<ObjectTemplate> globalTpl) {
+    Local<FunctionTemplate> cryptoClass =
+        FunctionTemplate::New(isolate, NewIOSTCrypto);
+    Local<String> cryptoClassName = String::NewFromUtf8(isolate, "IOSTCrypto");
+    cryptoClass->SetClassName(cryptoClassName);
+
+    Local<ObjectTemplate> cryptoTpl = cryptoClass->InstanceTemplate();
+    cryptoTpl->SetInternalFieldCount(1);
+    cryptoTpl->Set(
+        String::NewFromUtf8(isolate, "sha256"),
+        FunctionTemplate::New(isolate, IOSTCrypto_sha256)
+    );
+    cryptoTpl->Set(
+        String::NewFromUtf8(isolate, "sha3"),
+        FunctionTemplate::New(isolate, IOSTCrypto_sha3)
+    );
+    cryptoTpl->Set(
+        String::NewFromUtf8(isolate, "keccak256"),
+        FunctionTemplate::New(isolate, IOSTCrypto_keccak256)
+    );
+    cryptoTpl->Set(
+        String::NewFromUtf8(isolate, "ripemd160"),
+        FunctionTemplate::New(isolate, IOSTCrypto_ripemd160)
+    );
+    cryptoTpl->Set(
+        String::NewFromUtf8(isolate, "verify"),
+        FunctionTemplate::New(isolate, IOSTCrypto_verify)
+    );
+    cryptoTpl->Set(
+        String::NewFromUtf8(isolate, "pubkeyToID"),
+        FunctionTemplate::New(isolate, IOSTCrypto_pubkeyToID)
+    );
+
+    globalTpl->Set(cryptoClassName, cryptoClass);
+}
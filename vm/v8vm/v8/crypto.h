@@ -0,0 +1,27 @@
+#ifndef IOST_V8_CRYPTO_H
+#define IOST_V8_CRYPTO_H
+
+#include "sandbox.h"
+#include "stddef.h"
+
+using namespace v8;
+
+void InitCrypto(Isolate *isolate, Local<ObjectTemplate> globalTpl);
+void NewIOSTCrypto(const FunctionCallbackInfo<Value> &info);
+
+class IOSTCrypto {
+private:
+    SandboxPtr sbxPtr;
+    char *Hash(hashFunc f, const char *data);
+public:
+    IOSTCrypto(SandboxPtr ptr): sbxPtr(ptr) {}
+
+    char *Sha256(const char *data);
+    char *Sha3(const char *data);
+    char *Keccak256(const char *data);
+    char *Ripemd160(const char *data);
+    int Verify(const char *algo, const char *message, const char *signature, const char *pubkey);
+    char *PubkeyToID(const char *pubkey);
+};
+
+#endif // IOST_V8_CRYPTO_H
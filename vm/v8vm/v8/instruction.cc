@@ -60,6 +60,12 @@ void IOSTContractInstruction_Incr(const FunctionCallbackInfo<Value> &args) {
     IOSTContractInstruction *ici = static_cast<IOSTContractInstruction *>(extVal->Value());
     size_t ret = ici->Incr(valInt);
 
+    // stop at the same instruction on every node, the termination can't be caught by the contract
+    if (ici->OutOfGas()) {
+        isolate->TerminateExecution();
+        return;
+    }
+
     args.GetReturnValue().Set(Number::New(isolate, (double)ret));
 }
 
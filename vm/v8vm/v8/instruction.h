@@ -24,6 +24,10 @@ public:
         Sandbox *sbx = static_cast<Sandbox*>(sbxPtr);
         return sbx->gasUsed;
     }
+    bool OutOfGas() {
+        Sandbox *sbx = static_cast<Sandbox*>(sbxPtr);
+        return sbx->metering && sbx->gasUsed > sbx->gasLimit;
+    }
 };
 
 #endif // IOST_V8_INSTRUCTION_H
\ No newline at end of file
@@ -40,6 +40,15 @@ let BlockChain = (function () {
         blockInfo: function () {
             return bc.blockInfo();
         },
+        // get the random seed of the block, the witness of the block can't choose it
+        random: function () {
+            return JSON.parse(bc.blockInfo()).random;
+        },
+        // get the random seed of the past block at number, a bet committed in a block is resolved by the seed
+        // of a later block, which isn't known when the bet is committed
+        blockRandom: function (number) {
+            return bc.blockRandom(number.toString());
+        },
         // get transactionInfo
         txInfo: function () {
             return bc.txInfo();
@@ -56,6 +65,16 @@ let BlockChain = (function () {
         requireAuth: function (pubKey) {
             return bc.requireAuth(pubKey);
         },
+        // emit an event with the indexed topics, the topics are strings for filtering the events
+        emit: function (name, topics, data) {
+            if (typeof data !== "string") {
+                data = JSON.stringify(data);
+            }
+            let ret = bc.emit(name, JSON.stringify(topics || []), data === undefined ? "" : data);
+            if (ret !== 0) {
+                throw new Error("emit event " + name + " failed");
+            }
+        },
         // not supportted
         grantServi: function (pubKey, amount) {
             return bc.grantServi(pubKey, amount.toString());
@@ -0,0 +1,32 @@
+let Crypto = (function () {
+    let c = new IOSTCrypto;
+    return {
+        // sha256 of the string, returns base58
+        sha256: function (data) {
+            return c.sha256(data);
+        },
+        // sha3-256 of the string, returns base58
+        sha3: function (data) {
+            return c.sha3(data);
+        },
+        // legacy keccak-256 of the string, returns base58
+        keccak256: function (data) {
+            return c.keccak256(data);
+        },
+        // ripemd160 of the string, returns base58
+        ripemd160: function (data) {
+            return c.ripemd160(data);
+        },
+        // verify the signature by algo, "ed25519" or "secp256k1", message, signature and pubkey are in base58
+        // verify(algo, message, signature, pubkey)
+        verify: function (algo, message, signature, pubkey) {
+            return c.verify(algo, message, signature, pubkey) === 1;
+        },
+        // account id of the pubkey in base58
+        pubkeyToID: function (pubkey) {
+            return c.pubkeyToID(pubkey);
+        }
+    }
+})();
+
+module.exports = Crypto;
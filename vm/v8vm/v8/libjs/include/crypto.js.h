@@ -0,0 +1,94 @@
+unsigned char __libjs_crypto_js[] = {
+  0x6c, 0x65, 0x74, 0x20, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x20, 0x3d,
+  0x20, 0x28, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x28,
+  0x29, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x6c, 0x65, 0x74, 0x20,
+  0x63, 0x20, 0x3d, 0x20, 0x6e, 0x65, 0x77, 0x20, 0x49, 0x4f, 0x53, 0x54,
+  0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x3b, 0x0a, 0x20, 0x20, 0x20, 0x20,
+  0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20,
+  0x20, 0x20, 0x20, 0x20, 0x20, 0x2f, 0x2f, 0x20, 0x73, 0x68, 0x61, 0x32,
+  0x35, 0x36, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x73, 0x74,
+  0x72, 0x69, 0x6e, 0x67, 0x2c, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e,
+  0x73, 0x20, 0x62, 0x61, 0x73, 0x65, 0x35, 0x38, 0x0a, 0x20, 0x20, 0x20,
+  0x20, 0x20, 0x20, 0x20, 0x20, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x3a,
+  0x20, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x28, 0x64,
+  0x61, 0x74, 0x61, 0x29, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+  0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72,
+  0x6e, 0x20, 0x63, 0x2e, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x28, 0x64,
+  0x61, 0x74, 0x61, 0x29, 0x3b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+  0x20, 0x20, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+  0x20, 0x2f, 0x2f, 0x20, 0x73, 0x68, 0x61, 0x33, 0x2d, 0x32, 0x35, 0x36,
+  0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x73, 0x74, 0x72, 0x69,
+  0x6e, 0x67, 0x2c, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x20,
+  0x62, 0x61, 0x73, 0x65, 0x35, 0x38, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+  0x20, 0x20, 0x20, 0x73, 0x68, 0x61, 0x33, 0x3a, 0x20, 0x66, 0x75, 0x6e,
+  0x63, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x28, 0x64, 0x61, 0x74, 0x61, 0x29,
+  0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+  0x20, 0x20, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x63, 0x2e,
+  0x73, 0x68, 0x61, 0x33, 0x28, 0x64, 0x61, 0x74, 0x61, 0x29, 0x3b, 0x0a,
+  0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c, 0x0a, 0x20,
+  0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x2f, 0x2f, 0x20, 0x6c, 0x65,
+  0x67, 0x61, 0x63, 0x79, 0x20, 0x6b, 0x65, 0x63, 0x63, 0x61, 0x6b, 0x2d,
+  0x32, 0x35, 0x36, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x73,
+  0x74, 0x72, 0x69, 0x6e, 0x67, 0x2c, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72,
+  0x6e, 0x73, 0x20, 0x62, 0x61, 0x73, 0x65, 0x35, 0x38, 0x0a, 0x20, 0x20,
+  0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x6b, 0x65, 0x63, 0x63, 0x61, 0x6b,
+  0x32, 0x35, 0x36, 0x3a, 0x20, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f,
+  0x6e, 0x20, 0x28, 0x64, 0x61, 0x74, 0x61, 0x29, 0x20, 0x7b, 0x0a, 0x20,
+  0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x72,
+  0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x63, 0x2e, 0x6b, 0x65, 0x63, 0x63,
+  0x61, 0x6b, 0x32, 0x35, 0x36, 0x28, 0x64, 0x61, 0x74, 0x61, 0x29, 0x3b,
+  0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c, 0x0a,
+  0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x2f, 0x2f, 0x20, 0x72,
+  0x69, 0x70, 0x65, 0x6d, 0x64, 0x31, 0x36, 0x30, 0x20, 0x6f, 0x66, 0x20,
+  0x74, 0x68, 0x65, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x2c, 0x20,
+  0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x20, 0x62, 0x61, 0x73, 0x65,
+  0x35, 0x38, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x72,
+  0x69, 0x70, 0x65, 0x6d, 0x64, 0x31, 0x36, 0x30, 0x3a, 0x20, 0x66, 0x75,
+  0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x28, 0x64, 0x61, 0x74, 0x61,
+  0x29, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+  0x20, 0x20, 0x20, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x63,
+  0x2e, 0x72, 0x69, 0x70, 0x65, 0x6d, 0x64, 0x31, 0x36, 0x30, 0x28, 0x64,
+  0x61, 0x74, 0x61, 0x29, 0x3b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+  0x20, 0x20, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+  0x20, 0x2f, 0x2f, 0x20, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x20, 0x74,
+  0x68, 0x65, 0x20, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+  0x20, 0x62, 0x79, 0x20, 0x61, 0x6c, 0x67, 0x6f, 0x2c, 0x20, 0x22, 0x65,
+  0x64, 0x32, 0x35, 0x35, 0x31, 0x39, 0x22, 0x20, 0x6f, 0x72, 0x20, 0x22,
+  0x73, 0x65, 0x63, 0x70, 0x32, 0x35, 0x36, 0x6b, 0x31, 0x22, 0x2c, 0x20,
+  0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2c, 0x20, 0x73, 0x69, 0x67,
+  0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x70,
+  0x75, 0x62, 0x6b, 0x65, 0x79, 0x20, 0x61, 0x72, 0x65, 0x20, 0x69, 0x6e,
+  0x20, 0x62, 0x61, 0x73, 0x65, 0x35, 0x38, 0x0a, 0x20, 0x20, 0x20, 0x20,
+  0x20, 0x20, 0x20, 0x20, 0x2f, 0x2f, 0x20, 0x76, 0x65, 0x72, 0x69, 0x66,
+  0x79, 0x28, 0x61, 0x6c, 0x67, 0x6f, 0x2c, 0x20, 0x6d, 0x65, 0x73, 0x73,
+  0x61, 0x67, 0x65, 0x2c, 0x20, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+  0x72, 0x65, 0x2c, 0x20, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x29, 0x0a,
+  0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x76, 0x65, 0x72, 0x69,
+  0x66, 0x79, 0x3a, 0x20, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+  0x20, 0x28, 0x61, 0x6c, 0x67, 0x6f, 0x2c, 0x20, 0x6d, 0x65, 0x73, 0x73,
+  0x61, 0x67, 0x65, 0x2c, 0x20, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+  0x72, 0x65, 0x2c, 0x20, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x29, 0x20,
+  0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+  0x20, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x63, 0x2e, 0x76,
+  0x65, 0x72, 0x69, 0x66, 0x79, 0x28, 0x61, 0x6c, 0x67, 0x6f, 0x2c, 0x20,
+  0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2c, 0x20, 0x73, 0x69, 0x67,
+  0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x2c, 0x20, 0x70, 0x75, 0x62, 0x6b,
+  0x65, 0x79, 0x29, 0x20, 0x3d, 0x3d, 0x3d, 0x20, 0x31, 0x3b, 0x0a, 0x20,
+  0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c, 0x0a, 0x20, 0x20,
+  0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x2f, 0x2f, 0x20, 0x61, 0x63, 0x63,
+  0x6f, 0x75, 0x6e, 0x74, 0x20, 0x69, 0x64, 0x20, 0x6f, 0x66, 0x20, 0x74,
+  0x68, 0x65, 0x20, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x20, 0x69, 0x6e,
+  0x20, 0x62, 0x61, 0x73, 0x65, 0x35, 0x38, 0x0a, 0x20, 0x20, 0x20, 0x20,
+  0x20, 0x20, 0x20, 0x20, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x54, 0x6f,
+  0x49, 0x44, 0x3a, 0x20, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+  0x20, 0x28, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x29, 0x20, 0x7b, 0x0a,
+  0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+  0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x63, 0x2e, 0x70, 0x75, 0x62,
+  0x6b, 0x65, 0x79, 0x54, 0x6f, 0x49, 0x44, 0x28, 0x70, 0x75, 0x62, 0x6b,
+  0x65, 0x79, 0x29, 0x3b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+  0x20, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x0a, 0x7d, 0x29, 0x28,
+  0x29, 0x3b, 0x0a, 0x0a, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x65,
+  0x78, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x20, 0x3d, 0x20, 0x43, 0x72, 0x79,
+  0x70, 0x74, 0x6f, 0x3b, 0x0a, 0x00
+};
+unsigned int __libjs_crypto_js_len = 1085;
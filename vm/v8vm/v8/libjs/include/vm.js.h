@@ -116,27 +116,31 @@ unsigned char __libjs_vm_js[] = {
   0x61, 0x72, 0x20, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x68, 0x61, 0x69,
   0x6e, 0x20, 0x3d, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x28,
   0x27, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x27,
-  0x29, 0x3b, 0x0a, 0x0a, 0x2f, 0x2f, 0x20, 0x6f, 0x74, 0x68, 0x65, 0x72,
-  0x20, 0x68, 0x65, 0x6c, 0x70, 0x65, 0x72, 0x20, 0x66, 0x75, 0x6e, 0x63,
-  0x69, 0x74, 0x6f, 0x6e, 0x73, 0x0a, 0x2f, 0x2f, 0x20, 0x76, 0x61, 0x72,
-  0x20, 0x42, 0x69, 0x67, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x20, 0x3d,
-  0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x28, 0x27, 0x62, 0x69,
-  0x67, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x27, 0x29, 0x3b, 0x0a, 0x2f,
-  0x2f, 0x20, 0x76, 0x61, 0x72, 0x20, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x20,
-  0x3d, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x28, 0x27, 0x69,
-  0x6e, 0x74, 0x36, 0x34, 0x27, 0x29, 0x3b, 0x0a, 0x0a, 0x2f, 0x2f, 0x20,
-  0x76, 0x61, 0x72, 0x20, 0x69, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x47, 0x61,
-  0x73, 0x20, 0x3d, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x28,
-  0x27, 0x69, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x67, 0x61, 0x73, 0x27,
-  0x29, 0x3b, 0x0a, 0x0a, 0x76, 0x61, 0x72, 0x20, 0x5f, 0x49, 0x4f, 0x53,
-  0x54, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-  0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x20, 0x3d, 0x20, 0x6e,
-  0x65, 0x77, 0x20, 0x49, 0x4f, 0x53, 0x54, 0x49, 0x6e, 0x73, 0x74, 0x72,
-  0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x3b, 0x0a, 0x0a, 0x2f, 0x2f, 0x20,
-  0x76, 0x61, 0x72, 0x20, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x65, 0x20,
-  0x3d, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x28, 0x27, 0x63,
-  0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x65, 0x27, 0x29, 0x3b, 0x0a, 0x76, 0x61,
-  0x72, 0x20, 0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x65, 0x20, 0x3d, 0x20,
-  0x6e, 0x65, 0x77, 0x20, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x65, 0x3b, 0x00
+  0x29, 0x3b, 0x0a, 0x0a, 0x2f, 0x2f, 0x20, 0x63, 0x72, 0x79, 0x70, 0x74,
+  0x6f, 0x0a, 0x76, 0x61, 0x72, 0x20, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f,
+  0x20, 0x3d, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x28, 0x27,
+  0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x27, 0x29, 0x3b, 0x0a, 0x0a, 0x2f,
+  0x2f, 0x20, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x20, 0x68, 0x65, 0x6c, 0x70,
+  0x65, 0x72, 0x20, 0x66, 0x75, 0x6e, 0x63, 0x69, 0x74, 0x6f, 0x6e, 0x73,
+  0x0a, 0x2f, 0x2f, 0x20, 0x76, 0x61, 0x72, 0x20, 0x42, 0x69, 0x67, 0x4e,
+  0x75, 0x6d, 0x62, 0x65, 0x72, 0x20, 0x3d, 0x20, 0x72, 0x65, 0x71, 0x75,
+  0x69, 0x72, 0x65, 0x28, 0x27, 0x62, 0x69, 0x67, 0x6e, 0x75, 0x6d, 0x62,
+  0x65, 0x72, 0x27, 0x29, 0x3b, 0x0a, 0x2f, 0x2f, 0x20, 0x76, 0x61, 0x72,
+  0x20, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x20, 0x3d, 0x20, 0x72, 0x65, 0x71,
+  0x75, 0x69, 0x72, 0x65, 0x28, 0x27, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x27,
+  0x29, 0x3b, 0x0a, 0x0a, 0x2f, 0x2f, 0x20, 0x76, 0x61, 0x72, 0x20, 0x69,
+  0x6e, 0x6a, 0x65, 0x63, 0x74, 0x47, 0x61, 0x73, 0x20, 0x3d, 0x20, 0x72,
+  0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x28, 0x27, 0x69, 0x6e, 0x6a, 0x65,
+  0x63, 0x74, 0x5f, 0x67, 0x61, 0x73, 0x27, 0x29, 0x3b, 0x0a, 0x0a, 0x76,
+  0x61, 0x72, 0x20, 0x5f, 0x49, 0x4f, 0x53, 0x54, 0x49, 0x6e, 0x73, 0x74,
+  0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
+  0x74, 0x65, 0x72, 0x20, 0x3d, 0x20, 0x6e, 0x65, 0x77, 0x20, 0x49, 0x4f,
+  0x53, 0x54, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f,
+  0x6e, 0x3b, 0x0a, 0x0a, 0x2f, 0x2f, 0x20, 0x76, 0x61, 0x72, 0x20, 0x43,
+  0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x65, 0x20, 0x3d, 0x20, 0x72, 0x65, 0x71,
+  0x75, 0x69, 0x72, 0x65, 0x28, 0x27, 0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x6c,
+  0x65, 0x27, 0x29, 0x3b, 0x0a, 0x76, 0x61, 0x72, 0x20, 0x63, 0x6f, 0x6e,
+  0x73, 0x6f, 0x6c, 0x65, 0x20, 0x3d, 0x20, 0x6e, 0x65, 0x77, 0x20, 0x43,
+  0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x65, 0x3b, 0x00
 };
-unsigned int __libjs_vm_js_len = 1668;
+unsigned int __libjs_vm_js_len = 1711;
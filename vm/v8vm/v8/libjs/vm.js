@@ -65,6 +65,9 @@ var storage = require('storage');
 // blockchain
 var BlockChain = require('blockchain');
 
+// crypto
+var crypto = require('crypto');
+
 // other helper funcitons
 // var BigNumber = require('bignumber');
 // var Int64 = require('int64');
@@ -4,6 +4,7 @@
 //#include "inject_gas.js.h"
 #include "storage.js.h"
 #include "blockchain.js.h"
+#include "crypto.js.h"
 
 #include <stdlib.h>
 #include <fstream>
@@ -15,7 +16,8 @@ std::unordered_map<std::string, const char *> libJS = {
 //    {"esprima", reinterpret_cast<char *>(__libjs_esprima_js)},
 //    {"inject_gas", reinterpret_cast<char *>(__libjs_inject_gas_js)},
     {"storage", reinterpret_cast<char *>(__libjs_storage_js)},
-    {"blockchain", reinterpret_cast<char *>(__libjs_blockchain_js)}
+    {"blockchain", reinterpret_cast<char *>(__libjs_blockchain_js)},
+    {"crypto", reinterpret_cast<char *>(__libjs_crypto_js)}
 };
 
 static char injectGasFormat[] =
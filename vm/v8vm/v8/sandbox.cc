@@ -3,6 +3,7 @@
 #include "require.h"
 #include "storage.h"
 #include "blockchain.h"
+#include "crypto.h"
 #include "instruction.h"
 
 #include "vm.js.h"
@@ -86,6 +87,7 @@ Local<ObjectTemplate> createGlobalTpl(Isolate *isolate) {
 //    InitRequire(isolate, global);
     InitStorage(isolate, global);
     InitBlockchain(isolate, global);
+    InitCrypto(isolate, global);
     InitInstruction(isolate, global);
 
     global->Set(
@@ -124,6 +126,7 @@ SandboxPtr newSandbox(IsolatePtr ptr) {
     sbx->jsPath = strdup("v8/libjs");
     sbx->gasUsed = 0;
     sbx->gasLimit = 0;
+    sbx->metering = false;
 
     return static_cast<SandboxPtr>(sbx);
 }
@@ -154,6 +157,11 @@ void setSandboxGasLimit(SandboxPtr ptr, size_t gasLimit) {
     sbx->gasLimit = gasLimit;
 }
 
+void setSandboxMetering(SandboxPtr ptr, int metering) {
+    Sandbox *sbx = static_cast<Sandbox*>(ptr);
+    sbx->metering = metering != 0;
+}
+
 std::string reportException(Isolate *isolate, Local<Context> ctx, TryCatch& tryCatch) {
     std::stringstream ss;
     ss << "Uncaught exception: ";
@@ -270,6 +278,11 @@ void RealExecute(SandboxPtr ptr, const char *code, std::string &result, std::str
 
     Local<Value> ret = script->Run();
 
+    if (sbx->metering && sbx->gasUsed > sbx->gasLimit) {
+        error = "out of gas";
+        return;
+    }
+
     if (tryCatch.HasCaught() && tryCatch.Exception()->IsNull()) {
         return;
     }
@@ -333,7 +346,15 @@ ValueTuple Execution(SandboxPtr ptr, const char *code, long long int expireTime)
             res.gasUsed = sbx->gasUsed;
             break;
         }
-        auto now = std::chrono::duration_cast<std::chrono::nanoseconds>(std::chrono::steady_clock::now().time_since_epoch()).count();
+        // with metering, wall time is only a node-local safety valve, the execution bound agreed by all nodes is the gas limit.
+        // expireTime is unix time in nanoseconds, so it is compared with the system clock, the steady clock is kept before
+        // the fork to verify the old blocks the same way
+        long long now;
+        if (sbx->metering) {
+            now = std::chrono::duration_cast<std::chrono::nanoseconds>(std::chrono::system_clock::now().time_since_epoch()).count();
+        } else {
+            now = std::chrono::duration_cast<std::chrono::nanoseconds>(std::chrono::steady_clock::now().time_since_epoch()).count();
+        }
         //auto execTime = std::chrono::duration_cast<std::chrono::milliseconds>(now - startTime).count();
         if (now > expireTime) {
             isolate->TerminateExecution();
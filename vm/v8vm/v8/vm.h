@@ -35,6 +35,7 @@ extern void releaseSandbox(SandboxPtr ptr);
 extern ValueTuple Execute(SandboxPtr ptr, const char *code, long long int expireTime);
 extern void setJSPath(SandboxPtr ptr, const char *jsPath);
 extern void setSandboxGasLimit(SandboxPtr ptr, size_t gasLimit);
+extern void setSandboxMetering(SandboxPtr ptr, int metering);
 
 // log
 typedef int (*consoleFunc)(SandboxPtr, const char *, const char *);
@@ -52,12 +53,15 @@ typedef int (*topUpFunc)(SandboxPtr, const char *, const char *, const char *, s
 typedef int (*countermandFunc)(SandboxPtr, const char *, const char *, const char *, size_t *);
 typedef int (*blockInfoFunc)(SandboxPtr, char **, size_t *);
 typedef int (*txInfoFunc)(SandboxPtr, char **, size_t *);
+typedef int (*blockRandomFunc)(SandboxPtr, const char *, char **, size_t *);
 typedef int (*callFunc)(SandboxPtr, const char *, const char *, const char *, char **, size_t *);
 typedef int (*requireAuthFunc)(SandboxPtr, const char *, const bool *, size_t *);
 typedef int (*grantServiFunc)(SandboxPtr, const char *, const char *, size_t *);
+typedef int (*emitFunc)(SandboxPtr, const char *, const char *, const char *, size_t *);
 void InitGoBlockchain(transferFunc, withdrawFunc,
                         depositFunc, topUpFunc, countermandFunc,
-                        blockInfoFunc, txInfoFunc, callFunc, callFunc, requireAuthFunc, grantServiFunc);
+                        blockInfoFunc, txInfoFunc, callFunc, callFunc, requireAuthFunc, grantServiFunc, emitFunc,
+                        blockRandomFunc);
 
 // storage
 typedef int (*putFunc)(SandboxPtr, const char *, const char *, size_t *);
@@ -73,6 +77,12 @@ void InitGoStorage(putFunc, getFunc, delFunc,
     mapPutFunc, mapHasFunc, mapGetFunc, mapDelFunc, mapKeysFunc,
     globalGetFunc);
 
+// crypto
+typedef char *(*hashFunc)(SandboxPtr, const char *, size_t *);
+typedef int (*verifyFunc)(SandboxPtr, const char *, const char *, const char *, const char *, size_t *);
+typedef char *(*pubkeyToIDFunc)(SandboxPtr, const char *, size_t *);
+void InitGoCrypto(hashFunc, hashFunc, hashFunc, hashFunc, verifyFunc, pubkeyToIDFunc);
+
 extern void goMapLen(SandboxPtr, const char *, size_t *);
 extern void goGlobalMapGet(SandboxPtr, const char *, const char *, const char *, size_t *);
 extern void goGlobalMapKeys(SandboxPtr, const char *, const char *, size_t *);
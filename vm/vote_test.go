@@ -528,6 +528,137 @@ func TestJS_Vote(t *testing.T) {
 	})
 }
 
+func genEvidence(t *testing.T, seckey string, slot int64) string {
+	ac, err := account.NewAccount(common.Base58Decode(seckey), crypto.Secp256k1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var blks []*block.Block
+	for _, parent := range []string{"abc", "def"} {
+		blk := &block.Block{
+			Head: &block.BlockHead{
+				ParentHash: []byte(parent),
+				Number:     1,
+				Witness:    ac.ID,
+				Time:       slot,
+			},
+		}
+		blk.CalculateHeadHash()
+		blk.Sign = ac.Sign(blk.HeadHash())
+		blks = append(blks, blk)
+	}
+	e, err := block.NewEvidence(blks[0], blks[1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := e.Encode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return common.Base58Encode(b)
+}
+
+//nolint
+func TestJS_VoteEvidence(t *testing.T) {
+	Convey("test of vote evidence", t, func() {
+		ilog.Stop()
+
+		js := NewJSTester(t)
+		defer js.Clear()
+		js.NewBlock(&block.BlockHead{
+			ParentHash: []byte("abc"),
+			Number:     0,
+			Witness:    "witness",
+			Time:       123456,
+		})
+		lc, err := ReadFile("../config/vote.js")
+		if err != nil {
+			t.Fatal(err)
+		}
+		js.SetJS(string(lc))
+		js.SetAPI("InitProducer", "string")
+		js.SetAPI("LogInProducer", "string")
+		js.SetAPI("UnregisterProducer", "string")
+		js.SetAPI("RegisterProducer", "string", "string", "string", "string")
+		js.SetAPI("SubmitEvidence", "string")
+		js.SetAPI("Unjail", "string")
+		for i := 0; i <= 4; i += 2 {
+			js.vi.SetBalance(testID[i], 5e+7*1e8)
+		}
+		js.vi.Commit()
+		r := js.DoSet()
+		So(r.Status.Message, ShouldEqual, "")
+		for i := 0; i <= 4; i += 2 {
+			r = js.TestJS("InitProducer", fmt.Sprintf(`["%v"]`, testID[i]))
+			So(r.Status.Message, ShouldEqual, "")
+		}
+
+		js.NewBlock(&block.BlockHead{
+			ParentHash: []byte("abc"),
+			Number:     10,
+			Witness:    "witness",
+			Time:       123456,
+		})
+
+		// invalid evidence
+		r = js.TestJS("SubmitEvidence", `["abc"]`)
+		So(r.Status.Message, ShouldContainSubstring, "verify evidence failed")
+
+		// slash and jail the producer, anyone can submit the evidence
+		evidence := genEvidence(t, testID[3], 100)
+		r = js.TestJSWithAuth("SubmitEvidence", fmt.Sprintf(`["%v"]`, evidence), testID[5])
+		So(r.Status.Message, ShouldEqual, "")
+		So(js.ReadDB("pendingProducerList"), ShouldNotContainSubstring, testID[2])
+		So(js.ReadMap("slashTable", testID[2]), ShouldEqual, "10000000000000")
+		So(js.ReadMap("jailTable", testID[2]), ShouldEqual, "610")
+		So(js.ReadMap("producerTable", testID[2]), ShouldContainSubstring, `"online":false`)
+
+		r = js.TestJS("SubmitEvidence", fmt.Sprintf(`["%v"]`, evidence))
+		So(r.Status.Message, ShouldContainSubstring, "evidence exists")
+
+		r = js.TestJSWithAuth("LogInProducer", fmt.Sprintf(`["%v"]`, testID[2]), testID[3])
+		So(r.Status.Message, ShouldContainSubstring, "producer in jail")
+
+		// unjail after the cooldown
+		r = js.TestJSWithAuth("Unjail", fmt.Sprintf(`["%v"]`, testID[2]), testID[3])
+		So(r.Status.Message, ShouldContainSubstring, "producer still in jail")
+
+		js.NewBlock(&block.BlockHead{
+			ParentHash: []byte("abc"),
+			Number:     610,
+			Witness:    "witness",
+			Time:       123456,
+		})
+		balance := js.vi.Balance(testID[2])
+		r = js.TestJSWithAuth("Unjail", fmt.Sprintf(`["%v"]`, testID[2]), testID[3])
+		So(r.Status.Message, ShouldEqual, "")
+		So(js.vi.Balance(testID[2]), ShouldBeLessThanOrEqualTo, balance-10000000000000)
+		r = js.TestJSWithAuth("LogInProducer", fmt.Sprintf(`["%v"]`, testID[2]), testID[3])
+		So(r.Status.Message, ShouldEqual, "")
+
+		// a jailed producer can't escape by registering again
+		r = js.TestJSWithAuth("SubmitEvidence", fmt.Sprintf(`["%v"]`, genEvidence(t, testID[5], 101)), testID[1])
+		So(r.Status.Message, ShouldEqual, "")
+		r = js.TestJSWithAuth("UnregisterProducer", fmt.Sprintf(`["%v"]`, testID[4]), testID[5])
+		So(r.Status.Message, ShouldEqual, "")
+		So(js.ReadMap("jailTable", testID[4]), ShouldEqual, "1210")
+		register := fmt.Sprintf(`["%v", "loc", "url", "netId"]`, testID[4])
+		r = js.TestJSWithAuth("RegisterProducer", register, testID[5])
+		So(r.Status.Message, ShouldContainSubstring, "producer in jail")
+
+		js.NewBlock(&block.BlockHead{
+			ParentHash: []byte("abc"),
+			Number:     1210,
+			Witness:    "witness",
+			Time:       123456,
+		})
+		r = js.TestJSWithAuth("RegisterProducer", register, testID[5])
+		So(r.Status.Message, ShouldEqual, "")
+		r = js.TestJSWithAuth("LogInProducer", fmt.Sprintf(`["%v"]`, testID[4]), testID[5])
+		So(r.Status.Message, ShouldEqual, "")
+	})
+}
+
 //nolint
 func TestJS_Genesis(t *testing.T) {
 	t.Skip("skip genesis")
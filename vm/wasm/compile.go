@@ -0,0 +1,604 @@
+package wasm
+
+import (
+	"errors"
+	"fmt"
+)
+
+// opcodes of the supported instructions
+const (
+	opUnreachable  byte = 0x00
+	opNop          byte = 0x01
+	opBlock        byte = 0x02
+	opLoop         byte = 0x03
+	opIf           byte = 0x04
+	opElse         byte = 0x05
+	opEnd          byte = 0x0b
+	opBr           byte = 0x0c
+	opBrIf         byte = 0x0d
+	opBrTable      byte = 0x0e
+	opReturn       byte = 0x0f
+	opCall         byte = 0x10
+	opCallIndirect byte = 0x11
+	opDrop         byte = 0x1a
+	opSelect       byte = 0x1b
+	opLocalGet     byte = 0x20
+	opLocalSet     byte = 0x21
+	opLocalTee     byte = 0x22
+	opGlobalGet    byte = 0x23
+	opGlobalSet    byte = 0x24
+	opI32Load      byte = 0x28
+	opI64Load      byte = 0x29
+	opI32Load8S    byte = 0x2c
+	opI32Load8U    byte = 0x2d
+	opI32Load16S   byte = 0x2e
+	opI32Load16U   byte = 0x2f
+	opI64Load8S    byte = 0x30
+	opI64Load8U    byte = 0x31
+	opI64Load16S   byte = 0x32
+	opI64Load16U   byte = 0x33
+	opI64Load32S   byte = 0x34
+	opI64Load32U   byte = 0x35
+	opI32Store     byte = 0x36
+	opI64Store     byte = 0x37
+	opI32Store8    byte = 0x3a
+	opI32Store16   byte = 0x3b
+	opI64Store8    byte = 0x3c
+	opI64Store16   byte = 0x3d
+	opI64Store32   byte = 0x3e
+	opMemorySize   byte = 0x3f
+	opMemoryGrow   byte = 0x40
+	opI32Const     byte = 0x41
+	opI64Const     byte = 0x42
+	opI32Eqz       byte = 0x45
+	opI32GeU       byte = 0x4f
+	opI64Eqz       byte = 0x50
+	opI64GeU       byte = 0x5a
+	opI32Clz       byte = 0x67
+	opI32Popcnt    byte = 0x69
+	opI32Rotr      byte = 0x78
+	opI64Clz       byte = 0x79
+	opI64Popcnt    byte = 0x7b
+	opI64Rotr      byte = 0x8a
+	opI32WrapI64   byte = 0xa7
+	opI64ExtendS   byte = 0xac
+	opI64ExtendU   byte = 0xad
+	opI32Extend8S  byte = 0xc0
+	opI32Extend16S byte = 0xc1
+	opI64Extend8S  byte = 0xc2
+	opI64Extend16S byte = 0xc3
+	opI64Extend32S byte = 0xc4
+)
+
+// unknown is the type of the operands popped from the unreachable code.
+const unknown valType = 0
+
+type branch struct {
+	pc     uint32
+	arity  uint32
+	height uint32
+}
+
+// instr is a validated instruction. The branches have their targets resolved: a is the pc, b the
+// number of kept values and c the operand stack height of the target.
+type instr struct {
+	op  byte
+	a   uint64
+	b   uint32
+	c   uint32
+	tbl []branch
+}
+
+type fixup struct {
+	instr int
+	entry int // -1 for the target of a br, br_if, if or else, the index in tbl for a br_table
+}
+
+type ctrlFrame struct {
+	op          byte
+	result      []valType
+	height      int
+	unreachable bool
+	start       int
+	fixups      []fixup
+	ifInstr     int
+}
+
+func (f *ctrlFrame) labelTypes() []valType {
+	if f.op == opLoop {
+		return nil
+	}
+	return f.result
+}
+
+type compiler struct {
+	m        *Module
+	r        *reader
+	locals   []valType
+	results  []valType
+	vals     []valType
+	ctrls    []*ctrlFrame
+	code     []instr
+	maxStack int
+}
+
+// compile validates the body of the function and translates it into the instructions to execute.
+func (m *Module) compile(f *function) error {
+	ft := &m.types[f.typ]
+	c := &compiler{
+		m:       m,
+		r:       &reader{b: f.body},
+		locals:  append(append([]valType{}, ft.params...), f.locals...),
+		results: ft.results,
+	}
+	c.ctrls = []*ctrlFrame{{op: opBlock, result: ft.results, ifInstr: -1}}
+	for len(c.ctrls) > 0 {
+		op, err := c.r.byte()
+		if err != nil {
+			return err
+		}
+		if err := c.validate(op); err != nil {
+			return fmt.Errorf("opcode 0x%x at %v: %v", op, c.r.pos-1, err)
+		}
+	}
+	if !c.r.eof() {
+		return errors.New("operators remaining after the end of function")
+	}
+	f.code = c.code
+	f.maxStack = c.maxStack
+	return nil
+}
+
+func (c *compiler) push(t valType) {
+	c.vals = append(c.vals, t)
+	if len(c.vals) > c.maxStack {
+		c.maxStack = len(c.vals)
+	}
+}
+
+func (c *compiler) pop(expect valType) (valType, error) {
+	frame := c.ctrls[len(c.ctrls)-1]
+	if len(c.vals) == frame.height {
+		if frame.unreachable {
+			return expect, nil
+		}
+		return 0, errors.New("type mismatch: operand stack underflow")
+	}
+	t := c.vals[len(c.vals)-1]
+	c.vals = c.vals[:len(c.vals)-1]
+	if t != unknown && expect != unknown && t != expect {
+		return 0, fmt.Errorf("type mismatch: expected %v, got %v", expect, t)
+	}
+	if t == unknown {
+		return expect, nil
+	}
+	return t, nil
+}
+
+func (c *compiler) popTypes(ts []valType) error {
+	for i := len(ts) - 1; i >= 0; i-- {
+		if _, err := c.pop(ts[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *compiler) pushTypes(ts []valType) {
+	for _, t := range ts {
+		c.push(t)
+	}
+}
+
+func (c *compiler) setUnreachable() {
+	frame := c.ctrls[len(c.ctrls)-1]
+	c.vals = c.vals[:frame.height]
+	frame.unreachable = true
+}
+
+func (c *compiler) emit(in instr) int {
+	c.code = append(c.code, in)
+	return len(c.code) - 1
+}
+
+func (c *compiler) blockType() ([]valType, error) {
+	b, err := c.r.byte()
+	if err != nil {
+		return nil, err
+	}
+	switch valType(b) {
+	case 0x40:
+		return nil, nil
+	case i32, i64:
+		return []valType{valType(b)}, nil
+	case f32, f64:
+		return nil, errFloat
+	}
+	return nil, errors.New("multi-value blocks aren't supported")
+}
+
+// label returns the branch to the label, the target pc is fixed later if it's the end of a block.
+func (c *compiler) label(depth uint32, in int, entry int) (branch, []valType, error) {
+	if int(depth) >= len(c.ctrls) {
+		return branch{}, nil, fmt.Errorf("unknown label %v", depth)
+	}
+	frame := c.ctrls[len(c.ctrls)-1-int(depth)]
+	types := frame.labelTypes()
+	br := branch{arity: uint32(len(types)), height: uint32(frame.height)}
+	if frame.op == opLoop {
+		br.pc = uint32(frame.start)
+	} else {
+		frame.fixups = append(frame.fixups, fixup{instr: in, entry: entry})
+	}
+	return br, types, nil
+}
+
+func (c *compiler) patch(fixups []fixup, pc int) {
+	for _, f := range fixups {
+		if f.entry < 0 {
+			c.code[f.instr].a = uint64(pc)
+		} else {
+			c.code[f.instr].tbl[f.entry].pc = uint32(pc)
+		}
+	}
+}
+
+func (c *compiler) memarg(align uint32) (uint32, error) {
+	if c.m.memory == nil {
+		return 0, errors.New("unknown memory 0")
+	}
+	a, err := c.r.u32()
+	if err != nil {
+		return 0, err
+	}
+	if a > align {
+		return 0, errors.New("alignment must not be larger than natural")
+	}
+	return c.r.u32()
+}
+
+// memory access: the alignment in log2, the type of the value, and whether it's a store
+var memOps = map[byte]struct {
+	align uint32
+	typ   valType
+	store bool
+}{
+	opI32Load:    {2, i32, false},
+	opI64Load:    {3, i64, false},
+	opI32Load8S:  {0, i32, false},
+	opI32Load8U:  {0, i32, false},
+	opI32Load16S: {1, i32, false},
+	opI32Load16U: {1, i32, false},
+	opI64Load8S:  {0, i64, false},
+	opI64Load8U:  {0, i64, false},
+	opI64Load16S: {1, i64, false},
+	opI64Load16U: {1, i64, false},
+	opI64Load32S: {2, i64, false},
+	opI64Load32U: {2, i64, false},
+	opI32Store:   {2, i32, true},
+	opI64Store:   {3, i64, true},
+	opI32Store8:  {0, i32, true},
+	opI32Store16: {1, i32, true},
+	opI64Store8:  {0, i64, true},
+	opI64Store16: {1, i64, true},
+	opI64Store32: {2, i64, true},
+}
+
+// numeric returns the operand and result types of the numeric instruction.
+func numeric(op byte) (in []valType, out valType, ok bool) {
+	switch {
+	case op == opI32Eqz:
+		return []valType{i32}, i32, true
+	case op > opI32Eqz && op <= opI32GeU:
+		return []valType{i32, i32}, i32, true
+	case op == opI64Eqz:
+		return []valType{i64}, i32, true
+	case op > opI64Eqz && op <= opI64GeU:
+		return []valType{i64, i64}, i32, true
+	case op >= opI32Clz && op <= opI32Popcnt:
+		return []valType{i32}, i32, true
+	case op > opI32Popcnt && op <= opI32Rotr:
+		return []valType{i32, i32}, i32, true
+	case op >= opI64Clz && op <= opI64Popcnt:
+		return []valType{i64}, i64, true
+	case op > opI64Popcnt && op <= opI64Rotr:
+		return []valType{i64, i64}, i64, true
+	case op == opI32WrapI64:
+		return []valType{i64}, i32, true
+	case op == opI64ExtendS || op == opI64ExtendU:
+		return []valType{i32}, i64, true
+	case op == opI32Extend8S || op == opI32Extend16S:
+		return []valType{i32}, i32, true
+	case op >= opI64Extend8S && op <= opI64Extend32S:
+		return []valType{i64}, i64, true
+	}
+	return nil, 0, false
+}
+
+func (c *compiler) validate(op byte) error {
+	frame := c.ctrls[len(c.ctrls)-1]
+	switch op {
+	case opUnreachable:
+		c.emit(instr{op: op})
+		c.setUnreachable()
+	case opNop:
+	case opBlock, opLoop, opIf:
+		result, err := c.blockType()
+		if err != nil {
+			return err
+		}
+		if len(c.ctrls) > MaxBlockDepth {
+			return errors.New("blocks nested too deep")
+		}
+		next := &ctrlFrame{op: op, result: result, start: len(c.code), ifInstr: -1}
+		if op == opIf {
+			if _, err := c.pop(i32); err != nil {
+				return err
+			}
+			next.ifInstr = c.emit(instr{op: opIf})
+		}
+		next.height = len(c.vals)
+		c.ctrls = append(c.ctrls, next)
+	case opElse:
+		if frame.op != opIf || frame.ifInstr < 0 {
+			return errors.New("else without if")
+		}
+		if err := c.endFrame(frame); err != nil {
+			return err
+		}
+		jump := c.emit(instr{op: opElse})
+		frame.fixups = append(frame.fixups, fixup{instr: jump, entry: -1})
+		c.code[frame.ifInstr].a = uint64(len(c.code))
+		frame.ifInstr = -1
+		frame.unreachable = false
+	case opEnd:
+		if err := c.endFrame(frame); err != nil {
+			return err
+		}
+		if frame.ifInstr >= 0 {
+			if len(frame.result) != 0 {
+				return errors.New("type mismatch: if without else must not have results")
+			}
+			c.code[frame.ifInstr].a = uint64(len(c.code))
+		}
+		c.patch(frame.fixups, len(c.code))
+		c.ctrls = c.ctrls[:len(c.ctrls)-1]
+		if len(c.ctrls) == 0 {
+			c.emit(instr{op: opReturn, b: uint32(len(c.results))})
+		}
+		c.pushTypes(frame.result)
+	case opBr, opBrIf:
+		depth, err := c.r.u32()
+		if err != nil {
+			return err
+		}
+		if op == opBrIf {
+			if _, err := c.pop(i32); err != nil {
+				return err
+			}
+		}
+		in := len(c.code)
+		br, types, err := c.label(depth, in, -1)
+		if err != nil {
+			return err
+		}
+		c.emit(instr{op: op, a: uint64(br.pc), b: br.arity, c: br.height})
+		if err := c.popTypes(types); err != nil {
+			return err
+		}
+		if op == opBr {
+			c.setUnreachable()
+		} else {
+			c.pushTypes(types)
+		}
+	case opBrTable:
+		n, err := c.r.u32()
+		if err != nil {
+			return err
+		}
+		if int(n) > len(c.r.b) {
+			return errUnexpectedEnd
+		}
+		if _, err := c.pop(i32); err != nil {
+			return err
+		}
+		in := c.emit(instr{op: op, tbl: make([]branch, n+1)})
+		var arity []valType
+		for i := 0; i <= int(n); i++ {
+			depth, err := c.r.u32()
+			if err != nil {
+				return err
+			}
+			br, types, err := c.label(depth, in, i)
+			if err != nil {
+				return err
+			}
+			if i > 0 && len(types) != len(arity) {
+				return errors.New("type mismatch: br_table targets have inconsistent arities")
+			}
+			arity = types
+			c.code[in].tbl[i] = br
+		}
+		if err := c.popTypes(arity); err != nil {
+			return err
+		}
+		c.setUnreachable()
+	case opReturn:
+		if err := c.popTypes(c.results); err != nil {
+			return err
+		}
+		c.emit(instr{op: op, b: uint32(len(c.results))})
+		c.setUnreachable()
+	case opCall:
+		index, err := c.r.u32()
+		if err != nil {
+			return err
+		}
+		if int(index) >= c.m.numFuncs() {
+			return fmt.Errorf("unknown function %v", index)
+		}
+		ft := c.m.funcType(index)
+		if err := c.popTypes(ft.params); err != nil {
+			return err
+		}
+		c.emit(instr{op: op, a: uint64(index)})
+		c.pushTypes(ft.results)
+	case opCallIndirect:
+		typ, err := c.r.u32()
+		if err != nil {
+			return err
+		}
+		table, err := c.r.byte()
+		if err != nil {
+			return err
+		}
+		if table != 0 || c.m.table == nil {
+			return errors.New("unknown table 0")
+		}
+		if int(typ) >= len(c.m.types) {
+			return fmt.Errorf("unknown type %v", typ)
+		}
+		if _, err := c.pop(i32); err != nil {
+			return err
+		}
+		ft := &c.m.types[typ]
+		if err := c.popTypes(ft.params); err != nil {
+			return err
+		}
+		c.emit(instr{op: op, a: uint64(typ)})
+		c.pushTypes(ft.results)
+	case opDrop:
+		if _, err := c.pop(unknown); err != nil {
+			return err
+		}
+		c.emit(instr{op: op})
+	case opSelect:
+		if _, err := c.pop(i32); err != nil {
+			return err
+		}
+		t1, err := c.pop(unknown)
+		if err != nil {
+			return err
+		}
+		t2, err := c.pop(t1)
+		if err != nil {
+			return err
+		}
+		c.emit(instr{op: op})
+		c.push(t2)
+	case opLocalGet, opLocalSet, opLocalTee:
+		index, err := c.r.u32()
+		if err != nil {
+			return err
+		}
+		if int(index) >= len(c.locals) {
+			return fmt.Errorf("unknown local %v", index)
+		}
+		t := c.locals[index]
+		if op != opLocalGet {
+			if _, err := c.pop(t); err != nil {
+				return err
+			}
+		}
+		if op != opLocalSet {
+			c.push(t)
+		}
+		c.emit(instr{op: op, a: uint64(index)})
+	case opGlobalGet, opGlobalSet:
+		index, err := c.r.u32()
+		if err != nil {
+			return err
+		}
+		if int(index) >= len(c.m.globals) {
+			return fmt.Errorf("unknown global %v", index)
+		}
+		g := c.m.globals[index]
+		if op == opGlobalGet {
+			c.push(g.typ)
+		} else {
+			if !g.mutable {
+				return errors.New("global is immutable")
+			}
+			if _, err := c.pop(g.typ); err != nil {
+				return err
+			}
+		}
+		c.emit(instr{op: op, a: uint64(index)})
+	case opMemorySize, opMemoryGrow:
+		mem, err := c.r.byte()
+		if err != nil {
+			return err
+		}
+		if mem != 0 || c.m.memory == nil {
+			return errors.New("unknown memory 0")
+		}
+		if op == opMemoryGrow {
+			if _, err := c.pop(i32); err != nil {
+				return err
+			}
+		}
+		c.push(i32)
+		c.emit(instr{op: op})
+	case opI32Const:
+		v, err := c.r.s32()
+		if err != nil {
+			return err
+		}
+		c.push(i32)
+		c.emit(instr{op: op, a: uint64(uint32(v))})
+	case opI64Const:
+		v, err := c.r.s64()
+		if err != nil {
+			return err
+		}
+		c.push(i64)
+		c.emit(instr{op: op, a: uint64(v)})
+	default:
+		if mop, ok := memOps[op]; ok {
+			offset, err := c.memarg(mop.align)
+			if err != nil {
+				return err
+			}
+			if mop.store {
+				if _, err := c.pop(mop.typ); err != nil {
+					return err
+				}
+			}
+			if _, err := c.pop(i32); err != nil {
+				return err
+			}
+			if !mop.store {
+				c.push(mop.typ)
+			}
+			c.emit(instr{op: op, a: uint64(offset)})
+			return nil
+		}
+		in, out, ok := numeric(op)
+		if !ok {
+			if op >= 0x2a && op <= 0xbf {
+				return errFloat
+			}
+			return errors.New("unsupported instruction")
+		}
+		if err := c.popTypes(in); err != nil {
+			return err
+		}
+		c.push(out)
+		c.emit(instr{op: op})
+	}
+	if len(c.vals) > MaxStackHeight {
+		return errors.New("operand stack too high")
+	}
+	return nil
+}
+
+// endFrame checks the operand stack at the end of the block matches its results.
+func (c *compiler) endFrame(frame *ctrlFrame) error {
+	if err := c.popTypes(frame.result); err != nil {
+		return err
+	}
+	if len(c.vals) != frame.height {
+		return errors.New("type mismatch: values remaining on the operand stack at the end of block")
+	}
+	return nil
+}
@@ -0,0 +1,564 @@
+package wasm
+
+import (
+	"encoding/binary"
+	"errors"
+	"math/bits"
+)
+
+// limits and costs of the execution
+var (
+	MaxCallDepth  = 512
+	StackSize     = 1 << 15
+	MemoryPageGas = int64(1000)
+)
+
+var (
+	errOutOfGas           = errors.New("out of gas")
+	errUnreachable        = errors.New("unreachable executed")
+	errStackOverflow      = errors.New("call stack exhausted")
+	errOutOfBounds        = errors.New("out of bounds memory access")
+	errDivideByZero       = errors.New("integer divide by zero")
+	errIntegerOverflow    = errors.New("integer overflow")
+	errUndefinedElement   = errors.New("undefined element")
+	errIndirectCallType   = errors.New("indirect call type mismatch")
+	errUninitializedEntry = errors.New("uninitialized element")
+)
+
+type hostFunc func(in *instance, args []uint64) (uint64, error)
+
+// instance is a module instantiated for one call of the contract. The gas counts the executed
+// instructions and the costs of the host functions, the execution traps once it exceeds the limit.
+type instance struct {
+	m        *Module
+	hosts    []hostFunc
+	mem      []byte
+	maxPages int
+	globals  []uint64
+	table    []int64
+	stack    []uint64
+	sp       int
+	depth    int
+	gas      int64
+	gasLimit int64
+}
+
+func newInstance(m *Module, hosts []hostFunc, gasLimit int64) *instance {
+	return &instance{
+		m:        m,
+		hosts:    hosts,
+		globals:  make([]uint64, len(m.globals)),
+		stack:    make([]uint64, StackSize),
+		gasLimit: gasLimit,
+	}
+}
+
+// instantiate initializes the globals, memory and table, and runs the start function.
+func (in *instance) instantiate() error {
+	m := in.m
+	for i, g := range m.globals {
+		in.globals[i] = g.init
+	}
+	if m.memory != nil {
+		in.maxPages = MaxMemoryPages
+		if m.memory.has {
+			in.maxPages = int(m.memory.max)
+		}
+		if err := in.chargePages(int64(m.memory.min)); err != nil {
+			return err
+		}
+		in.mem = make([]byte, int(m.memory.min)*pageSize)
+	}
+	if m.table != nil {
+		in.table = make([]int64, m.table.min)
+		for i := range in.table {
+			in.table[i] = -1
+		}
+	}
+	for _, e := range m.elems {
+		if uint64(e.offset)+uint64(len(e.funcs)) > uint64(len(in.table)) {
+			return errors.New("elements segment does not fit")
+		}
+		for i, f := range e.funcs {
+			in.table[int(e.offset)+i] = int64(f)
+		}
+	}
+	for _, d := range m.data {
+		if uint64(d.offset)+uint64(len(d.data)) > uint64(len(in.mem)) {
+			return errors.New("data segment does not fit")
+		}
+		copy(in.mem[d.offset:], d.data)
+	}
+	if m.start >= 0 {
+		return in.call(uint32(m.start))
+	}
+	return nil
+}
+
+func (in *instance) charge(gas int64) error {
+	in.gas += gas
+	if in.gas > in.gasLimit {
+		return errOutOfGas
+	}
+	return nil
+}
+
+func (in *instance) chargePages(pages int64) error {
+	return in.charge(pages * MemoryPageGas)
+}
+
+// memory returns the n bytes at the address in the linear memory.
+func (in *instance) memory(addr uint64, n uint64) ([]byte, error) {
+	if addr+n > uint64(len(in.mem)) {
+		return nil, errOutOfBounds
+	}
+	return in.mem[addr : addr+n], nil
+}
+
+// call calls the function in the function index space with the params on the top of the stack.
+func (in *instance) call(index uint32) error {
+	ft := in.m.funcType(index)
+	base := in.sp - len(ft.params)
+	if int(index) < len(in.m.imports) {
+		r, err := in.hosts[index](in, in.stack[base:in.sp])
+		if err != nil {
+			return err
+		}
+		in.sp = base
+		if len(ft.results) == 1 {
+			in.stack[in.sp] = r
+			in.sp++
+		}
+		return nil
+	}
+	f := in.m.funcs[int(index)-len(in.m.imports)]
+	top := base + len(ft.params) + len(f.locals)
+	if in.depth >= MaxCallDepth || top+f.maxStack > len(in.stack) {
+		return errStackOverflow
+	}
+	for i := in.sp; i < top; i++ {
+		in.stack[i] = 0
+	}
+	in.sp = top
+	in.depth++
+	err := in.run(f, base, top)
+	in.depth--
+	return err
+}
+
+func (in *instance) run(f *function, base, opBase int) error {
+	stack := in.stack
+	sp := in.sp
+	code := f.code
+	for pc := 0; ; pc++ {
+		in.gas++
+		if in.gas > in.gasLimit {
+			return errOutOfGas
+		}
+		ins := &code[pc]
+		switch op := ins.op; op {
+		case opUnreachable:
+			return errUnreachable
+		case opIf:
+			sp--
+			if uint32(stack[sp]) == 0 {
+				pc = int(ins.a) - 1
+			}
+		case opElse:
+			pc = int(ins.a) - 1
+		case opBr:
+			sp = branchTo(stack, sp, opBase, ins.b, ins.c)
+			pc = int(ins.a) - 1
+		case opBrIf:
+			sp--
+			if uint32(stack[sp]) != 0 {
+				sp = branchTo(stack, sp, opBase, ins.b, ins.c)
+				pc = int(ins.a) - 1
+			}
+		case opBrTable:
+			sp--
+			i := uint64(uint32(stack[sp]))
+			if i >= uint64(len(ins.tbl)) {
+				i = uint64(len(ins.tbl)) - 1
+			}
+			br := ins.tbl[i]
+			sp = branchTo(stack, sp, opBase, br.arity, br.height)
+			pc = int(br.pc) - 1
+		case opReturn:
+			if ins.b == 1 {
+				stack[base] = stack[sp-1]
+			}
+			in.sp = base + int(ins.b)
+			return nil
+		case opCall, opCallIndirect:
+			index := uint32(ins.a)
+			if op == opCallIndirect {
+				sp--
+				i := uint64(uint32(stack[sp]))
+				if i >= uint64(len(in.table)) {
+					return errUndefinedElement
+				}
+				if in.table[i] < 0 {
+					return errUninitializedEntry
+				}
+				index = uint32(in.table[i])
+				if !in.m.funcType(index).equal(&in.m.types[ins.a]) {
+					return errIndirectCallType
+				}
+			}
+			in.sp = sp
+			if err := in.call(index); err != nil {
+				return err
+			}
+			sp = in.sp
+		case opDrop:
+			sp--
+		case opSelect:
+			sp -= 2
+			if uint32(stack[sp+1]) == 0 {
+				stack[sp-1] = stack[sp]
+			}
+		case opLocalGet:
+			stack[sp] = stack[base+int(ins.a)]
+			sp++
+		case opLocalSet:
+			sp--
+			stack[base+int(ins.a)] = stack[sp]
+		case opLocalTee:
+			stack[base+int(ins.a)] = stack[sp-1]
+		case opGlobalGet:
+			stack[sp] = in.globals[ins.a]
+			sp++
+		case opGlobalSet:
+			sp--
+			in.globals[ins.a] = stack[sp]
+		case opMemorySize:
+			stack[sp] = uint64(len(in.mem) / pageSize)
+			sp++
+		case opMemoryGrow:
+			old := len(in.mem) / pageSize
+			delta := int(uint32(stack[sp-1]))
+			if delta > in.maxPages-old {
+				stack[sp-1] = uint64(uint32(0xffffffff))
+				break
+			}
+			if err := in.chargePages(int64(delta)); err != nil {
+				return err
+			}
+			in.mem = append(in.mem, make([]byte, delta*pageSize)...)
+			stack[sp-1] = uint64(old)
+		case opI32Const, opI64Const:
+			stack[sp] = ins.a
+			sp++
+		case opI32Load, opI64Load, opI32Load8S, opI32Load8U, opI32Load16S, opI32Load16U,
+			opI64Load8S, opI64Load8U, opI64Load16S, opI64Load16U, opI64Load32S, opI64Load32U:
+			v, err := in.load(op, uint64(uint32(stack[sp-1]))+ins.a)
+			if err != nil {
+				return err
+			}
+			stack[sp-1] = v
+		case opI32Store, opI64Store, opI32Store8, opI32Store16, opI64Store8, opI64Store16, opI64Store32:
+			sp -= 2
+			if err := in.store(op, uint64(uint32(stack[sp]))+ins.a, stack[sp+1]); err != nil {
+				return err
+			}
+		default:
+			var err error
+			sp, err = numericOp(op, stack, sp)
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// branchTo keeps the top arity values and drops the others above the target height.
+func branchTo(stack []uint64, sp, opBase int, arity, height uint32) int {
+	top := opBase + int(height)
+	if arity == 1 {
+		stack[top] = stack[sp-1]
+	}
+	return top + int(arity)
+}
+
+func (in *instance) load(op byte, addr uint64) (uint64, error) {
+	size := uint64(1)
+	switch op {
+	case opI32Load, opI64Load32S, opI64Load32U:
+		size = 4
+	case opI64Load:
+		size = 8
+	case opI32Load16S, opI32Load16U, opI64Load16S, opI64Load16U:
+		size = 2
+	}
+	b, err := in.memory(addr, size)
+	if err != nil {
+		return 0, err
+	}
+	switch op {
+	case opI32Load, opI64Load32U:
+		return uint64(binary.LittleEndian.Uint32(b)), nil
+	case opI64Load:
+		return binary.LittleEndian.Uint64(b), nil
+	case opI32Load8S:
+		return uint64(uint32(int32(int8(b[0])))), nil
+	case opI32Load8U, opI64Load8U:
+		return uint64(b[0]), nil
+	case opI32Load16S:
+		return uint64(uint32(int32(int16(binary.LittleEndian.Uint16(b))))), nil
+	case opI32Load16U, opI64Load16U:
+		return uint64(binary.LittleEndian.Uint16(b)), nil
+	case opI64Load8S:
+		return uint64(int64(int8(b[0]))), nil
+	case opI64Load16S:
+		return uint64(int64(int16(binary.LittleEndian.Uint16(b)))), nil
+	default: // opI64Load32S
+		return uint64(int64(int32(binary.LittleEndian.Uint32(b)))), nil
+	}
+}
+
+func (in *instance) store(op byte, addr uint64, v uint64) error {
+	size := uint64(1)
+	switch op {
+	case opI32Store, opI64Store32:
+		size = 4
+	case opI64Store:
+		size = 8
+	case opI32Store16, opI64Store16:
+		size = 2
+	}
+	b, err := in.memory(addr, size)
+	if err != nil {
+		return err
+	}
+	switch size {
+	case 1:
+		b[0] = byte(v)
+	case 2:
+		binary.LittleEndian.PutUint16(b, uint16(v))
+	case 4:
+		binary.LittleEndian.PutUint32(b, uint32(v))
+	default:
+		binary.LittleEndian.PutUint64(b, v)
+	}
+	return nil
+}
+
+func unary(op byte) bool {
+	switch {
+	case op == opI32Eqz, op == opI64Eqz:
+	case op >= opI32Clz && op <= opI32Popcnt:
+	case op >= opI64Clz && op <= opI64Popcnt:
+	case op >= opI32WrapI64:
+	default:
+		return false
+	}
+	return true
+}
+
+func boolVal(b bool) uint64 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// numericOp executes the integer instruction on the top of the stack, returns the new stack pointer.
+func numericOp(op byte, stack []uint64, sp int) (int, error) {
+	if unary(op) {
+		v := stack[sp-1]
+		x, y := uint32(v), v
+		var r uint64
+		switch op {
+		case opI32Eqz:
+			r = boolVal(x == 0)
+		case opI64Eqz:
+			r = boolVal(y == 0)
+		case opI32Clz:
+			r = uint64(bits.LeadingZeros32(x))
+		case opI32Clz + 1:
+			r = uint64(bits.TrailingZeros32(x))
+		case opI32Popcnt:
+			r = uint64(bits.OnesCount32(x))
+		case opI64Clz:
+			r = uint64(bits.LeadingZeros64(y))
+		case opI64Clz + 1:
+			r = uint64(bits.TrailingZeros64(y))
+		case opI64Popcnt:
+			r = uint64(bits.OnesCount64(y))
+		case opI32WrapI64:
+			r = uint64(x)
+		case opI64ExtendS:
+			r = uint64(int64(int32(x)))
+		case opI64ExtendU:
+			r = uint64(x)
+		case opI32Extend8S:
+			r = uint64(uint32(int32(int8(x))))
+		case opI32Extend16S:
+			r = uint64(uint32(int32(int16(x))))
+		case opI64Extend8S:
+			r = uint64(int64(int8(y)))
+		case opI64Extend16S:
+			r = uint64(int64(int16(y)))
+		case opI64Extend32S:
+			r = uint64(int64(int32(y)))
+		}
+		stack[sp-1] = r
+		return sp, nil
+	}
+	sp--
+	if op <= opI32GeU || (op > opI32Popcnt && op <= opI32Rotr) {
+		r, err := i32Binary(op, uint32(stack[sp-1]), uint32(stack[sp]))
+		stack[sp-1] = r
+		return sp, err
+	}
+	r, err := i64Binary(op, stack[sp-1], stack[sp])
+	stack[sp-1] = r
+	return sp, err
+}
+
+func i32Binary(op byte, a, b uint32) (uint64, error) {
+	switch op {
+	case 0x46:
+		return boolVal(a == b), nil
+	case 0x47:
+		return boolVal(a != b), nil
+	case 0x48:
+		return boolVal(int32(a) < int32(b)), nil
+	case 0x49:
+		return boolVal(a < b), nil
+	case 0x4a:
+		return boolVal(int32(a) > int32(b)), nil
+	case 0x4b:
+		return boolVal(a > b), nil
+	case 0x4c:
+		return boolVal(int32(a) <= int32(b)), nil
+	case 0x4d:
+		return boolVal(a <= b), nil
+	case 0x4e:
+		return boolVal(int32(a) >= int32(b)), nil
+	case 0x4f:
+		return boolVal(a >= b), nil
+	case 0x6a:
+		return uint64(a + b), nil
+	case 0x6b:
+		return uint64(a - b), nil
+	case 0x6c:
+		return uint64(a * b), nil
+	case 0x6d:
+		if b == 0 {
+			return 0, errDivideByZero
+		}
+		if int32(a) == -1<<31 && int32(b) == -1 {
+			return 0, errIntegerOverflow
+		}
+		return uint64(uint32(int32(a) / int32(b))), nil
+	case 0x6e:
+		if b == 0 {
+			return 0, errDivideByZero
+		}
+		return uint64(a / b), nil
+	case 0x6f:
+		if b == 0 {
+			return 0, errDivideByZero
+		}
+		if int32(b) == -1 {
+			return 0, nil
+		}
+		return uint64(uint32(int32(a) % int32(b))), nil
+	case 0x70:
+		if b == 0 {
+			return 0, errDivideByZero
+		}
+		return uint64(a % b), nil
+	case 0x71:
+		return uint64(a & b), nil
+	case 0x72:
+		return uint64(a | b), nil
+	case 0x73:
+		return uint64(a ^ b), nil
+	case 0x74:
+		return uint64(a << (b & 31)), nil
+	case 0x75:
+		return uint64(uint32(int32(a) >> (b & 31))), nil
+	case 0x76:
+		return uint64(a >> (b & 31)), nil
+	case 0x77:
+		return uint64(bits.RotateLeft32(a, int(b&31))), nil
+	default: // rotr
+		return uint64(bits.RotateLeft32(a, -int(b&31))), nil
+	}
+}
+
+func i64Binary(op byte, a, b uint64) (uint64, error) {
+	switch op {
+	case 0x51:
+		return boolVal(a == b), nil
+	case 0x52:
+		return boolVal(a != b), nil
+	case 0x53:
+		return boolVal(int64(a) < int64(b)), nil
+	case 0x54:
+		return boolVal(a < b), nil
+	case 0x55:
+		return boolVal(int64(a) > int64(b)), nil
+	case 0x56:
+		return boolVal(a > b), nil
+	case 0x57:
+		return boolVal(int64(a) <= int64(b)), nil
+	case 0x58:
+		return boolVal(a <= b), nil
+	case 0x59:
+		return boolVal(int64(a) >= int64(b)), nil
+	case 0x5a:
+		return boolVal(a >= b), nil
+	case 0x7c:
+		return a + b, nil
+	case 0x7d:
+		return a - b, nil
+	case 0x7e:
+		return a * b, nil
+	case 0x7f:
+		if b == 0 {
+			return 0, errDivideByZero
+		}
+		if int64(a) == -1<<63 && int64(b) == -1 {
+			return 0, errIntegerOverflow
+		}
+		return uint64(int64(a) / int64(b)), nil
+	case 0x80:
+		if b == 0 {
+			return 0, errDivideByZero
+		}
+		return a / b, nil
+	case 0x81:
+		if b == 0 {
+			return 0, errDivideByZero
+		}
+		if int64(b) == -1 {
+			return 0, nil
+		}
+		return uint64(int64(a) % int64(b)), nil
+	case 0x82:
+		if b == 0 {
+			return 0, errDivideByZero
+		}
+		return a % b, nil
+	case 0x83:
+		return a & b, nil
+	case 0x84:
+		return a | b, nil
+	case 0x85:
+		return a ^ b, nil
+	case 0x86:
+		return a << (b & 63), nil
+	case 0x87:
+		return uint64(int64(a) >> (b & 63)), nil
+	case 0x88:
+		return a >> (b & 63), nil
+	case 0x89:
+		return bits.RotateLeft64(a, int(b&63)), nil
+	default: // rotr
+		return bits.RotateLeft64(a, -int(b&63)), nil
+	}
+}
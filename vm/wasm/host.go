@@ -0,0 +1,441 @@
+package wasm
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/iost-official/go-iost/core/contract"
+	"github.com/iost-official/go-iost/vm/database"
+	"github.com/iost-official/go-iost/vm/host"
+)
+
+// HostModule is the module name of the host functions imported by the contracts.
+const HostModule = "iost"
+
+// transfer err list, the same as the javascript vm
+const (
+	TransferSuccess = iota
+	TransferInvalidAmount
+	TransferBalanceNotEnough
+	TransferUnexpectedError
+)
+
+// callContext is the state of a contract call shared by the host functions. The host functions
+// returning a variable-length value put it in the result buffer and return its length, the contract
+// copies it into its memory by calling result.
+type callContext struct {
+	h      *host.Host
+	args   []string
+	result []byte
+	ret    string
+	cost   *contract.Cost
+}
+
+type hostAPI struct {
+	params  []valType
+	results []valType
+	fn      func(ctx *callContext, in *instance, args []uint64) (uint64, error)
+}
+
+func params(n int) []valType {
+	ts := make([]valType, n)
+	for i := range ts {
+		ts[i] = i32
+	}
+	return ts
+}
+
+var hostAPIs = map[string]hostAPI{
+	"arg_count":    {nil, []valType{i32}, argCount},
+	"arg":          {params(1), []valType{i32}, arg},
+	"result":       {params(1), nil, result},
+	"ret":          {params(2), nil, ret},
+	"abort":        {params(2), nil, abort},
+	"put":          {params(4), nil, put},
+	"get":          {params(2), []valType{i32}, get},
+	"del":          {params(2), nil, del},
+	"map_put":      {params(6), nil, mapPut},
+	"map_get":      {params(4), []valType{i32}, mapGet},
+	"map_has":      {params(4), []valType{i32}, mapHas},
+	"map_del":      {params(4), nil, mapDel},
+	"map_keys":     {params(2), []valType{i32}, mapKeys},
+	"global_get":   {params(4), []valType{i32}, globalGet},
+	"transfer":     {append(params(4), i64), []valType{i32}, transfer},
+	"withdraw":     {append(params(2), i64), []valType{i32}, withdraw},
+	"deposit":      {append(params(2), i64), []valType{i32}, deposit},
+	"block_info":   {nil, []valType{i32}, blockInfo},
+	"tx_info":      {nil, []valType{i32}, txInfo},
+	"random":       {nil, []valType{i32}, random},
+	"block_random": {[]valType{i64}, []valType{i32}, blockRandom},
+	"call":         {params(6), []valType{i32}, call},
+	"require_auth": {params(2), []valType{i32}, requireAuth},
+	"receipt":      {params(2), nil, receipt},
+	"emit":         {params(6), nil, emit},
+	"sha256":       {params(2), []valType{i32}, hashAPI((*host.Host).Sha256)},
+	"sha3":         {params(2), []valType{i32}, hashAPI((*host.Host).Sha3)},
+	"keccak256":    {params(2), []valType{i32}, hashAPI((*host.Host).Keccak256)},
+	"ripemd160":    {params(2), []valType{i32}, hashAPI((*host.Host).Ripemd160)},
+	"verify":       {params(8), []valType{i32}, verify},
+	"pubkey_to_id": {params(2), []valType{i32}, pubkeyToID},
+}
+
+// checkImports checks the imports of the module are the host functions with the right types.
+func checkImports(m *Module) error {
+	for _, imp := range m.imports {
+		api, ok := hostAPIs[imp.name]
+		if imp.module != HostModule || !ok {
+			return fmt.Errorf("unknown import %v.%v", imp.module, imp.name)
+		}
+		if !m.types[imp.typ].equal(&funcType{params: api.params, results: api.results}) {
+			return fmt.Errorf("import %v.%v: incompatible import type", imp.module, imp.name)
+		}
+	}
+	return nil
+}
+
+// bind binds the imports of the module to the host functions of the call.
+func bind(m *Module, ctx *callContext) []hostFunc {
+	hosts := make([]hostFunc, len(m.imports))
+	for i, imp := range m.imports {
+		fn := hostAPIs[imp.name].fn
+		hosts[i] = func(in *instance, args []uint64) (uint64, error) {
+			return fn(ctx, in, args)
+		}
+	}
+	return hosts
+}
+
+func (ctx *callContext) charge(in *instance, cost *contract.Cost) error {
+	if cost == nil {
+		return nil
+	}
+	ctx.cost.AddAssign(cost)
+	return in.charge(cost.ToGas())
+}
+
+// setResult puts the value into the result buffer and returns its length.
+func (ctx *callContext) setResult(b []byte) uint64 {
+	ctx.result = b
+	return uint64(uint32(len(b)))
+}
+
+func (in *instance) str(ptr, n uint64) (string, error) {
+	b, err := in.memory(uint64(uint32(ptr)), uint64(uint32(n)))
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// strs reads the (ptr, len) pairs from the args.
+func (in *instance) strs(args []uint64) ([]string, error) {
+	ss := make([]string, len(args)/2)
+	for i := range ss {
+		s, err := in.str(args[2*i], args[2*i+1])
+		if err != nil {
+			return nil, err
+		}
+		ss[i] = s
+	}
+	return ss, nil
+}
+
+var nilResult = uint64(uint32(0xffffffff))
+
+// dbVal returns the value read from the db as the result, nil is returned as -1.
+func (ctx *callContext) dbVal(val interface{}) uint64 {
+	switch v := val.(type) {
+	case nil:
+		return nilResult
+	case string:
+		return ctx.setResult([]byte(v))
+	case int64:
+		return ctx.setResult([]byte(strconv.FormatInt(v, 10)))
+	case bool:
+		return ctx.setResult([]byte(strconv.FormatBool(v)))
+	case database.SerializedJSON:
+		return ctx.setResult(v)
+	default:
+		b, _ := json.Marshal(v)
+		return ctx.setResult(b)
+	}
+}
+
+func argCount(ctx *callContext, in *instance, args []uint64) (uint64, error) {
+	return uint64(len(ctx.args)), nil
+}
+
+func arg(ctx *callContext, in *instance, args []uint64) (uint64, error) {
+	i := uint64(uint32(args[0]))
+	if i >= uint64(len(ctx.args)) {
+		return nilResult, nil
+	}
+	return ctx.setResult([]byte(ctx.args[i])), nil
+}
+
+func result(ctx *callContext, in *instance, args []uint64) (uint64, error) {
+	b, err := in.memory(uint64(uint32(args[0])), uint64(len(ctx.result)))
+	if err != nil {
+		return 0, err
+	}
+	copy(b, ctx.result)
+	return 0, nil
+}
+
+func ret(ctx *callContext, in *instance, args []uint64) (uint64, error) {
+	s, err := in.str(args[0], args[1])
+	ctx.ret = s
+	return 0, err
+}
+
+func abort(ctx *callContext, in *instance, args []uint64) (uint64, error) {
+	s, err := in.str(args[0], args[1])
+	if err != nil {
+		return 0, err
+	}
+	return 0, errors.New(s)
+}
+
+func put(ctx *callContext, in *instance, args []uint64) (uint64, error) {
+	s, err := in.strs(args)
+	if err != nil {
+		return 0, err
+	}
+	return 0, ctx.charge(in, ctx.h.Put(s[0], s[1]))
+}
+
+func get(ctx *callContext, in *instance, args []uint64) (uint64, error) {
+	s, err := in.strs(args)
+	if err != nil {
+		return 0, err
+	}
+	val, cost := ctx.h.Get(s[0])
+	return ctx.dbVal(val), ctx.charge(in, cost)
+}
+
+func del(ctx *callContext, in *instance, args []uint64) (uint64, error) {
+	s, err := in.strs(args)
+	if err != nil {
+		return 0, err
+	}
+	return 0, ctx.charge(in, ctx.h.Del(s[0]))
+}
+
+func mapPut(ctx *callContext, in *instance, args []uint64) (uint64, error) {
+	s, err := in.strs(args)
+	if err != nil {
+		return 0, err
+	}
+	return 0, ctx.charge(in, ctx.h.MapPut(s[0], s[1], s[2]))
+}
+
+func mapGet(ctx *callContext, in *instance, args []uint64) (uint64, error) {
+	s, err := in.strs(args)
+	if err != nil {
+		return 0, err
+	}
+	val, cost := ctx.h.MapGet(s[0], s[1])
+	return ctx.dbVal(val), ctx.charge(in, cost)
+}
+
+func mapHas(ctx *callContext, in *instance, args []uint64) (uint64, error) {
+	s, err := in.strs(args)
+	if err != nil {
+		return 0, err
+	}
+	ok, cost := ctx.h.MapHas(s[0], s[1])
+	return boolVal(ok), ctx.charge(in, cost)
+}
+
+func mapDel(ctx *callContext, in *instance, args []uint64) (uint64, error) {
+	s, err := in.strs(args)
+	if err != nil {
+		return 0, err
+	}
+	return 0, ctx.charge(in, ctx.h.MapDel(s[0], s[1]))
+}
+
+func mapKeys(ctx *callContext, in *instance, args []uint64) (uint64, error) {
+	s, err := in.strs(args)
+	if err != nil {
+		return 0, err
+	}
+	keys, cost := ctx.h.MapKeys(s[0])
+	if keys == nil {
+		keys = []string{}
+	}
+	b, err := json.Marshal(keys)
+	if err != nil {
+		return 0, err
+	}
+	return ctx.setResult(b), ctx.charge(in, cost)
+}
+
+func globalGet(ctx *callContext, in *instance, args []uint64) (uint64, error) {
+	s, err := in.strs(args)
+	if err != nil {
+		return 0, err
+	}
+	val, cost := ctx.h.GlobalGet(s[0], s[1])
+	return ctx.dbVal(val), ctx.charge(in, cost)
+}
+
+// transferResult returns the error code of the transfer and charges its cost.
+func (ctx *callContext) transferResult(in *instance, cost *contract.Cost, err error) (uint64, error) {
+	if e := ctx.charge(in, cost); e != nil {
+		return 0, e
+	}
+	switch err {
+	case nil:
+		return TransferSuccess, nil
+	case host.ErrBalanceNotEnough:
+		return TransferBalanceNotEnough, nil
+	}
+	return TransferUnexpectedError, nil
+}
+
+func transfer(ctx *callContext, in *instance, args []uint64) (uint64, error) {
+	s, err := in.strs(args[:4])
+	if err != nil {
+		return 0, err
+	}
+	if int64(args[4]) <= 0 {
+		return TransferInvalidAmount, nil
+	}
+	cost, err := ctx.h.Transfer(s[0], s[1], int64(args[4]))
+	return ctx.transferResult(in, cost, err)
+}
+
+func withdraw(ctx *callContext, in *instance, args []uint64) (uint64, error) {
+	s, err := in.strs(args[:2])
+	if err != nil {
+		return 0, err
+	}
+	if int64(args[2]) <= 0 {
+		return TransferInvalidAmount, nil
+	}
+	cost, err := ctx.h.Withdraw(s[0], int64(args[2]))
+	return ctx.transferResult(in, cost, err)
+}
+
+func deposit(ctx *callContext, in *instance, args []uint64) (uint64, error) {
+	s, err := in.strs(args[:2])
+	if err != nil {
+		return 0, err
+	}
+	if int64(args[2]) <= 0 {
+		return TransferInvalidAmount, nil
+	}
+	cost, err := ctx.h.Deposit(s[0], int64(args[2]))
+	return ctx.transferResult(in, cost, err)
+}
+
+func blockInfo(ctx *callContext, in *instance, args []uint64) (uint64, error) {
+	info, cost := ctx.h.BlockInfo()
+	return ctx.setResult([]byte(info)), ctx.charge(in, cost)
+}
+
+// random returns the random seed of the block in base58.
+func random(ctx *callContext, in *instance, args []uint64) (uint64, error) {
+	r, cost := ctx.h.Random()
+	return ctx.setResult([]byte(r)), ctx.charge(in, cost)
+}
+
+// blockRandom returns the random seed of the block at the number in base58, it's empty if the block has none.
+func blockRandom(ctx *callContext, in *instance, args []uint64) (uint64, error) {
+	r, cost := ctx.h.BlockRandom(int64(args[0]))
+	return ctx.setResult([]byte(r)), ctx.charge(in, cost)
+}
+
+func txInfo(ctx *callContext, in *instance, args []uint64) (uint64, error) {
+	info, cost := ctx.h.TxInfo()
+	return ctx.setResult([]byte(info)), ctx.charge(in, cost)
+}
+
+// call calls the api of another contract with the args in json, the result is the returned values in
+// json, or -1 if the call fails.
+func call(ctx *callContext, in *instance, args []uint64) (uint64, error) {
+	s, err := in.strs(args)
+	if err != nil {
+		return 0, err
+	}
+	rtn, cost, err := ctx.h.Call(s[0], s[1], s[2])
+	if e := ctx.charge(in, cost); e != nil {
+		return 0, e
+	}
+	if err != nil {
+		return nilResult, nil
+	}
+	b, err := json.Marshal(rtn)
+	if err != nil {
+		return nilResult, nil
+	}
+	return ctx.setResult(b), nil
+}
+
+func requireAuth(ctx *callContext, in *instance, args []uint64) (uint64, error) {
+	s, err := in.strs(args)
+	if err != nil {
+		return 0, err
+	}
+	ok, cost := ctx.h.RequireAuth(s[0])
+	return boolVal(ok), ctx.charge(in, cost)
+}
+
+func receipt(ctx *callContext, in *instance, args []uint64) (uint64, error) {
+	s, err := in.strs(args)
+	if err != nil {
+		return 0, err
+	}
+	return 0, ctx.charge(in, ctx.h.Receipt(s[0]))
+}
+
+// emit emits an event with the name, the indexed topics in a json array and the data.
+func emit(ctx *callContext, in *instance, args []uint64) (uint64, error) {
+	s, err := in.strs(args)
+	if err != nil {
+		return 0, err
+	}
+	var topics []string
+	if err := json.Unmarshal([]byte(s[1]), &topics); err != nil {
+		return 0, err
+	}
+	cost, err := ctx.h.Emit(s[0], topics, s[2])
+	if e := ctx.charge(in, cost); e != nil {
+		return 0, e
+	}
+	return 0, err
+}
+
+// hashAPI returns the host function of the hash, the result is the hash in base58.
+func hashAPI(hash func(*host.Host, string) (string, *contract.Cost)) func(*callContext, *instance, []uint64) (uint64, error) {
+	return func(ctx *callContext, in *instance, args []uint64) (uint64, error) {
+		s, err := in.strs(args)
+		if err != nil {
+			return 0, err
+		}
+		h, cost := hash(ctx.h, s[0])
+		return ctx.setResult([]byte(h)), ctx.charge(in, cost)
+	}
+}
+
+// verify verifies the signature with the algo, the message, the signature and the pubkey in base58.
+func verify(ctx *callContext, in *instance, args []uint64) (uint64, error) {
+	s, err := in.strs(args)
+	if err != nil {
+		return 0, err
+	}
+	ok, cost := ctx.h.VerifySignature(s[0], s[1], s[2], s[3])
+	return boolVal(ok), ctx.charge(in, cost)
+}
+
+func pubkeyToID(ctx *callContext, in *instance, args []uint64) (uint64, error) {
+	s, err := in.strs(args)
+	if err != nil {
+		return 0, err
+	}
+	id, cost := ctx.h.PubkeyToID(s[0])
+	return ctx.setResult([]byte(id)), ctx.charge(in, cost)
+}
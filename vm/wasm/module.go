@@ -0,0 +1,736 @@
+package wasm
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"unicode/utf8"
+)
+
+// limits of the modules, the modules beyond them are refused at deploy time
+var (
+	MaxFunctions   = 10000
+	MaxLocals      = 50000
+	MaxTableSize   = 10000
+	MaxMemoryPages = 256
+	MaxGlobals     = 1000
+	MaxBlockDepth  = 1024
+	MaxStackHeight = 1 << 16
+)
+
+const pageSize = 1 << 16
+
+var (
+	magic   = []byte{0x00, 0x61, 0x73, 0x6d}
+	version = []byte{0x01, 0x00, 0x00, 0x00}
+)
+
+var (
+	errUnexpectedEnd = errors.New("unexpected end of module")
+	errIntTooLong    = errors.New("integer representation too long")
+	errFloat         = errors.New("floating point isn't supported")
+)
+
+type valType byte
+
+const (
+	i32 valType = 0x7f
+	i64 valType = 0x7e
+	f32 valType = 0x7d
+	f64 valType = 0x7c
+)
+
+func (t valType) String() string {
+	switch t {
+	case i32:
+		return "i32"
+	case i64:
+		return "i64"
+	case f32:
+		return "f32"
+	case f64:
+		return "f64"
+	}
+	return "unknown"
+}
+
+type funcType struct {
+	params  []valType
+	results []valType
+}
+
+func (ft *funcType) equal(o *funcType) bool {
+	return bytes.Equal(valTypeBytes(ft.params), valTypeBytes(o.params)) &&
+		bytes.Equal(valTypeBytes(ft.results), valTypeBytes(o.results))
+}
+
+func valTypeBytes(ts []valType) []byte {
+	b := make([]byte, len(ts))
+	for i, t := range ts {
+		b[i] = byte(t)
+	}
+	return b
+}
+
+type importFunc struct {
+	module string
+	name   string
+	typ    uint32
+}
+
+type limits struct {
+	min uint32
+	max uint32
+	has bool
+}
+
+type global struct {
+	typ     valType
+	mutable bool
+	init    uint64
+}
+
+type function struct {
+	typ      uint32
+	locals   []valType
+	body     []byte
+	code     []instr
+	maxStack int
+}
+
+const (
+	externFunc   byte = 0x00
+	externTable  byte = 0x01
+	externMemory byte = 0x02
+	externGlobal byte = 0x03
+)
+
+type export struct {
+	kind  byte
+	index uint32
+}
+
+type segment struct {
+	offset uint32
+	funcs  []uint32
+	data   []byte
+}
+
+// Module is a decoded and validated WebAssembly module. Only the integer instructions of the
+// MVP are supported, because the results of floating point instructions may differ between nodes.
+type Module struct {
+	types   []funcType
+	imports []importFunc
+	funcs   []*function
+	table   *limits
+	memory  *limits
+	globals []global
+	exports map[string]export
+	start   int64
+	elems   []segment
+	data    []segment
+}
+
+// funcType returns the type of the function in the function index space, imports first.
+func (m *Module) funcType(index uint32) *funcType {
+	if int(index) < len(m.imports) {
+		return &m.types[m.imports[index].typ]
+	}
+	return &m.types[m.funcs[int(index)-len(m.imports)].typ]
+}
+
+func (m *Module) numFuncs() int {
+	return len(m.imports) + len(m.funcs)
+}
+
+// Export returns the index of the exported function.
+func (m *Module) Export(name string) (uint32, bool) {
+	e, ok := m.exports[name]
+	if !ok || e.kind != externFunc {
+		return 0, false
+	}
+	return e.index, true
+}
+
+type reader struct {
+	b   []byte
+	pos int
+}
+
+func (r *reader) eof() bool {
+	return r.pos >= len(r.b)
+}
+
+func (r *reader) byte() (byte, error) {
+	if r.pos >= len(r.b) {
+		return 0, errUnexpectedEnd
+	}
+	b := r.b[r.pos]
+	r.pos++
+	return b, nil
+}
+
+func (r *reader) bytes(n int) ([]byte, error) {
+	if n < 0 || len(r.b)-r.pos < n {
+		return nil, errUnexpectedEnd
+	}
+	b := r.b[r.pos : r.pos+n]
+	r.pos += n
+	return b, nil
+}
+
+func (r *reader) u32() (uint32, error) {
+	var v uint64
+	for shift := uint(0); ; shift += 7 {
+		b, err := r.byte()
+		if err != nil {
+			return 0, err
+		}
+		if shift == 28 && b > 0x0f {
+			return 0, errIntTooLong
+		}
+		v |= uint64(b&0x7f) << shift
+		if b&0x80 == 0 {
+			return uint32(v), nil
+		}
+	}
+}
+
+func (r *reader) signed(size uint) (int64, error) {
+	var v int64
+	var shift uint
+	for {
+		b, err := r.byte()
+		if err != nil {
+			return 0, err
+		}
+		if shift+7 >= size && shift < size {
+			// the unused bits of the last byte must be the sign extension
+			rest := int8(b<<1) >> (size - shift)
+			if b&0x80 != 0 || (rest != 0 && rest != -1) {
+				return 0, errIntTooLong
+			}
+		}
+		v |= int64(b&0x7f) << shift
+		shift += 7
+		if b&0x80 == 0 {
+			if shift < 64 && b&0x40 != 0 {
+				v |= -1 << shift
+			}
+			return v, nil
+		}
+	}
+}
+
+func (r *reader) s32() (int32, error) {
+	v, err := r.signed(32)
+	return int32(v), err
+}
+
+func (r *reader) s64() (int64, error) {
+	return r.signed(64)
+}
+
+func (r *reader) name() (string, error) {
+	n, err := r.u32()
+	if err != nil {
+		return "", err
+	}
+	b, err := r.bytes(int(n))
+	if err != nil {
+		return "", err
+	}
+	if !utf8.Valid(b) {
+		return "", errors.New("invalid utf-8 name")
+	}
+	return string(b), nil
+}
+
+func (r *reader) valType() (valType, error) {
+	b, err := r.byte()
+	if err != nil {
+		return 0, err
+	}
+	switch valType(b) {
+	case i32, i64:
+		return valType(b), nil
+	case f32, f64:
+		return 0, errFloat
+	}
+	return 0, fmt.Errorf("invalid value type 0x%x", b)
+}
+
+func (r *reader) limits(max uint32) (*limits, error) {
+	flag, err := r.byte()
+	if err != nil {
+		return nil, err
+	}
+	l := &limits{}
+	if l.min, err = r.u32(); err != nil {
+		return nil, err
+	}
+	switch flag {
+	case 0:
+	case 1:
+		if l.max, err = r.u32(); err != nil {
+			return nil, err
+		}
+		l.has = true
+		if l.max < l.min {
+			return nil, errors.New("size minimum must not be greater than maximum")
+		}
+	default:
+		return nil, fmt.Errorf("invalid limits flag 0x%x", flag)
+	}
+	if l.min > max || (l.has && l.max > max) {
+		return nil, fmt.Errorf("size limit exceeds %v", max)
+	}
+	return l, nil
+}
+
+// constExpr reads an initializer expression, only constants are supported as there are no imported globals.
+func (r *reader) constExpr(t valType) (uint64, error) {
+	op, err := r.byte()
+	if err != nil {
+		return 0, err
+	}
+	var v uint64
+	switch {
+	case op == opI32Const && t == i32:
+		c, err := r.s32()
+		if err != nil {
+			return 0, err
+		}
+		v = uint64(uint32(c))
+	case op == opI64Const && t == i64:
+		c, err := r.s64()
+		if err != nil {
+			return 0, err
+		}
+		v = uint64(c)
+	default:
+		return 0, fmt.Errorf("invalid constant expression 0x%x", op)
+	}
+	end, err := r.byte()
+	if err != nil {
+		return 0, err
+	}
+	if end != opEnd {
+		return 0, errors.New("constant expression required")
+	}
+	return v, nil
+}
+
+// Decode decodes and validates the binary module.
+func Decode(b []byte) (*Module, error) {
+	r := &reader{b: b}
+	head, err := r.bytes(8)
+	if err != nil || !bytes.Equal(head[:4], magic) {
+		return nil, errors.New("magic header not detected")
+	}
+	if !bytes.Equal(head[4:], version) {
+		return nil, errors.New("unknown binary version")
+	}
+	m := &Module{
+		exports: make(map[string]export),
+		start:   -1,
+	}
+	var funcTypes []uint32
+	var last byte
+	for !r.eof() {
+		id, err := r.byte()
+		if err != nil {
+			return nil, err
+		}
+		size, err := r.u32()
+		if err != nil {
+			return nil, err
+		}
+		content, err := r.bytes(int(size))
+		if err != nil {
+			return nil, err
+		}
+		if id == 0 {
+			continue
+		}
+		if id <= last {
+			return nil, fmt.Errorf("unexpected section %v", id)
+		}
+		last = id
+		s := &reader{b: content}
+		switch id {
+		case 1:
+			err = m.decodeTypes(s)
+		case 2:
+			err = m.decodeImports(s)
+		case 3:
+			funcTypes, err = m.decodeFunctions(s)
+		case 4:
+			err = m.decodeTable(s)
+		case 5:
+			err = m.decodeMemory(s)
+		case 6:
+			err = m.decodeGlobals(s)
+		case 7:
+			err = m.decodeExports(s)
+		case 8:
+			err = m.decodeStart(s)
+		case 9:
+			err = m.decodeElements(s)
+		case 10:
+			err = m.decodeCode(s, funcTypes)
+		case 11:
+			err = m.decodeData(s)
+		default:
+			err = fmt.Errorf("unknown section %v", id)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("section %v: %v", id, err)
+		}
+		if !s.eof() {
+			return nil, fmt.Errorf("section %v: size mismatch", id)
+		}
+	}
+	if len(funcTypes) != len(m.funcs) {
+		return nil, errors.New("function and code section have inconsistent lengths")
+	}
+	for i, f := range m.funcs {
+		if err := m.compile(f); err != nil {
+			return nil, fmt.Errorf("function %v: %v", len(m.imports)+i, err)
+		}
+	}
+	return m, nil
+}
+
+func (m *Module) decodeTypes(r *reader) error {
+	n, err := r.u32()
+	if err != nil {
+		return err
+	}
+	if int(n) > MaxFunctions {
+		return errors.New("too many types")
+	}
+	for i := uint32(0); i < n; i++ {
+		form, err := r.byte()
+		if err != nil {
+			return err
+		}
+		if form != 0x60 {
+			return fmt.Errorf("invalid function type form 0x%x", form)
+		}
+		var ft funcType
+		for _, ts := range []*[]valType{&ft.params, &ft.results} {
+			c, err := r.u32()
+			if err != nil {
+				return err
+			}
+			if int(c) > MaxLocals {
+				return errors.New("too many params")
+			}
+			for j := uint32(0); j < c; j++ {
+				t, err := r.valType()
+				if err != nil {
+					return err
+				}
+				*ts = append(*ts, t)
+			}
+		}
+		if len(ft.results) > 1 {
+			return errors.New("multiple results aren't supported")
+		}
+		m.types = append(m.types, ft)
+	}
+	return nil
+}
+
+func (m *Module) decodeImports(r *reader) error {
+	n, err := r.u32()
+	if err != nil {
+		return err
+	}
+	if int(n) > MaxFunctions {
+		return errors.New("too many imports")
+	}
+	for i := uint32(0); i < n; i++ {
+		module, err := r.name()
+		if err != nil {
+			return err
+		}
+		name, err := r.name()
+		if err != nil {
+			return err
+		}
+		kind, err := r.byte()
+		if err != nil {
+			return err
+		}
+		if kind != externFunc {
+			return fmt.Errorf("import %v.%v: only functions can be imported", module, name)
+		}
+		typ, err := r.u32()
+		if err != nil {
+			return err
+		}
+		if int(typ) >= len(m.types) {
+			return fmt.Errorf("import %v.%v: unknown type %v", module, name, typ)
+		}
+		m.imports = append(m.imports, importFunc{module: module, name: name, typ: typ})
+	}
+	return nil
+}
+
+func (m *Module) decodeFunctions(r *reader) ([]uint32, error) {
+	n, err := r.u32()
+	if err != nil {
+		return nil, err
+	}
+	if int(n) > MaxFunctions {
+		return nil, errors.New("too many functions")
+	}
+	types := make([]uint32, n)
+	for i := range types {
+		if types[i], err = r.u32(); err != nil {
+			return nil, err
+		}
+		if int(types[i]) >= len(m.types) {
+			return nil, fmt.Errorf("unknown type %v", types[i])
+		}
+		m.funcs = append(m.funcs, &function{typ: types[i]})
+	}
+	return types, nil
+}
+
+func (m *Module) decodeTable(r *reader) error {
+	n, err := r.u32()
+	if err != nil {
+		return err
+	}
+	if n > 1 {
+		return errors.New("multiple tables")
+	}
+	if n == 0 {
+		return nil
+	}
+	elem, err := r.byte()
+	if err != nil {
+		return err
+	}
+	if elem != 0x70 {
+		return fmt.Errorf("invalid table element type 0x%x", elem)
+	}
+	m.table, err = r.limits(uint32(MaxTableSize))
+	return err
+}
+
+func (m *Module) decodeMemory(r *reader) error {
+	n, err := r.u32()
+	if err != nil {
+		return err
+	}
+	if n > 1 {
+		return errors.New("multiple memories")
+	}
+	if n == 0 {
+		return nil
+	}
+	m.memory, err = r.limits(uint32(MaxMemoryPages))
+	return err
+}
+
+func (m *Module) decodeGlobals(r *reader) error {
+	n, err := r.u32()
+	if err != nil {
+		return err
+	}
+	if int(n) > MaxGlobals {
+		return errors.New("too many globals")
+	}
+	for i := uint32(0); i < n; i++ {
+		var g global
+		if g.typ, err = r.valType(); err != nil {
+			return err
+		}
+		mut, err := r.byte()
+		if err != nil {
+			return err
+		}
+		if mut > 1 {
+			return fmt.Errorf("invalid mutability 0x%x", mut)
+		}
+		g.mutable = mut == 1
+		if g.init, err = r.constExpr(g.typ); err != nil {
+			return err
+		}
+		m.globals = append(m.globals, g)
+	}
+	return nil
+}
+
+func (m *Module) decodeExports(r *reader) error {
+	n, err := r.u32()
+	if err != nil {
+		return err
+	}
+	for i := uint32(0); i < n; i++ {
+		name, err := r.name()
+		if err != nil {
+			return err
+		}
+		var e export
+		if e.kind, err = r.byte(); err != nil {
+			return err
+		}
+		if e.index, err = r.u32(); err != nil {
+			return err
+		}
+		var ok bool
+		switch e.kind {
+		case externFunc:
+			ok = int(e.index) < m.numFuncs()
+		case externTable:
+			ok = e.index == 0 && m.table != nil
+		case externMemory:
+			ok = e.index == 0 && m.memory != nil
+		case externGlobal:
+			ok = int(e.index) < len(m.globals)
+		}
+		if !ok {
+			return fmt.Errorf("export %v: unknown %v %v", name, e.kind, e.index)
+		}
+		if _, dup := m.exports[name]; dup {
+			return fmt.Errorf("duplicate export name %v", name)
+		}
+		m.exports[name] = e
+	}
+	return nil
+}
+
+func (m *Module) decodeStart(r *reader) error {
+	index, err := r.u32()
+	if err != nil {
+		return err
+	}
+	if int(index) >= m.numFuncs() {
+		return fmt.Errorf("unknown function %v", index)
+	}
+	ft := m.funcType(index)
+	if len(ft.params) != 0 || len(ft.results) != 0 {
+		return errors.New("start function must not have params or results")
+	}
+	m.start = int64(index)
+	return nil
+}
+
+func (m *Module) decodeElements(r *reader) error {
+	n, err := r.u32()
+	if err != nil {
+		return err
+	}
+	for i := uint32(0); i < n; i++ {
+		table, err := r.u32()
+		if err != nil {
+			return err
+		}
+		if table != 0 || m.table == nil {
+			return fmt.Errorf("unknown table %v", table)
+		}
+		offset, err := r.constExpr(i32)
+		if err != nil {
+			return err
+		}
+		c, err := r.u32()
+		if err != nil {
+			return err
+		}
+		if int(c) > MaxTableSize {
+			return errors.New("too many elements")
+		}
+		seg := segment{offset: uint32(offset), funcs: make([]uint32, c)}
+		for j := range seg.funcs {
+			if seg.funcs[j], err = r.u32(); err != nil {
+				return err
+			}
+			if int(seg.funcs[j]) >= m.numFuncs() {
+				return fmt.Errorf("unknown function %v", seg.funcs[j])
+			}
+		}
+		m.elems = append(m.elems, seg)
+	}
+	return nil
+}
+
+func (m *Module) decodeCode(r *reader, funcTypes []uint32) error {
+	n, err := r.u32()
+	if err != nil {
+		return err
+	}
+	if int(n) != len(funcTypes) {
+		return errors.New("function and code section have inconsistent lengths")
+	}
+	for i := uint32(0); i < n; i++ {
+		size, err := r.u32()
+		if err != nil {
+			return err
+		}
+		body, err := r.bytes(int(size))
+		if err != nil {
+			return err
+		}
+		br := &reader{b: body}
+		f := m.funcs[i]
+		groups, err := br.u32()
+		if err != nil {
+			return err
+		}
+		total := len(m.types[f.typ].params)
+		for j := uint32(0); j < groups; j++ {
+			c, err := br.u32()
+			if err != nil {
+				return err
+			}
+			total += int(c)
+			if total > MaxLocals {
+				return errors.New("too many locals")
+			}
+			t, err := br.valType()
+			if err != nil {
+				return err
+			}
+			for k := uint32(0); k < c; k++ {
+				f.locals = append(f.locals, t)
+			}
+		}
+		f.body = body[br.pos:]
+	}
+	return nil
+}
+
+func (m *Module) decodeData(r *reader) error {
+	n, err := r.u32()
+	if err != nil {
+		return err
+	}
+	for i := uint32(0); i < n; i++ {
+		mem, err := r.u32()
+		if err != nil {
+			return err
+		}
+		if mem != 0 || m.memory == nil {
+			return fmt.Errorf("unknown memory %v", mem)
+		}
+		offset, err := r.constExpr(i32)
+		if err != nil {
+			return err
+		}
+		size, err := r.u32()
+		if err != nil {
+			return err
+		}
+		data, err := r.bytes(int(size))
+		if err != nil {
+			return err
+		}
+		m.data = append(m.data, segment{offset: uint32(offset), data: data})
+	}
+	return nil
+}
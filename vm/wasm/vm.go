@@ -0,0 +1,185 @@
+package wasm
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/golang-lru"
+	"github.com/iost-official/go-iost/common"
+	"github.com/iost-official/go-iost/core/contract"
+	"github.com/iost-official/go-iost/vm/host"
+)
+
+// ModuleCacheSize is the number of decoded modules cached by the VM.
+var ModuleCacheSize = 128
+
+var (
+	errInvalidCode = errors.New("invalid wasm code, should be the binary module or its base64 encoding")
+	errArgType     = errors.New("unsupported arg type")
+)
+
+// VM runs the contracts compiled to WebAssembly. The contract exports a function without params and
+// results for each api, and imports the host functions from the "iost" module to read the args, access
+// the storage and return the result.
+type VM struct {
+	modules *lru.Cache
+}
+
+// NewVM returns a wasm VM.
+func NewVM() *VM {
+	return &VM{}
+}
+
+// Init inits the module cache.
+func (v *VM) Init() error {
+	c, err := lru.New(ModuleCacheSize)
+	if err != nil {
+		return err
+	}
+	v.modules = c
+	return nil
+}
+
+// Release releases the cached modules.
+func (v *VM) Release() {
+	if v.modules != nil {
+		v.modules.Purge()
+	}
+}
+
+func decodeCode(code string) ([]byte, error) {
+	b := []byte(code)
+	if bytes.HasPrefix(b, magic) {
+		return b, nil
+	}
+	b, err := base64.StdEncoding.DecodeString(code)
+	if err != nil || !bytes.HasPrefix(b, magic) {
+		return nil, errInvalidCode
+	}
+	return b, nil
+}
+
+// Compile validates the module of the contract, and returns its base64 encoding as the code to store.
+func (v *VM) Compile(c *contract.Contract) (string, error) {
+	b, err := decodeCode(c.Code)
+	if err != nil {
+		return "", err
+	}
+	m, err := Decode(b)
+	if err != nil {
+		return "", err
+	}
+	if err := checkImports(m); err != nil {
+		return "", err
+	}
+	if c.Info != nil {
+		for _, abi := range c.Info.Abi {
+			if err := checkAPI(m, abi.Name); err != nil {
+				return "", err
+			}
+		}
+	}
+	if _, ok := m.exports["init"]; ok {
+		if err := checkAPI(m, "init"); err != nil {
+			return "", err
+		}
+	}
+	return base64.StdEncoding.EncodeToString(b), nil
+}
+
+func checkAPI(m *Module, api string) error {
+	index, ok := m.Export(api)
+	if !ok {
+		return fmt.Errorf("api %v isn't exported", api)
+	}
+	ft := m.funcType(index)
+	if len(ft.params) != 0 || len(ft.results) != 0 {
+		return fmt.Errorf("api %v must not have params or results", api)
+	}
+	return nil
+}
+
+func (v *VM) module(code string) (*Module, error) {
+	key := string(common.Sha3([]byte(code)))
+	if v.modules != nil {
+		if m, ok := v.modules.Get(key); ok {
+			return m.(*Module), nil
+		}
+	}
+	b, err := decodeCode(code)
+	if err != nil {
+		return nil, err
+	}
+	m, err := Decode(b)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkImports(m); err != nil {
+		return nil, err
+	}
+	if v.modules != nil {
+		v.modules.Add(key, m)
+	}
+	return m, nil
+}
+
+func formatArgs(args []interface{}) ([]string, error) {
+	s := make([]string, len(args))
+	for i, arg := range args {
+		switch a := arg.(type) {
+		case string:
+			s[i] = a
+		case int64:
+			s[i] = strconv.FormatInt(a, 10)
+		case bool:
+			s[i] = strconv.FormatBool(a)
+		case []byte:
+			s[i] = string(a)
+		default:
+			return nil, errArgType
+		}
+	}
+	return s, nil
+}
+
+// LoadAndCall instantiates the module of the contract and calls the api. The module has no state
+// across calls, the contract keeps its state in the storage.
+func (v *VM) LoadAndCall(h *host.Host, con *contract.Contract, api string, args ...interface{}) (rtn []interface{}, cost *contract.Cost, err error) {
+	cost = contract.Cost0()
+	m, err := v.module(con.Code)
+	if err != nil {
+		return nil, cost, err
+	}
+	index, ok := m.Export(api)
+	if !ok {
+		if api == "init" {
+			return []interface{}{""}, cost, nil
+		}
+		return nil, cost, fmt.Errorf("api %v isn't exported", api)
+	}
+	if err := checkAPI(m, api); err != nil {
+		return nil, cost, err
+	}
+	ctx := &callContext{
+		h:    h,
+		cost: contract.Cost0(),
+	}
+	ctx.args, err = formatArgs(args)
+	if err != nil {
+		return nil, cost, err
+	}
+	in := newInstance(m, bind(m, ctx), h.GasLimit())
+	err = in.instantiate()
+	if err == nil {
+		err = in.call(index)
+	}
+	cost.CPU = in.gas - ctx.cost.ToGas()
+	cost.AddAssign(ctx.cost)
+	if err != nil {
+		return nil, cost, err
+	}
+	return []interface{}{ctx.ret}, cost, nil
+}
@@ -0,0 +1,342 @@
+package wasm
+
+import (
+	"testing"
+
+	"github.com/iost-official/go-iost/core/contract"
+	"github.com/iost-official/go-iost/vm/host"
+)
+
+// builder assembles the binary modules of the tests.
+type builder struct {
+	sections [12][]byte
+	counts   [12]int
+}
+
+func vec(n int, b ...[]byte) []byte {
+	r := uleb(uint32(n))
+	for _, p := range b {
+		r = append(r, p...)
+	}
+	return r
+}
+
+func uleb(v uint32) []byte {
+	var b []byte
+	for {
+		c := byte(v & 0x7f)
+		v >>= 7
+		if v != 0 {
+			c |= 0x80
+		}
+		b = append(b, c)
+		if v == 0 {
+			return b
+		}
+	}
+}
+
+func str(s string) []byte {
+	return append(uleb(uint32(len(s))), s...)
+}
+
+func (b *builder) add(id int, entry ...byte) *builder {
+	b.sections[id] = append(b.sections[id], entry...)
+	b.counts[id]++
+	return b
+}
+
+func (b *builder) typ(params, results []valType) *builder {
+	e := append([]byte{0x60}, vec(len(params), valTypeBytes(params))...)
+	return b.add(1, append(e, vec(len(results), valTypeBytes(results))...)...)
+}
+
+func (b *builder) imp(name string, typ uint32) *builder {
+	e := append(str(HostModule), str(name)...)
+	return b.add(2, append(append(e, externFunc), uleb(typ)...)...)
+}
+
+func (b *builder) fn(typ uint32, locals []valType, body ...byte) *builder {
+	b.add(3, uleb(typ)...)
+	var l []byte
+	for _, t := range locals {
+		l = append(l, 1, byte(t))
+	}
+	code := append(vec(len(locals), l), append(body, opEnd)...)
+	return b.add(10, append(uleb(uint32(len(code))), code...)...)
+}
+
+func (b *builder) export(name string, index uint32) *builder {
+	return b.add(7, append(append(str(name), externFunc), uleb(index)...)...)
+}
+
+func (b *builder) bytes() []byte {
+	m := append(append([]byte{}, magic...), version...)
+	for id, s := range b.sections {
+		if b.counts[id] == 0 {
+			continue
+		}
+		content := vec(b.counts[id], s)
+		m = append(append(append(m, byte(id)), uleb(uint32(len(content)))...), content...)
+	}
+	return m
+}
+
+func (b *builder) module(t *testing.T) *Module {
+	m, err := Decode(b.bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return m
+}
+
+// invoke calls the function with the params and returns the result on the top of the stack.
+func invoke(m *Module, gasLimit int64, index uint32, params ...uint64) (uint64, *instance, error) {
+	in := newInstance(m, nil, gasLimit)
+	if err := in.instantiate(); err != nil {
+		return 0, in, err
+	}
+	for _, p := range params {
+		in.stack[in.sp] = p
+		in.sp++
+	}
+	if err := in.call(index); err != nil {
+		return 0, in, err
+	}
+	if in.sp == 0 {
+		return 0, in, nil
+	}
+	return in.stack[in.sp-1], in, nil
+}
+
+func TestDecodeRejects(t *testing.T) {
+	tests := []struct {
+		name string
+		code []byte
+	}{
+		{"magic", []byte{0, 'a', 's', 'n', 1, 0, 0, 0}},
+		{"float type", (&builder{}).typ([]valType{f64}, nil).bytes()},
+		{"float instruction", (&builder{}).typ(nil, nil).fn(0, nil, 0x43, 0, 0, 0, 0, 0x1a).bytes()},
+		{"type mismatch", (&builder{}).typ(nil, []valType{i32}).fn(0, nil, opI32Const, 1, opI64Const, 1, 0x6a).bytes()},
+		{"missing result", (&builder{}).typ(nil, []valType{i32}).fn(0, nil).bytes()},
+		{"unknown local", (&builder{}).typ(nil, nil).fn(0, nil, opLocalGet, 0, opDrop).bytes()},
+		{"unknown label", (&builder{}).typ(nil, nil).fn(0, nil, opBr, 1).bytes()},
+		{"memory without declaration", (&builder{}).typ(nil, nil).fn(0, nil, opI32Const, 0, opI32Load, 2, 0, opDrop).bytes()},
+	}
+	for _, tt := range tests {
+		if _, err := Decode(tt.code); err == nil {
+			t.Errorf("%v: decoded the invalid module", tt.name)
+		}
+	}
+}
+
+func TestCheckImports(t *testing.T) {
+	m := (&builder{}).typ([]valType{i32}, []valType{i32}).imp("arg", 0).module(t)
+	if err := checkImports(m); err != nil {
+		t.Fatal(err)
+	}
+	m = (&builder{}).typ(nil, nil).imp("exec", 0).module(t)
+	if err := checkImports(m); err == nil {
+		t.Fatal("unknown import should be refused")
+	}
+	m = (&builder{}).typ(nil, nil).imp("arg", 0).module(t)
+	if err := checkImports(m); err == nil {
+		t.Fatal("import of the wrong type should be refused")
+	}
+}
+
+func TestExec(t *testing.T) {
+	i64s := []valType{i64}
+	m := (&builder{}).
+		typ(i64s, i64s).
+		typ([]valType{i32}, []valType{i32}).
+		// factorial with a loop
+		fn(0, i64s,
+			opI64Const, 1, opLocalSet, 1,
+			opBlock, 0x40, opLoop, 0x40,
+			opLocalGet, 0, opI64Eqz, opBrIf, 1,
+			opLocalGet, 1, opLocalGet, 0, 0x7e, opLocalSet, 1,
+			opLocalGet, 0, opI64Const, 1, 0x7d, opLocalSet, 0,
+			opBr, 0,
+			opEnd, opEnd,
+			opLocalGet, 1).
+		// br_table
+		fn(1, nil,
+			opBlock, 0x40, opBlock, 0x40, opBlock, 0x40,
+			opLocalGet, 0, opBrTable, 2, 0, 1, 2,
+			opEnd, opI32Const, 10, opReturn,
+			opEnd, opI32Const, 20, opReturn,
+			opEnd, opI32Const, 30).
+		// recursion
+		fn(1, nil,
+			opLocalGet, 0, opI32Eqz,
+			opIf, byte(i32), opI32Const, 0,
+			opElse, opLocalGet, 0, opLocalGet, 0, opI32Const, 1, 0x6b, opCall, 2, 0x6a,
+			opEnd).
+		// division
+		fn(1, nil, opI32Const, 56, opLocalGet, 0, 0x6d).
+		module(t)
+
+	tests := []struct {
+		index  uint32
+		param  uint64
+		result uint64
+	}{
+		{0, 0, 1},
+		{0, 20, 2432902008176640000},
+		{1, 0, 10},
+		{1, 1, 20},
+		{1, 2, 30},
+		{1, 100, 30},
+		{2, 100, 5050},
+		{3, 7, 8},
+		{3, uint64(uint32(0xfffffffc)), uint64(uint32(0xfffffff2))},
+	}
+	for _, tt := range tests {
+		r, _, err := invoke(m, 1000000, tt.index, tt.param)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if r != tt.result {
+			t.Errorf("func %v(%v) = %v, want %v", tt.index, tt.param, r, tt.result)
+		}
+	}
+	if _, _, err := invoke(m, 1000000, 3, 0); err != errDivideByZero {
+		t.Errorf("division by zero should trap, got %v", err)
+	}
+	if _, _, err := invoke(m, 1000000, 2, 100000); err != errStackOverflow {
+		t.Errorf("deep recursion should trap, got %v", err)
+	}
+}
+
+func TestCallIndirect(t *testing.T) {
+	b := (&builder{}).
+		typ(nil, []valType{i32}).
+		typ([]valType{i32}, []valType{i32}).
+		fn(0, nil, opI32Const, 7).
+		fn(0, nil, opI32Const, 9).
+		fn(1, nil, opI32Const, 1).
+		fn(1, nil, opLocalGet, 0, opCallIndirect, 0, 0)
+	b.add(4, 0x70, 0, 4)
+	b.add(9, 0, opI32Const, 0, opEnd, 3, 0, 1, 2)
+	m := b.module(t)
+
+	for i, want := range []uint64{7, 9} {
+		r, _, err := invoke(m, 1000, 3, uint64(i))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if r != want {
+			t.Errorf("call_indirect %v = %v, want %v", i, r, want)
+		}
+	}
+	if _, _, err := invoke(m, 1000, 3, 2); err != errIndirectCallType {
+		t.Errorf("expect %v, got %v", errIndirectCallType, err)
+	}
+	if _, _, err := invoke(m, 1000, 3, 3); err != errUninitializedEntry {
+		t.Errorf("expect %v, got %v", errUninitializedEntry, err)
+	}
+	if _, _, err := invoke(m, 1000, 3, 4); err != errUndefinedElement {
+		t.Errorf("expect %v, got %v", errUndefinedElement, err)
+	}
+}
+
+func TestGasAndMemory(t *testing.T) {
+	b := (&builder{}).
+		typ(nil, nil).
+		typ([]valType{i32}, []valType{i32}).
+		fn(0, nil, opLoop, 0x40, opBr, 0, opEnd).
+		fn(1, nil, opLocalGet, 0, opI32Const, 42, opI32Store, 2, 0, opLocalGet, 0, opI32Load, 2, 0).
+		fn(1, nil, opLocalGet, 0, opMemoryGrow, 0)
+	b.add(5, 1, 1, 2)
+	m := b.module(t)
+
+	_, in, err := invoke(m, 10000, 0)
+	if err != errOutOfGas {
+		t.Fatalf("infinite loop should run out of gas, got %v", err)
+	}
+	if in.gas <= 10000 {
+		t.Errorf("gas %v should exceed the limit", in.gas)
+	}
+	r, _, err := invoke(m, 10000, 1, pageSize-4)
+	if err != nil || r != 42 {
+		t.Errorf("load = %v, %v", r, err)
+	}
+	if _, _, err := invoke(m, 10000, 1, pageSize-3); err != errOutOfBounds {
+		t.Errorf("expect %v, got %v", errOutOfBounds, err)
+	}
+	r, _, err = invoke(m, 10000, 2, 1)
+	if err != nil || r != 1 {
+		t.Errorf("memory.grow = %v, %v", r, err)
+	}
+	r, _, err = invoke(m, 10000, 2, 2)
+	if err != nil || r != uint64(uint32(0xffffffff)) {
+		t.Errorf("memory.grow beyond the maximum = %v, %v", r, err)
+	}
+	if _, _, err := invoke(m, 1500, 2, 1); err != errOutOfGas {
+		t.Errorf("memory.grow should be charged, got %v", err)
+	}
+}
+
+// echoModule returns the first arg of the call.
+func echoModule() *builder {
+	b := (&builder{}).
+		typ([]valType{i32}, []valType{i32}).
+		typ([]valType{i32}, nil).
+		typ([]valType{i32, i32}, nil).
+		typ(nil, nil).
+		imp("arg", 0).
+		imp("result", 1).
+		imp("ret", 2).
+		fn(3, []valType{i32},
+			opI32Const, 0, opCall, 0, opLocalSet, 0,
+			opI32Const, 0, opCall, 1,
+			opI32Const, 0, opLocalGet, 0, opCall, 2).
+		export("echo", 3)
+	b.add(5, 0, 1)
+	return b
+}
+
+func TestVM(t *testing.T) {
+	vm := NewVM()
+	if err := vm.Init(); err != nil {
+		t.Fatal(err)
+	}
+	defer vm.Release()
+
+	c := &contract.Contract{
+		ID:   "Contract",
+		Code: string(echoModule().bytes()),
+		Info: &contract.Info{Abi: []*contract.ABI{{Name: "echo"}}},
+	}
+	code, err := vm.Compile(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.Code = code
+
+	ctx := host.NewContext(nil)
+	ctx.GSet("gas_limit", int64(100000))
+	h := host.NewHost(ctx, nil, nil, nil)
+	rtn, cost, err := vm.LoadAndCall(h, c, "echo", "hello")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rtn) != 1 || rtn[0] != "hello" {
+		t.Errorf("rtn = %v", rtn)
+	}
+	if cost.CPU <= 0 || cost.CPU != cost.ToGas() {
+		t.Errorf("cost = %v", cost)
+	}
+	if _, _, err := vm.LoadAndCall(h, c, "init"); err != nil {
+		t.Errorf("missing init should be a no-op, got %v", err)
+	}
+	if _, _, err := vm.LoadAndCall(h, c, "missing"); err == nil {
+		t.Error("call of the missing api should fail")
+	}
+
+	c.Info.Abi = append(c.Info.Abi, &contract.ABI{Name: "missing"})
+	if _, err := vm.Compile(c); err == nil {
+		t.Error("abi without the exported function should be refused")
+	}
+}